@@ -17,6 +17,8 @@ func NewMySQLConnection() (*gorm.DB, error) {
 	}
 
 	dbCfg := cfg.Database
-	dsn := fmt.Sprintf("%s:%s@tcp(%s:%d)/%s?charset=utf8mb4&parseTime=True&loc=Local", dbCfg.User, dbCfg.Password, dbCfg.Host, dbCfg.Port, dbCfg.Name)
+	// DATETIME の読み書きとセッションのタイムゾーンを UTC に揃え、
+	// SQL 側の日付計算 (DATE, TIMESTAMPDIFF) が Go 側と同じ暦で行われるようにする
+	dsn := fmt.Sprintf("%s:%s@tcp(%s:%d)/%s?charset=utf8mb4&parseTime=True&loc=UTC&time_zone=%%27%%2B00%%3A00%%27", dbCfg.User, dbCfg.Password, dbCfg.Host, dbCfg.Port, dbCfg.Name)
 	return gorm.Open("mysql", dsn)
 }
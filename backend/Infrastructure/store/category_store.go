@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"backend/Infrastructure/store/dto"
+	"backend/domain/apperr"
 	"backend/domain/model"
 	"backend/domain/repository"
 
@@ -20,13 +21,18 @@ func NewCategoryRepository(db *gorm.DB) repository.CategoryRepository {
 	return &CategoryRepository{db: db}
 }
 
-// ListCategories returns every category.
+// ListCategories returns the shared categories and those of the calling user.
 func (r *CategoryRepository) ListCategories(ctx context.Context) ([]model.Category, error) {
-	var categoryDTOs []dto.Category
-	if err := r.db.Find(&categoryDTOs).Error; err != nil {
+	owner, err := ownerID(ctx)
+	if err != nil {
 		return nil, err
 	}
 
+	var categoryDTOs []dto.Category
+	if err := r.visible(owner).Find(&categoryDTOs).Error; err != nil {
+		return nil, translateError(err, "category", 0)
+	}
+
 	categories := make([]model.Category, 0, len(categoryDTOs))
 	for _, c := range categoryDTOs {
 		categories = append(categories, c.ToModel())
@@ -34,3 +40,124 @@ func (r *CategoryRepository) ListCategories(ctx context.Context) ([]model.Catego
 
 	return categories, nil
 }
+
+// FindCategoryByID retrieves a shared category or one of the calling user's by its identifier.
+func (r *CategoryRepository) FindCategoryByID(ctx context.Context, id uint64) (*model.Category, error) {
+	owner, err := ownerID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var d dto.Category
+	if err := r.visible(owner).First(&d, "id = ?", id).Error; err != nil {
+		return nil, translateError(err, "category", id)
+	}
+	res := d.ToModel()
+	return &res, nil
+}
+
+// CreateCategory persists a new category owned by the calling user.
+func (r *CategoryRepository) CreateCategory(ctx context.Context, in model.Category) (*model.Category, error) {
+	owner, err := ownerID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	d := dto.CategoryFromModel(in)
+	d.UserID = &owner
+	if err := r.db.Create(&d).Error; err != nil {
+		return nil, translateError(err, "category", 0)
+	}
+	res := d.ToModel()
+	return &res, nil
+}
+
+// UpdateCategory persists changes to one of the calling user's categories.
+func (r *CategoryRepository) UpdateCategory(ctx context.Context, in model.Category) (*model.Category, error) {
+	owner, err := ownerID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := r.checkOwned(owner, in.ID); err != nil {
+		return nil, err
+	}
+
+	d := dto.CategoryFromModel(in)
+	d.UserID = &owner
+	if err := r.db.Save(&d).Error; err != nil {
+		return nil, translateError(err, "category", in.ID)
+	}
+	res := d.ToModel()
+	return &res, nil
+}
+
+// DeleteCategory removes a category, handling referencing tasks according to the request policy.
+// It returns the ids of the tasks whose category was reassigned or cleared.
+func (r *CategoryRepository) DeleteCategory(ctx context.Context, in model.DeleteCategoryRequest) ([]uint64, error) {
+	owner, err := ownerID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := r.checkOwned(owner, in.ID); err != nil {
+		return nil, err
+	}
+
+	var moved []uint64
+	err = r.db.Transaction(func(tx *gorm.DB) error {
+		// ゴミ箱内のタスクも外部キーで参照しているため対象に含める
+		tasks := tx.Unscoped().Model(&dto.Task{}).Where("category_id = ? AND user_id = ?", in.ID, owner)
+
+		switch in.Policy {
+		case model.DeleteCategoryReassign, model.DeleteCategoryNullify:
+			if err := tasks.Pluck("id", &moved).Error; err != nil {
+				return translateError(err, "category", in.ID)
+			}
+			var categoryID interface{}
+			if in.Policy == model.DeleteCategoryReassign {
+				categoryID = *in.ReassignTo
+			}
+			if err := tasks.Updates(map[string]interface{}{"category_id": categoryID, "version": gorm.Expr("version + 1")}).Error; err != nil {
+				return translateError(err, "category", in.ID)
+			}
+		default:
+			var count int
+			if err := tasks.Count(&count).Error; err != nil {
+				return translateError(err, "category", in.ID)
+			}
+			if count > 0 {
+				return repository.ErrCategoryInUse
+			}
+		}
+
+		res := tx.Delete(&dto.Category{}, "id = ? AND user_id = ?", in.ID, owner)
+		if res.Error != nil {
+			return translateError(res.Error, "category", in.ID)
+		}
+		if res.RowsAffected == 0 {
+			return apperr.NotFound("category", in.ID)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return moved, nil
+}
+
+// visible restricts queries to the shared categories and those of owner.
+func (r *CategoryRepository) visible(owner uint64) *gorm.DB {
+	return r.db.Where("user_id IS NULL OR user_id = ?", owner)
+}
+
+// checkOwned makes sure the category belongs to owner. Shared categories are visible to
+// everyone but may not be changed.
+func (r *CategoryRepository) checkOwned(owner, id uint64) error {
+	var d dto.Category
+	if err := r.visible(owner).First(&d, "id = ?", id).Error; err != nil {
+		return translateError(err, "category", id)
+	}
+	if d.UserID == nil {
+		return repository.ErrSharedCategory
+	}
+	return nil
+}
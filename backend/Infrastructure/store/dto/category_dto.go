@@ -8,6 +8,7 @@ import (
 // Category represents the persistence model for the categories table.
 type Category struct {
 	ID        uint64    `gorm:"column:id;primaryKey;autoIncrement;type:bigint unsigned"`
+	UserID    *uint64   `gorm:"column:user_id;type:bigint unsigned"` // NULL なら全ユーザー共通のカテゴリ
 	Name      string    `gorm:"column:name;type:varchar(255)"`
 	CreatedAt time.Time `gorm:"column:created_at;autoCreateTime"`
 	UpdatedAt time.Time `gorm:"column:updated_at;autoUpdateTime"`
@@ -27,3 +28,13 @@ func (c Category) ToModel() model.Category {
 		UpdatedAt: c.UpdatedAt,
 	}
 }
+
+// CategoryFromModel converts the domain Category entity into the DTO form.
+func CategoryFromModel(c model.Category) Category {
+	return Category{
+		ID:        c.ID,
+		Name:      c.Name,
+		CreatedAt: c.CreatedAt,
+		UpdatedAt: c.UpdatedAt,
+	}
+}
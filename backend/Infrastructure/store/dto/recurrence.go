@@ -0,0 +1,21 @@
+package dto
+
+import "backend/domain/model"
+
+// formatRRule stores a recurrence as its RRULE value. A nil rule is stored as NULL.
+func formatRRule(r *model.Recurrence) *string {
+	if r == nil {
+		return nil
+	}
+
+	rule := r.RRule()
+	return &rule
+}
+
+// parseRRule reads a rule written by formatRRule.
+func parseRRule(rule *string) *model.Recurrence {
+	if rule == nil || *rule == "" {
+		return nil
+	}
+	return model.ParseRRule(*rule)
+}
@@ -8,6 +8,7 @@ import (
 type SubTask struct {
 	ID          uint64     `gorm:"column:id;primaryKey;autoIncrement;type:bigint unsigned"`
 	TaskID      uint64     `gorm:"column:task_id;type:bigint unsigned"`
+	Position    int32      `gorm:"column:position;type:int"`
 	Title       string     `gorm:"column:title;type:varchar(255)"`
 	Note        string     `gorm:"column:note;type:text"`
 	Completed   int        `gorm:"column:completed;type:tinyint"`
@@ -15,6 +16,7 @@ type SubTask struct {
 	DueDate     *time.Time `gorm:"column:due_date;type:date"`
 	CreatedAt   time.Time  `gorm:"column:created_at;autoCreateTime"`
 	UpdatedAt   time.Time  `gorm:"column:updated_at;autoUpdateTime"`
+	Version     int32      `gorm:"column:version;type:int unsigned"`
 }
 
 func (SubTask) TableName() string {
@@ -25,6 +27,7 @@ func (s SubTask) ToModel() model.SubTask {
 	return model.SubTask{
 		ID:          s.ID,
 		TaskID:      s.TaskID,
+		Position:    s.Position,
 		Title:       s.Title,
 		Note:        s.Note,
 		Completed:   int32(s.Completed),
@@ -32,6 +35,7 @@ func (s SubTask) ToModel() model.SubTask {
 		DueDate:     s.DueDate,
 		CreatedAt:   s.CreatedAt,
 		UpdatedAt:   s.UpdatedAt,
+		Version:     s.Version,
 	}
 }
 
@@ -39,6 +43,7 @@ func SubTaskFromModel(m model.SubTask) SubTask {
 	return SubTask{
 		ID:          m.ID,
 		TaskID:      m.TaskID,
+		Position:    m.Position,
 		Title:       m.Title,
 		Note:        m.Note,
 		Completed:   int(m.Completed),
@@ -46,5 +51,6 @@ func SubTaskFromModel(m model.SubTask) SubTask {
 		DueDate:     m.DueDate,
 		CreatedAt:   m.CreatedAt,
 		UpdatedAt:   m.UpdatedAt,
+		Version:     m.Version,
 	}
 }
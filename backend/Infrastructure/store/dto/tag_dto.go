@@ -0,0 +1,51 @@
+package dto
+
+import (
+	"backend/domain/model"
+	"time"
+)
+
+// Tag represents the persistence model for the tags table.
+type Tag struct {
+	ID        uint64    `gorm:"column:id;primaryKey;autoIncrement;type:bigint unsigned"`
+	UserID    uint64    `gorm:"column:user_id;type:bigint unsigned"`
+	Name      string    `gorm:"column:name;type:varchar(64)"`
+	CreatedAt time.Time `gorm:"column:created_at;autoCreateTime"`
+	UpdatedAt time.Time `gorm:"column:updated_at;autoUpdateTime"`
+}
+
+// TableName overrides the default table name.
+func (Tag) TableName() string {
+	return "tags"
+}
+
+// ToModel converts DTO to domain model.
+func (t Tag) ToModel() model.Tag {
+	return model.Tag{
+		ID:        t.ID,
+		Name:      t.Name,
+		CreatedAt: t.CreatedAt,
+		UpdatedAt: t.UpdatedAt,
+	}
+}
+
+// TagFromModel converts the domain Tag entity into the DTO form.
+func TagFromModel(t model.Tag) Tag {
+	return Tag{
+		ID:        t.ID,
+		Name:      t.Name,
+		CreatedAt: t.CreatedAt,
+		UpdatedAt: t.UpdatedAt,
+	}
+}
+
+// TaskTag represents a row of the task_tags join table.
+type TaskTag struct {
+	TaskID uint64 `gorm:"column:task_id;primaryKey;type:bigint unsigned"`
+	TagID  uint64 `gorm:"column:tag_id;primaryKey;type:bigint unsigned"`
+}
+
+// TableName overrides the default table name.
+func (TaskTag) TableName() string {
+	return "task_tags"
+}
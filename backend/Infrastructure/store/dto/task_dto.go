@@ -7,15 +7,21 @@ import (
 
 // Task represents the persistence model for the tasks table.
 type Task struct {
-	ID          uint64     `gorm:"column:id;primaryKey;autoIncrement;type:bigint unsigned"`
-	Title       string     `gorm:"column:title;type:varchar(255)"`
-	Note        string     `gorm:"column:note;type:text"`
-	Completed   int        `gorm:"column:completed;type:tinyint"`
-	CompletedAt *time.Time `gorm:"column:completed_at;type:datetime"`
-	DueDate     *time.Time `gorm:"column:due_date;type:date"`
-	CategoryID  uint64     `gorm:"column:category_id;type:bigint unsigned"`
-	CreatedAt   time.Time  `gorm:"column:created_at;autoCreateTime"` // 自動で現在時刻が設定される
-	UpdatedAt   time.Time  `gorm:"column:updated_at;autoUpdateTime"` // 更新時に自動更新される
+	ID             uint64     `gorm:"column:id;primaryKey;autoIncrement;type:bigint unsigned"`
+	UserID         uint64     `gorm:"column:user_id;type:bigint unsigned"` // 所有者。リポジトリが呼び出し元のユーザーで設定する
+	Title          string     `gorm:"column:title;type:varchar(255)"`
+	Note           string     `gorm:"column:note;type:text"`
+	Completed      int        `gorm:"column:completed;type:tinyint"`
+	CompletedAt    *time.Time `gorm:"column:completed_at;type:datetime"`
+	DueDate        *time.Time `gorm:"column:due_date;type:date"`
+	CategoryID     *uint64    `gorm:"column:category_id;type:bigint unsigned"`
+	CreatedAt      time.Time  `gorm:"column:created_at;autoCreateTime"`         // 自動で現在時刻が設定される
+	UpdatedAt      time.Time  `gorm:"column:updated_at;autoUpdateTime"`         // 更新時に自動更新される
+	DeletedAt      *time.Time `gorm:"column:deleted_at;type:datetime"`          // 設定されている間はゴミ箱扱い (GORM の論理削除)
+	RRule          *string    `gorm:"column:recurrence_rule;type:varchar(255)"` // 繰り返しタスクの RRULE
+	Priority       int        `gorm:"column:priority;type:tinyint"`
+	Version        int32      `gorm:"column:version;type:int unsigned"`       // 楽観的ロック用。更新のたびに増える
+	SyncCompletion bool       `gorm:"column:sync_completion;type:tinyint(1)"` // サブタスクの完了状態と連動させるか
 }
 
 // TableName allows GORM to map the DTO to the tasks table.
@@ -26,29 +32,55 @@ func (Task) TableName() string {
 // ToModel converts the DTO into the domain Task entity.
 func (t Task) ToModel() model.Task {
 	return model.Task{
-		ID:          t.ID,
-		Title:       t.Title,
-		Note:        t.Note,
-		Completed:   int32(t.Completed),
-		CompletedAt: t.CompletedAt,
-		DueDate:     t.DueDate,
-		CategoryID:  t.CategoryID,
-		CreatedAt:   t.CreatedAt,
-		UpdatedAt:   t.UpdatedAt,
+		ID:             t.ID,
+		Title:          t.Title,
+		Note:           t.Note,
+		Completed:      int32(t.Completed),
+		CompletedAt:    t.CompletedAt,
+		DueDate:        t.DueDate,
+		CategoryID:     derefCategoryID(t.CategoryID),
+		CreatedAt:      t.CreatedAt,
+		UpdatedAt:      t.UpdatedAt,
+		DeletedAt:      t.DeletedAt,
+		Recurrence:     parseRRule(t.RRule),
+		Priority:       model.Priority(t.Priority),
+		Version:        t.Version,
+		SyncCompletion: t.SyncCompletion,
 	}
 }
 
 // FromModel converts the domain Task entity into the DTO form.
 func FromModel(task model.Task) Task {
 	return Task{
-		ID:          task.ID,
-		Title:       task.Title,
-		Note:        task.Note,
-		Completed:   int(task.Completed),
-		CompletedAt: task.CompletedAt,
-		DueDate:     task.DueDate,
-		CategoryID:  task.CategoryID,
-		CreatedAt:   task.CreatedAt,
-		UpdatedAt:   task.UpdatedAt,
+		ID:             task.ID,
+		Title:          task.Title,
+		Note:           task.Note,
+		Completed:      int(task.Completed),
+		CompletedAt:    task.CompletedAt,
+		DueDate:        task.DueDate,
+		CategoryID:     categoryIDPtr(task.CategoryID),
+		CreatedAt:      task.CreatedAt,
+		UpdatedAt:      task.UpdatedAt,
+		DeletedAt:      task.DeletedAt,
+		RRule:          formatRRule(task.Recurrence),
+		Priority:       int(task.Priority),
+		Version:        task.Version,
+		SyncCompletion: task.SyncCompletion,
 	}
 }
+
+// derefCategoryID maps a NULL category_id to the zero value used by the domain.
+func derefCategoryID(id *uint64) uint64 {
+	if id == nil {
+		return 0
+	}
+	return *id
+}
+
+// categoryIDPtr maps the domain zero value back to a NULL category_id.
+func categoryIDPtr(id uint64) *uint64 {
+	if id == 0 {
+		return nil
+	}
+	return &id
+}
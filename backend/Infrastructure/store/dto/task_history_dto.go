@@ -0,0 +1,60 @@
+package dto
+
+import (
+	"backend/domain/model"
+	"time"
+)
+
+// TaskHistoryEntry represents the persistence model for the task_events table.
+type TaskHistoryEntry struct {
+	ID        uint64    `gorm:"column:id;primaryKey;autoIncrement;type:bigint unsigned"`
+	TaskID    uint64    `gorm:"column:task_id;type:bigint unsigned"`
+	SubTaskID *uint64   `gorm:"column:sub_task_id;type:bigint unsigned"`
+	UserID    uint64    `gorm:"column:user_id;type:bigint unsigned"` // 変更したユーザー。リポジトリが呼び出し元のユーザーで設定する
+	Action    int       `gorm:"column:action;type:tinyint"`
+	Field     *string   `gorm:"column:field;type:varchar(64)"`
+	OldValue  *string   `gorm:"column:old_value;type:text"`
+	NewValue  *string   `gorm:"column:new_value;type:text"`
+	CreatedAt time.Time `gorm:"column:created_at;autoCreateTime"`
+}
+
+// TableName overrides the default table name.
+func (TaskHistoryEntry) TableName() string {
+	return "task_events"
+}
+
+// ToModel converts DTO to domain model.
+func (e TaskHistoryEntry) ToModel() model.TaskHistoryEntry {
+	res := model.TaskHistoryEntry{
+		ID:        e.ID,
+		TaskID:    e.TaskID,
+		SubTaskID: e.SubTaskID,
+		UserID:    e.UserID,
+		Action:    model.TaskHistoryAction(e.Action),
+		OldValue:  e.OldValue,
+		NewValue:  e.NewValue,
+		CreatedAt: e.CreatedAt,
+	}
+	if e.Field != nil {
+		res.Field = *e.Field
+	}
+	return res
+}
+
+// TaskHistoryEntryFromModel converts the domain TaskHistoryEntry into the DTO form.
+func TaskHistoryEntryFromModel(e model.TaskHistoryEntry) TaskHistoryEntry {
+	res := TaskHistoryEntry{
+		ID:        e.ID,
+		TaskID:    e.TaskID,
+		SubTaskID: e.SubTaskID,
+		UserID:    e.UserID,
+		Action:    int(e.Action),
+		OldValue:  e.OldValue,
+		NewValue:  e.NewValue,
+		CreatedAt: e.CreatedAt,
+	}
+	if e.Field != "" {
+		res.Field = &e.Field
+	}
+	return res
+}
@@ -0,0 +1,42 @@
+package dto
+
+import (
+	"backend/domain/model"
+	"time"
+)
+
+// User represents the persistence model for the users table.
+type User struct {
+	ID           uint64    `gorm:"column:id;primaryKey;autoIncrement;type:bigint unsigned"`
+	Email        string    `gorm:"column:email;type:varchar(255)"`
+	PasswordHash string    `gorm:"column:password_hash;type:varchar(255)"`
+	CreatedAt    time.Time `gorm:"column:created_at;autoCreateTime"`
+	UpdatedAt    time.Time `gorm:"column:updated_at;autoUpdateTime"`
+}
+
+// TableName overrides the default table name.
+func (User) TableName() string {
+	return "users"
+}
+
+// ToModel converts DTO to domain model.
+func (u User) ToModel() model.User {
+	return model.User{
+		ID:           u.ID,
+		Email:        u.Email,
+		PasswordHash: u.PasswordHash,
+		CreatedAt:    u.CreatedAt,
+		UpdatedAt:    u.UpdatedAt,
+	}
+}
+
+// UserFromModel converts the domain User entity into the DTO form.
+func UserFromModel(u model.User) User {
+	return User{
+		ID:           u.ID,
+		Email:        u.Email,
+		PasswordHash: u.PasswordHash,
+		CreatedAt:    u.CreatedAt,
+		UpdatedAt:    u.UpdatedAt,
+	}
+}
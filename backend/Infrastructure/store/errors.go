@@ -0,0 +1,67 @@
+package store
+
+import (
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"net"
+
+	"backend/domain/apperr"
+
+	"github.com/go-sql-driver/mysql"
+	"github.com/jinzhu/gorm"
+)
+
+// MySQL server error numbers translated by translateError.
+const (
+	mysqlErrDuplicateEntry    = 1062
+	mysqlErrDataTooLong       = 1406
+	mysqlErrRowIsReferenced   = 1451
+	mysqlErrNoReferencedRow   = 1452
+	mysqlErrRowIsReferenced2  = 1217
+	mysqlErrNoReferencedRow2  = 1216
+	mysqlErrLockWaitTimeout   = 1205
+	mysqlErrLockDeadlock      = 1213
+	mysqlErrTooManyConnection = 1040
+)
+
+// translateError converts GORM and MySQL errors into apperr errors so that
+// callers can react to them without knowing about the database.
+// resource and id describe the entity that was accessed and end up in NotFound errors.
+func translateError(err error, resource string, id uint64) error {
+	if err == nil {
+		return nil
+	}
+
+	var appErr *apperr.Error
+	if errors.As(err, &appErr) {
+		return err
+	}
+
+	if gorm.IsRecordNotFoundError(err) {
+		return apperr.NotFound(resource, id)
+	}
+
+	var mysqlErr *mysql.MySQLError
+	if errors.As(err, &mysqlErr) {
+		switch mysqlErr.Number {
+		case mysqlErrDuplicateEntry:
+			return &apperr.Error{Code: apperr.CodeConflict, Message: fmt.Sprintf("%s already exists", resource), Err: err}
+		case mysqlErrDataTooLong:
+			return &apperr.Error{Code: apperr.CodeInvalidArgument, Message: "value is too long", Err: err}
+		case mysqlErrNoReferencedRow, mysqlErrNoReferencedRow2:
+			return &apperr.Error{Code: apperr.CodeInvalidArgument, Message: "referenced record does not exist", Err: err}
+		case mysqlErrRowIsReferenced, mysqlErrRowIsReferenced2:
+			return &apperr.Error{Code: apperr.CodeFailedPrecondition, Message: fmt.Sprintf("%s is still referenced by other records", resource), Subject: resource, Err: err}
+		case mysqlErrLockWaitTimeout, mysqlErrLockDeadlock, mysqlErrTooManyConnection:
+			return apperr.Unavailable("database is busy", err)
+		}
+	}
+
+	var netErr net.Error
+	if errors.Is(err, driver.ErrBadConn) || errors.Is(err, mysql.ErrInvalidConn) || errors.As(err, &netErr) {
+		return apperr.Unavailable("database is unavailable", err)
+	}
+
+	return err
+}
@@ -0,0 +1,32 @@
+package store
+
+import (
+	"context"
+
+	"backend/domain/apperr"
+	"backend/domain/auth"
+
+	"github.com/jinzhu/gorm"
+)
+
+var errNoOwner = apperr.Unauthenticated("no user in request context")
+
+// ownerID returns the calling user, whose rows are the only ones a repository may touch.
+func ownerID(ctx context.Context) (uint64, error) {
+	userID, ok := auth.UserIDFromContext(ctx)
+	if !ok {
+		return 0, errNoOwner
+	}
+	return userID, nil
+}
+
+// ownedTaskIDs is a subquery selecting the ids of the owner's live tasks. The sub tasks of a
+// trashed task can neither be read nor changed until the task is restored.
+func ownedTaskIDs(db *gorm.DB, owner uint64) *gorm.SqlExpr {
+	return db.Table("tasks").Select("id").Where("user_id = ? AND deleted_at IS NULL", owner).SubQuery()
+}
+
+// ownedTaskIDsWithTrash is a subquery selecting the ids of the owner's tasks, trashed ones included.
+func ownedTaskIDsWithTrash(db *gorm.DB, owner uint64) *gorm.SqlExpr {
+	return db.Table("tasks").Select("id").Where("user_id = ?", owner).SubQuery()
+}
@@ -0,0 +1,40 @@
+package store
+
+import (
+	"encoding/base64"
+	"encoding/json"
+
+	"backend/domain/repository"
+)
+
+// pageToken is the decoded form of the opaque tokens handed out to clients.
+// Listings resume after the sort keys and id of the last row seen.
+// Search results are ordered by relevance instead and resume from an offset.
+type pageToken struct {
+	LastID uint64 `json:"id,omitempty"`
+	// Values holds the sort keys of the last row, in the order of TaskFilter.OrderBy.
+	Values []*string `json:"values,omitempty"`
+	Offset int       `json:"offset,omitempty"`
+}
+
+func encodePageToken(t pageToken) string {
+	b, _ := json.Marshal(t)
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+func decodePageToken(s string) (pageToken, error) {
+	var t pageToken
+	if s == "" {
+		return t, nil
+	}
+
+	b, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return t, repository.ErrInvalidPageToken
+	}
+	if err := json.Unmarshal(b, &t); err != nil {
+		return t, repository.ErrInvalidPageToken
+	}
+
+	return t, nil
+}
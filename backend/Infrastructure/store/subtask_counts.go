@@ -0,0 +1,58 @@
+package store
+
+import (
+	"backend/Infrastructure/store/dto"
+	"backend/domain/model"
+
+	"github.com/jinzhu/gorm"
+)
+
+// attachTaskDetails fills the fields of every task that live outside the tasks table.
+func attachTaskDetails(db *gorm.DB, tasks []model.Task) error {
+	if err := attachTagIDs(db, tasks); err != nil {
+		return err
+	}
+	return attachSubTaskCounts(db, tasks)
+}
+
+// attachSubTaskCounts fills SubTaskTotal and SubTaskCompleted of every task with a single
+// aggregate query on sub_tasks.
+func attachSubTaskCounts(db *gorm.DB, tasks []model.Task) error {
+	if len(tasks) == 0 {
+		return nil
+	}
+
+	ids := make([]uint64, 0, len(tasks))
+	for _, t := range tasks {
+		ids = append(ids, t.ID)
+	}
+
+	var rows []struct {
+		TaskID    uint64
+		Total     int32
+		Completed int32
+	}
+	err := db.Model(&dto.SubTask{}).
+		Select("task_id, COUNT(*) AS total, COALESCE(SUM(completed <> 0), 0) AS completed").
+		Where("task_id IN (?)", ids).
+		Group("task_id").
+		Scan(&rows).Error
+	if err != nil {
+		return translateError(err, "sub task", 0)
+	}
+
+	byTask := make(map[uint64]int, len(rows))
+	for i, row := range rows {
+		byTask[row.TaskID] = i
+	}
+	for i := range tasks {
+		if j, ok := byTask[tasks[i].ID]; ok {
+			tasks[i].SubTaskTotal = rows[j].Total
+			tasks[i].SubTaskCompleted = rows[j].Completed
+		} else {
+			tasks[i].SubTaskTotal = 0
+			tasks[i].SubTaskCompleted = 0
+		}
+	}
+	return nil
+}
@@ -2,8 +2,10 @@ package store
 
 import (
 	"context"
+	"fmt"
 
 	"backend/Infrastructure/store/dto"
+	"backend/domain/apperr"
 	"backend/domain/model"
 	"backend/domain/repository"
 
@@ -19,11 +21,16 @@ func NewSubTaskRepository(db *gorm.DB) repository.SubTaskRepository {
 }
 
 func (r *SubTaskRepository) ListByTaskID(ctx context.Context, taskID uint64) ([]model.SubTask, error) {
-	var subTaskDTOs []dto.SubTask
-	if err := r.db.Where("task_id = ?", taskID).Find(&subTaskDTOs).Error; err != nil {
+	owner, err := ownerID(ctx)
+	if err != nil {
 		return nil, err
 	}
 
+	var subTaskDTOs []dto.SubTask
+	if err := r.owned(owner).Where("task_id = ?", taskID).Order("position ASC, id ASC").Find(&subTaskDTOs).Error; err != nil {
+		return nil, translateError(err, "sub task", taskID)
+	}
+
 	result := make([]model.SubTask, 0, len(subTaskDTOs))
 	for _, st := range subTaskDTOs {
 		result = append(result, st.ToModel())
@@ -32,29 +39,162 @@ func (r *SubTaskRepository) ListByTaskID(ctx context.Context, taskID uint64) ([]
 	return result, nil
 }
 
+// ListByTaskIDs loads the subtasks of every given task in a single query, grouped by task id.
+func (r *SubTaskRepository) ListByTaskIDs(ctx context.Context, taskIDs []uint64) (map[uint64][]model.SubTask, error) {
+	owner, err := ownerID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make(map[uint64][]model.SubTask, len(taskIDs))
+	if len(taskIDs) == 0 {
+		return result, nil
+	}
+
+	var subTaskDTOs []dto.SubTask
+	if err := r.owned(owner).Where("task_id IN (?)", taskIDs).Order("position ASC, id ASC").Find(&subTaskDTOs).Error; err != nil {
+		return nil, translateError(err, "sub task", 0)
+	}
+
+	for _, st := range subTaskDTOs {
+		result[st.TaskID] = append(result[st.TaskID], st.ToModel())
+	}
+
+	return result, nil
+}
+
 func (r *SubTaskRepository) Create(ctx context.Context, in model.SubTask) (*model.SubTask, error) {
+	owner, err := ownerID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	d := dto.SubTaskFromModel(in)
-	if err := r.db.Create(&d).Error; err != nil {
+	d.Version = 1
+	err = r.db.Transaction(func(tx *gorm.DB) error {
+		// 他のユーザーのタスクやゴミ箱のタスクにはサブタスクを追加できない
+		// 親タスクの行をロックして、同時に追加されるサブタスクと position が重ならないようにする
+		var parent dto.Task
+		err := tx.Set("gorm:query_option", "FOR UPDATE").
+			Where("user_id = ?", owner).
+			First(&parent, "id = ?", in.TaskID).Error
+		if err != nil {
+			return translateError(err, "task", in.TaskID)
+		}
+
+		// 新しいサブタスクは末尾に追加する
+		var last struct{ Position int32 }
+		err = tx.Model(&dto.SubTask{}).
+			Select("COALESCE(MAX(position), 0) AS position").
+			Where("task_id = ?", in.TaskID).
+			Scan(&last).Error
+		if err != nil {
+			return translateError(err, "sub task", 0)
+		}
+
+		d.Position = last.Position + 1
+		if err := tx.Create(&d).Error; err != nil {
+			return translateError(err, "sub task", 0)
+		}
+		return nil
+	})
+	if err != nil {
 		return nil, err
 	}
 	res := d.ToModel()
 	return &res, nil
 }
 
+// Update persists changes to a sub task. in.Version must be the version the sub task was
+// loaded with; when it has been updated since, an Aborted error carrying the current sub
+// task is returned. Sub tasks of other users' tasks are reported as not found.
 func (r *SubTaskRepository) Update(ctx context.Context, in model.SubTask) (*model.SubTask, error) {
+	owner, err := ownerID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	d := dto.SubTaskFromModel(in)
-	if err := r.db.Save(&d).Error; err != nil {
+	d.Version = in.Version + 1
+
+	err = r.db.Transaction(func(tx *gorm.DB) error {
+		owned := tx.Where("task_id IN ?", ownedTaskIDs(tx, owner))
+		ok, err := bumpVersion(owned, &dto.SubTask{}, in.ID, in.Version)
+		if err != nil {
+			return translateError(err, "sub task", in.ID)
+		}
+		if !ok {
+			var current dto.SubTask
+			if err := owned.First(&current, "id = ?", in.ID).Error; err != nil {
+				return translateError(err, "sub task", in.ID)
+			}
+			res := current.ToModel()
+			return apperr.Aborted(fmt.Sprintf("sub task %d has been modified by another request", in.ID), &res)
+		}
+		if err := tx.Save(&d).Error; err != nil {
+			return translateError(err, "sub task", in.ID)
+		}
+		return nil
+	})
+	if err != nil {
 		return nil, err
 	}
+
 	res := d.ToModel()
 	return &res, nil
 }
 
 func (r *SubTaskRepository) FindByID(ctx context.Context, id uint64) (*model.SubTask, error) {
-	var d dto.SubTask
-	if err := r.db.First(&d, "id = ?", id).Error; err != nil {
+	owner, err := ownerID(ctx)
+	if err != nil {
 		return nil, err
 	}
+
+	var d dto.SubTask
+	if err := r.owned(owner).First(&d, "id = ?", id).Error; err != nil {
+		return nil, translateError(err, "sub task", id)
+	}
 	res := d.ToModel()
 	return &res, nil
 }
+
+func (r *SubTaskRepository) Delete(ctx context.Context, id uint64) error {
+	owner, err := ownerID(ctx)
+	if err != nil {
+		return err
+	}
+
+	res := r.owned(owner).Delete(&dto.SubTask{}, "id = ?", id)
+	if res.Error != nil {
+		return translateError(res.Error, "sub task", id)
+	}
+	if res.RowsAffected == 0 {
+		return apperr.NotFound("sub task", id)
+	}
+	return nil
+}
+
+func (r *SubTaskRepository) Reorder(ctx context.Context, taskID uint64, ids []uint64) error {
+	owner, err := ownerID(ctx)
+	if err != nil {
+		return err
+	}
+
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		for i, id := range ids {
+			err := tx.Model(&dto.SubTask{}).
+				Where("id = ? AND task_id = ?", id, taskID).
+				Where("task_id IN ?", ownedTaskIDs(tx, owner)).
+				UpdateColumn("position", i+1).Error
+			if err != nil {
+				return translateError(err, "sub task", taskID)
+			}
+		}
+		return nil
+	})
+}
+
+// owned restricts queries to the sub tasks of owner's tasks.
+func (r *SubTaskRepository) owned(owner uint64) *gorm.DB {
+	return r.db.Where("task_id IN ?", ownedTaskIDs(r.db, owner))
+}
@@ -0,0 +1,136 @@
+package store
+
+import (
+	"context"
+	"regexp"
+	"testing"
+	"time"
+
+	"backend/domain/apperr"
+	"backend/domain/auth"
+	"backend/domain/model"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/jinzhu/gorm"
+)
+
+const testUserID = uint64(1)
+
+func newTestDB(t *testing.T) (*gorm.DB, sqlmock.Sqlmock) {
+	t.Helper()
+
+	sqlDB, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("failed to open sqlmock: %v", err)
+	}
+	db, err := gorm.Open("mysql", sqlDB)
+	if err != nil {
+		t.Fatalf("failed to open gorm: %v", err)
+	}
+	t.Cleanup(func() { db.Close() })
+	return db, mock
+}
+
+// ownedLiveTasks is the subquery every sub task statement is scoped by.
+const ownedLiveTasks = "task_id IN (SELECT id FROM `tasks` WHERE (user_id = ? AND deleted_at IS NULL))"
+
+func TestSubTaskRepository_Create(t *testing.T) {
+	t.Parallel()
+
+	ctx := auth.WithUserID(context.Background(), testUserID)
+	now := time.Now()
+	lockParent := "SELECT * FROM `tasks` WHERE `tasks`.`deleted_at` IS NULL AND ((user_id = ?) AND (id = ?)) ORDER BY `tasks`.`id` ASC LIMIT 1 FOR UPDATE"
+
+	t.Run("appends after the last position while the parent is locked", func(t *testing.T) {
+		t.Parallel()
+
+		db, mock := newTestDB(t)
+		mock.ExpectBegin()
+		mock.ExpectQuery(regexp.QuoteMeta(lockParent)).
+			WithArgs(testUserID, uint64(5)).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "user_id", "title", "created_at", "updated_at"}).AddRow(5, testUserID, "task", now, now))
+		mock.ExpectQuery(regexp.QuoteMeta("SELECT COALESCE(MAX(position), 0) AS position FROM `sub_tasks` WHERE (task_id = ?)")).
+			WithArgs(uint64(5)).
+			WillReturnRows(sqlmock.NewRows([]string{"position"}).AddRow(2))
+		mock.ExpectExec(regexp.QuoteMeta("INSERT INTO `sub_tasks`")).
+			WillReturnResult(sqlmock.NewResult(9, 1))
+		mock.ExpectCommit()
+
+		res, err := NewSubTaskRepository(db).Create(ctx, model.SubTask{TaskID: 5, Title: "sub task"})
+		if err != nil {
+			t.Fatalf("Create returned error: %v", err)
+		}
+		if res.ID != 9 || res.Position != 3 {
+			t.Fatalf("Create = id %d at position %d, want id 9 at position 3", res.ID, res.Position)
+		}
+		if err := mock.ExpectationsWereMet(); err != nil {
+			t.Fatalf("unexpected queries: %v", err)
+		}
+	})
+
+	t.Run("trashed or foreign parent", func(t *testing.T) {
+		t.Parallel()
+
+		db, mock := newTestDB(t)
+		mock.ExpectBegin()
+		mock.ExpectQuery(regexp.QuoteMeta(lockParent)).
+			WithArgs(testUserID, uint64(5)).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}))
+		mock.ExpectRollback()
+
+		_, err := NewSubTaskRepository(db).Create(ctx, model.SubTask{TaskID: 5, Title: "sub task"})
+		if !apperr.IsNotFound(err) {
+			t.Fatalf("Create error = %v, want NotFound", err)
+		}
+		if err := mock.ExpectationsWereMet(); err != nil {
+			t.Fatalf("unexpected queries: %v", err)
+		}
+	})
+}
+
+// TestSubTaskRepository_Update_NotOwned verifies that the version check only matches sub tasks
+// of the caller's live tasks, so a sub task of anyone else's task is neither changed nor shown.
+func TestSubTaskRepository_Update_NotOwned(t *testing.T) {
+	t.Parallel()
+
+	db, mock := newTestDB(t)
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta("UPDATE `sub_tasks` SET `version` = version + 1 WHERE ("+ownedLiveTasks+") AND (id = ? AND version = ?)")).
+		WithArgs(testUserID, uint64(3), int32(1)).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `sub_tasks` WHERE ("+ownedLiveTasks+") AND (id = ?)")).
+		WithArgs(testUserID, uint64(3)).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}))
+	mock.ExpectRollback()
+
+	ctx := auth.WithUserID(context.Background(), testUserID)
+	_, err := NewSubTaskRepository(db).Update(ctx, model.SubTask{ID: 3, TaskID: 5, Title: "sub task", Version: 1})
+	if !apperr.IsNotFound(err) {
+		t.Fatalf("Update error = %v, want NotFound", err)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Fatalf("unexpected queries: %v", err)
+	}
+}
+
+// TestSubTaskRepository_ListByTaskIDs_LiveTasksOnly verifies that sub tasks of trashed tasks are not listed.
+func TestSubTaskRepository_ListByTaskIDs_LiveTasksOnly(t *testing.T) {
+	t.Parallel()
+
+	db, mock := newTestDB(t)
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `sub_tasks` WHERE ("+ownedLiveTasks+") AND (task_id IN (?,?))")).
+		WithArgs(testUserID, uint64(1), uint64(2)).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "task_id", "title"}).AddRow(1, 1, "sub task"))
+
+	ctx := auth.WithUserID(context.Background(), testUserID)
+	res, err := NewSubTaskRepository(db).ListByTaskIDs(ctx, []uint64{1, 2})
+	if err != nil {
+		t.Fatalf("ListByTaskIDs returned error: %v", err)
+	}
+	if len(res[1]) != 1 || len(res[2]) != 0 {
+		t.Fatalf("ListByTaskIDs = %v, want one sub task of task 1", res)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Fatalf("unexpected queries: %v", err)
+	}
+}
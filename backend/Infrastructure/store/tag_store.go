@@ -0,0 +1,134 @@
+package store
+
+import (
+	"context"
+
+	"backend/Infrastructure/store/dto"
+	"backend/domain/apperr"
+	"backend/domain/model"
+	"backend/domain/repository"
+
+	"github.com/jinzhu/gorm"
+)
+
+// TagRepository implements tag-specific persistence.
+type TagRepository struct {
+	db *gorm.DB
+}
+
+// NewTagRepository creates a TagRepository.
+func NewTagRepository(db *gorm.DB) repository.TagRepository {
+	return &TagRepository{db: db}
+}
+
+// ListTags returns the tags of the calling user ordered by name.
+func (r *TagRepository) ListTags(ctx context.Context) ([]model.Tag, error) {
+	owner, err := ownerID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var tagDTOs []dto.Tag
+	if err := r.owned(owner).Order("name ASC").Find(&tagDTOs).Error; err != nil {
+		return nil, translateError(err, "tag", 0)
+	}
+
+	return toModelTags(tagDTOs), nil
+}
+
+// FindTagsByIDs returns the calling user's tags among ids.
+func (r *TagRepository) FindTagsByIDs(ctx context.Context, ids []uint64) ([]model.Tag, error) {
+	owner, err := ownerID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(ids) == 0 {
+		return []model.Tag{}, nil
+	}
+
+	var tagDTOs []dto.Tag
+	if err := r.owned(owner).Where("id IN (?)", ids).Find(&tagDTOs).Error; err != nil {
+		return nil, translateError(err, "tag", 0)
+	}
+
+	return toModelTags(tagDTOs), nil
+}
+
+// FindTagByID retrieves one of the calling user's tags by its identifier.
+func (r *TagRepository) FindTagByID(ctx context.Context, id uint64) (*model.Tag, error) {
+	owner, err := ownerID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var d dto.Tag
+	if err := r.owned(owner).First(&d, "id = ?", id).Error; err != nil {
+		return nil, translateError(err, "tag", id)
+	}
+	res := d.ToModel()
+	return &res, nil
+}
+
+// CreateTag persists a new tag owned by the calling user.
+func (r *TagRepository) CreateTag(ctx context.Context, in model.Tag) (*model.Tag, error) {
+	owner, err := ownerID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	d := dto.TagFromModel(in)
+	d.UserID = owner
+	if err := r.db.Create(&d).Error; err != nil {
+		return nil, translateError(err, "tag", 0)
+	}
+	res := d.ToModel()
+	return &res, nil
+}
+
+// UpdateTag persists changes to a tag.
+// Callers load the tag through FindTagByID first, which checks its owner.
+func (r *TagRepository) UpdateTag(ctx context.Context, in model.Tag) (*model.Tag, error) {
+	owner, err := ownerID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	d := dto.TagFromModel(in)
+	d.UserID = owner
+	if err := r.db.Save(&d).Error; err != nil {
+		return nil, translateError(err, "tag", in.ID)
+	}
+	res := d.ToModel()
+	return &res, nil
+}
+
+// DeleteTag removes one of the calling user's tags. Its task associations go with it
+// through ON DELETE CASCADE.
+func (r *TagRepository) DeleteTag(ctx context.Context, id uint64) error {
+	owner, err := ownerID(ctx)
+	if err != nil {
+		return err
+	}
+
+	res := r.owned(owner).Delete(&dto.Tag{}, "id = ?", id)
+	if res.Error != nil {
+		return translateError(res.Error, "tag", id)
+	}
+	if res.RowsAffected == 0 {
+		return apperr.NotFound("tag", id)
+	}
+	return nil
+}
+
+// owned restricts queries to the tags of owner.
+func (r *TagRepository) owned(owner uint64) *gorm.DB {
+	return r.db.Where("user_id = ?", owner)
+}
+
+func toModelTags(tagDTOs []dto.Tag) []model.Tag {
+	tags := make([]model.Tag, 0, len(tagDTOs))
+	for _, t := range tagDTOs {
+		tags = append(tags, t.ToModel())
+	}
+	return tags
+}
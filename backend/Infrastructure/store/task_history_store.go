@@ -0,0 +1,63 @@
+package store
+
+import (
+	"context"
+
+	"backend/Infrastructure/store/dto"
+	"backend/domain/model"
+	"backend/domain/repository"
+
+	"github.com/jinzhu/gorm"
+)
+
+// TaskHistoryRepository implements repository.TaskHistoryRepository on the task_events table.
+type TaskHistoryRepository struct {
+	db *gorm.DB
+}
+
+// NewTaskHistoryRepository creates a TaskHistoryRepository.
+func NewTaskHistoryRepository(db *gorm.DB) repository.TaskHistoryRepository {
+	return &TaskHistoryRepository{db: db}
+}
+
+// Append records entries as changes made by the calling user.
+func (r *TaskHistoryRepository) Append(ctx context.Context, entries []model.TaskHistoryEntry) error {
+	owner, err := ownerID(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, e := range entries {
+		d := dto.TaskHistoryEntryFromModel(e)
+		d.ID = 0
+		d.UserID = owner
+		if err := r.db.Create(&d).Error; err != nil {
+			return translateError(err, "task history", 0)
+		}
+	}
+	return nil
+}
+
+// ListByTaskID returns the history of one of the calling user's tasks, most recent first.
+func (r *TaskHistoryRepository) ListByTaskID(ctx context.Context, taskID uint64) ([]model.TaskHistoryEntry, error) {
+	owner, err := ownerID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var entryDTOs []dto.TaskHistoryEntry
+	err = r.db.
+		Where("task_id IN ?", ownedTaskIDsWithTrash(r.db, owner)).
+		Where("task_id = ?", taskID).
+		Order("id DESC").
+		Find(&entryDTOs).Error
+	if err != nil {
+		return nil, translateError(err, "task history", taskID)
+	}
+
+	entries := make([]model.TaskHistoryEntry, 0, len(entryDTOs))
+	for _, e := range entryDTOs {
+		entries = append(entries, e.ToModel())
+	}
+	return entries, nil
+}
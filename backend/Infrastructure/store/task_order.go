@@ -0,0 +1,156 @@
+package store
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"backend/Infrastructure/store/dto"
+	"backend/domain/repository"
+
+	"github.com/jinzhu/gorm"
+)
+
+// orderColumn describes how a sort key maps onto the tasks table.
+type orderColumn struct {
+	name string
+	// nullable columns sort their NULLs last in both directions.
+	nullable bool
+	// value extracts the key of a row as it is stored in page tokens. Nil stands for NULL.
+	value func(t dto.Task) *string
+	// parse turns a page token value back into a query argument.
+	parse func(s string) (interface{}, error)
+}
+
+var taskOrderColumns = map[repository.TaskOrderField]orderColumn{
+	repository.TaskOrderDueDate: {
+		name:     "due_date",
+		nullable: true,
+		value:    func(t dto.Task) *string { return formatOrderTime(t.DueDate, dateLayout) },
+		parse:    func(s string) (interface{}, error) { return s, nil },
+	},
+	repository.TaskOrderCreatedAt: {
+		name:  "created_at",
+		value: func(t dto.Task) *string { return formatOrderTime(&t.CreatedAt, time.RFC3339Nano) },
+		parse: parseOrderTime,
+	},
+	repository.TaskOrderUpdatedAt: {
+		name:  "updated_at",
+		value: func(t dto.Task) *string { return formatOrderTime(&t.UpdatedAt, time.RFC3339Nano) },
+		parse: parseOrderTime,
+	},
+	repository.TaskOrderTitle: {
+		name:  "title",
+		value: func(t dto.Task) *string { return &t.Title },
+		parse: func(s string) (interface{}, error) { return s, nil },
+	},
+	repository.TaskOrderCompletedAt: {
+		name:     "completed_at",
+		nullable: true,
+		value:    func(t dto.Task) *string { return formatOrderTime(t.CompletedAt, time.RFC3339Nano) },
+		parse:    parseOrderTime,
+	},
+	repository.TaskOrderPriority: {
+		name:  "priority",
+		value: func(t dto.Task) *string { return formatOrderInt(t.Priority) },
+		parse: func(s string) (interface{}, error) { return strconv.Atoi(s) },
+	},
+}
+
+const dateLayout = "2006-01-02"
+
+// orderTasks sorts query by order, breaking ties by id.
+func orderTasks(query *gorm.DB, order []repository.TaskOrder) *gorm.DB {
+	for _, o := range order {
+		col := taskOrderColumns[o.Field]
+		if col.nullable {
+			query = query.Order(col.name + " IS NULL")
+		}
+		if o.Desc {
+			query = query.Order(col.name + " DESC")
+		} else {
+			query = query.Order(col.name + " ASC")
+		}
+	}
+	return query.Order("id ASC")
+}
+
+// orderValues captures the sort keys of t so that a page token can resume right after it.
+func orderValues(t dto.Task, order []repository.TaskOrder) []*string {
+	if len(order) == 0 {
+		return nil
+	}
+	values := make([]*string, 0, len(order))
+	for _, o := range order {
+		values = append(values, taskOrderColumns[o.Field].value(t))
+	}
+	return values
+}
+
+// afterToken restricts query to the rows sorting after the row the token was taken from.
+// For keys k1, k2 and the id tie-breaker this is
+// k1 beyond v1 OR (k1 = v1 AND k2 beyond v2) OR (k1 = v1 AND k2 = v2 AND id > last id),
+// where NULLs, sorting last, are beyond every value and nothing is beyond a NULL.
+func afterToken(query *gorm.DB, order []repository.TaskOrder, token pageToken) (*gorm.DB, error) {
+	if len(token.Values) != len(order) {
+		return nil, repository.ErrInvalidPageToken
+	}
+
+	var (
+		terms   []string
+		args    []interface{}
+		eqs     []string
+		eqArgs  []interface{}
+		combine = func(last string, lastArgs ...interface{}) {
+			terms = append(terms, "("+strings.Join(append(append([]string{}, eqs...), last), " AND ")+")")
+			args = append(append(args, eqArgs...), lastArgs...)
+		}
+	)
+	for i, o := range order {
+		col := taskOrderColumns[o.Field]
+		if token.Values[i] == nil {
+			if !col.nullable {
+				return nil, repository.ErrInvalidPageToken
+			}
+			eqs = append(eqs, col.name+" IS NULL")
+			continue
+		}
+
+		v, err := col.parse(*token.Values[i])
+		if err != nil {
+			return nil, repository.ErrInvalidPageToken
+		}
+		op := ">"
+		if o.Desc {
+			op = "<"
+		}
+		beyond := fmt.Sprintf("%s %s ?", col.name, op)
+		if col.nullable {
+			beyond = fmt.Sprintf("(%s OR %s IS NULL)", beyond, col.name)
+		}
+		combine(beyond, v)
+		eqs = append(eqs, col.name+" = ?")
+		eqArgs = append(eqArgs, v)
+	}
+	combine("id > ?", token.LastID)
+
+	return query.Where(strings.Join(terms, " OR "), args...), nil
+}
+
+func formatOrderTime(t *time.Time, layout string) *string {
+	if t == nil {
+		return nil
+	}
+	s := t.Format(layout)
+	return &s
+}
+
+func formatOrderInt(n int) *string {
+	s := strconv.Itoa(n)
+	return &s
+}
+
+func parseOrderTime(s string) (interface{}, error) {
+	return time.Parse(time.RFC3339Nano, s)
+}
@@ -0,0 +1,146 @@
+package store
+
+import (
+	"context"
+	"time"
+
+	"backend/Infrastructure/store/dto"
+	"backend/domain/model"
+	"backend/domain/repository"
+
+	"github.com/jinzhu/gorm"
+)
+
+// TaskStatsRepository implements repository.TaskStatsRepository with aggregate queries on the tasks table.
+type TaskStatsRepository struct {
+	db *gorm.DB
+}
+
+// NewTaskStatsRepository creates a TaskStatsRepository.
+func NewTaskStatsRepository(db *gorm.DB) repository.TaskStatsRepository {
+	return &TaskStatsRepository{db: db}
+}
+
+// CountByCategory counts open tasks and tasks completed in the range per category.
+func (r *TaskStatsRepository) CountByCategory(ctx context.Context, filter repository.TaskStatsFilter) ([]model.CategoryTaskCount, error) {
+	query, err := r.tasks(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	var rows []struct {
+		CategoryID     *uint64
+		OpenCount      int32
+		CompletedCount int32
+	}
+	err = query.
+		Select("category_id, "+
+			"COALESCE(SUM(completed = 0), 0) AS open_count, "+
+			"COALESCE(SUM(completed <> 0 AND completed_at >= ?), 0) AS completed_count", filter.CompletedSince).
+		Group("category_id").
+		Having("open_count > 0 OR completed_count > 0").
+		Order("category_id ASC").
+		Scan(&rows).Error
+	if err != nil {
+		return nil, translateError(err, "task", 0)
+	}
+
+	counts := make([]model.CategoryTaskCount, 0, len(rows))
+	for _, row := range rows {
+		c := model.CategoryTaskCount{Open: row.OpenCount, Completed: row.CompletedCount}
+		if row.CategoryID != nil {
+			c.CategoryID = *row.CategoryID
+		}
+		counts = append(counts, c)
+	}
+	return counts, nil
+}
+
+// CountOverdue counts open tasks due before today.
+func (r *TaskStatsRepository) CountOverdue(ctx context.Context, filter repository.TaskStatsFilter, today time.Time) (int32, error) {
+	query, err := r.tasks(ctx, filter)
+	if err != nil {
+		return 0, err
+	}
+
+	var n int32
+	err = query.
+		Where("completed = 0 AND due_date < ?", today.Format("2006-01-02")).
+		Count(&n).Error
+	if err != nil {
+		return 0, translateError(err, "task", 0)
+	}
+	return n, nil
+}
+
+// CompletedPerDay counts the tasks completed in the range per day, oldest first.
+func (r *TaskStatsRepository) CompletedPerDay(ctx context.Context, filter repository.TaskStatsFilter) ([]model.DailyCompletionCount, error) {
+	query, err := r.tasks(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	var rows []struct {
+		Day       time.Time
+		Completed int32
+	}
+	err = query.
+		Select("DATE(completed_at) AS day, COUNT(*) AS completed").
+		Where("completed <> 0 AND completed_at >= ?", filter.CompletedSince).
+		Group("DATE(completed_at)").
+		Order("day ASC").
+		Scan(&rows).Error
+	if err != nil {
+		return nil, translateError(err, "task", 0)
+	}
+
+	days := make([]model.DailyCompletionCount, 0, len(rows))
+	for _, row := range rows {
+		days = append(days, model.DailyCompletionCount{Date: row.Day, Completed: row.Completed})
+	}
+	return days, nil
+}
+
+// AverageCompletionTime averages the time from creation to completion of the tasks completed in the range.
+func (r *TaskStatsRepository) AverageCompletionTime(ctx context.Context, filter repository.TaskStatsFilter) (*time.Duration, error) {
+	query, err := r.tasks(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	var row struct {
+		Seconds *float64
+	}
+	err = query.
+		Select("AVG(TIMESTAMPDIFF(SECOND, created_at, completed_at)) AS seconds").
+		Where("completed <> 0 AND completed_at >= ?", filter.CompletedSince).
+		Scan(&row).Error
+	if err != nil {
+		return nil, translateError(err, "task", 0)
+	}
+	if row.Seconds == nil {
+		return nil, nil
+	}
+
+	avg := time.Duration(*row.Seconds * float64(time.Second))
+	return &avg, nil
+}
+
+// tasks starts a query on the calling user's tasks that are not in the trash, limited to
+// the category of filter when it names one.
+func (r *TaskStatsRepository) tasks(ctx context.Context, filter repository.TaskStatsFilter) (*gorm.DB, error) {
+	owner, err := ownerID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	query := r.db.Model(&dto.Task{}).Where("user_id = ?", owner)
+	switch {
+	case filter.CategoryID == nil:
+	case *filter.CategoryID == 0:
+		query = query.Where("category_id IS NULL")
+	default:
+		query = query.Where("category_id = ?", *filter.CategoryID)
+	}
+	return query, nil
+}
@@ -0,0 +1,131 @@
+package store
+
+import (
+	"context"
+	"reflect"
+	"regexp"
+	"testing"
+	"time"
+
+	"backend/domain/auth"
+	"backend/domain/model"
+	"backend/domain/repository"
+
+	"github.com/DATA-DOG/go-sqlmock"
+)
+
+func TestTaskStatsRepository(t *testing.T) {
+	t.Parallel()
+
+	ctx := auth.WithUserID(context.Background(), testUserID)
+	since := time.Date(2025, time.March, 1, 0, 0, 0, 0, time.UTC)
+	today := time.Date(2025, time.March, 7, 0, 0, 0, 0, time.UTC)
+	noCategory := uint64(0)
+	categoryID := uint64(3)
+
+	t.Run("counts per category", func(t *testing.T) {
+		t.Parallel()
+
+		db, mock := newTestDB(t)
+		mock.ExpectQuery(regexp.QuoteMeta("SELECT category_id, "+
+			"COALESCE(SUM(completed = 0), 0) AS open_count, "+
+			"COALESCE(SUM(completed <> 0 AND completed_at >= ?), 0) AS completed_count "+
+			"FROM `tasks` WHERE `tasks`.`deleted_at` IS NULL AND ((user_id = ?)) "+
+			"GROUP BY category_id HAVING (open_count > 0 OR completed_count > 0) ORDER BY category_id ASC")).
+			WithArgs(since, testUserID).
+			WillReturnRows(sqlmock.NewRows([]string{"category_id", "open_count", "completed_count"}).AddRow(nil, 1, 0).AddRow(3, 2, 4))
+
+		res, err := NewTaskStatsRepository(db).CountByCategory(ctx, repository.TaskStatsFilter{CompletedSince: since})
+		if err != nil {
+			t.Fatalf("CountByCategory returned error: %v", err)
+		}
+		want := []model.CategoryTaskCount{{CategoryID: 0, Open: 1}, {CategoryID: 3, Open: 2, Completed: 4}}
+		if !reflect.DeepEqual(res, want) {
+			t.Fatalf("CountByCategory = %v, want %v", res, want)
+		}
+		if err := mock.ExpectationsWereMet(); err != nil {
+			t.Fatalf("unexpected queries: %v", err)
+		}
+	})
+
+	t.Run("counts overdue tasks without a category", func(t *testing.T) {
+		t.Parallel()
+
+		db, mock := newTestDB(t)
+		mock.ExpectQuery(regexp.QuoteMeta("SELECT count(*) FROM `tasks` WHERE `tasks`.`deleted_at` IS NULL AND ((user_id = ?) AND (category_id IS NULL) AND (completed = 0 AND due_date < ?))")).
+			WithArgs(testUserID, "2025-03-07").
+			WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(2))
+
+		n, err := NewTaskStatsRepository(db).CountOverdue(ctx, repository.TaskStatsFilter{CategoryID: &noCategory, CompletedSince: since}, today)
+		if err != nil || n != 2 {
+			t.Fatalf("CountOverdue = %d, %v, want 2", n, err)
+		}
+		if err := mock.ExpectationsWereMet(); err != nil {
+			t.Fatalf("unexpected queries: %v", err)
+		}
+	})
+
+	t.Run("counts completions per day", func(t *testing.T) {
+		t.Parallel()
+
+		db, mock := newTestDB(t)
+		mock.ExpectQuery(regexp.QuoteMeta("SELECT DATE(completed_at) AS day, COUNT(*) AS completed FROM `tasks` "+
+			"WHERE `tasks`.`deleted_at` IS NULL AND ((user_id = ?) AND (category_id = ?) AND (completed <> 0 AND completed_at >= ?)) "+
+			"GROUP BY DATE(completed_at) ORDER BY day ASC")).
+			WithArgs(testUserID, categoryID, since).
+			WillReturnRows(sqlmock.NewRows([]string{"day", "completed"}).AddRow(since, 1).AddRow(today, 3))
+
+		res, err := NewTaskStatsRepository(db).CompletedPerDay(ctx, repository.TaskStatsFilter{CategoryID: &categoryID, CompletedSince: since})
+		if err != nil {
+			t.Fatalf("CompletedPerDay returned error: %v", err)
+		}
+		want := []model.DailyCompletionCount{{Date: since, Completed: 1}, {Date: today, Completed: 3}}
+		if !reflect.DeepEqual(res, want) {
+			t.Fatalf("CompletedPerDay = %v, want %v", res, want)
+		}
+		if err := mock.ExpectationsWereMet(); err != nil {
+			t.Fatalf("unexpected queries: %v", err)
+		}
+	})
+
+	t.Run("averages completion time", func(t *testing.T) {
+		t.Parallel()
+
+		tests := []struct {
+			name    string
+			seconds interface{}
+			want    *time.Duration
+		}{
+			{name: "completed tasks", seconds: 5400.0, want: durationPtr(90 * time.Minute)},
+			{name: "no completed tasks", seconds: nil},
+		}
+
+		for _, tt := range tests {
+			tt := tt
+			t.Run(tt.name, func(t *testing.T) {
+				t.Parallel()
+
+				db, mock := newTestDB(t)
+				mock.ExpectQuery(regexp.QuoteMeta("SELECT AVG(TIMESTAMPDIFF(SECOND, created_at, completed_at)) AS seconds FROM `tasks` "+
+					"WHERE `tasks`.`deleted_at` IS NULL AND ((user_id = ?) AND (completed <> 0 AND completed_at >= ?))")).
+					WithArgs(testUserID, since).
+					WillReturnRows(sqlmock.NewRows([]string{"seconds"}).AddRow(tt.seconds))
+
+				avg, err := NewTaskStatsRepository(db).AverageCompletionTime(ctx, repository.TaskStatsFilter{CompletedSince: since})
+				if err != nil {
+					t.Fatalf("AverageCompletionTime returned error: %v", err)
+				}
+				if !reflect.DeepEqual(avg, tt.want) {
+					t.Fatalf("AverageCompletionTime = %v, want %v", avg, tt.want)
+				}
+				if err := mock.ExpectationsWereMet(); err != nil {
+					t.Fatalf("unexpected queries: %v", err)
+				}
+			})
+		}
+	})
+}
+
+func durationPtr(d time.Duration) *time.Duration {
+	return &d
+}
@@ -2,13 +2,15 @@ package store
 
 import (
 	"context"
+	"fmt"
+	"time"
 
 	"backend/Infrastructure/store/dto"
+	"backend/domain/apperr"
 	"backend/domain/model"
 	"backend/domain/repository"
 
 	"github.com/jinzhu/gorm"
-	"github.com/labstack/gommon/log"
 )
 
 // TaskRepository implements domain.TaskRepository using GORM.
@@ -23,70 +25,438 @@ func NewTaskRepository(db *gorm.DB) repository.TaskRepository {
 
 // FindAll retrieves every task, filtered by provided criteria.
 func (r *TaskRepository) FindAll(ctx context.Context, filter repository.TaskFilter) ([]model.Task, error) {
-	query := r.db
-	if filter.CategoryID != nil {
-		query = query.Where("category_id = ?", *filter.CategoryID)
-	}
-	if filter.DueDateFrom != nil {
-		query = query.Where("due_date >= ?", filter.DueDateFrom.Format("2006-01-02"))
-	}
-	if filter.DueDateTo != nil {
-		query = query.Where("due_date <= ?", filter.DueDateTo.Format("2006-01-02"))
-	}
-	log.Debugf("IncompleteOnly: %v", filter.IncompleteOnly)
-	if filter.IncompleteOnly != nil && *filter.IncompleteOnly {
-		query = query.Where("completed = ?", 0)
+	owner, err := ownerID(ctx)
+	if err != nil {
+		return nil, err
 	}
 
 	var taskDTOs []dto.Task
-	if err := query.Find(&taskDTOs).Error; err != nil {
-		return nil, err
+	if err := orderTasks(applyTaskFilter(r.owned(owner), filter), filter.OrderBy).Find(&taskDTOs).Error; err != nil {
+		return nil, translateError(err, "task", 0)
 	}
 
 	tasks := make([]model.Task, 0, len(taskDTOs))
 	for _, t := range taskDTOs {
 		tasks = append(tasks, t.ToModel())
 	}
+	if err := attachTaskDetails(r.db, tasks); err != nil {
+		return nil, err
+	}
 
 	return tasks, nil
 }
 
+// FindPage retrieves one page of tasks ordered by id, resuming after the page token.
+func (r *TaskRepository) FindPage(ctx context.Context, filter repository.TaskFilter, page repository.PageRequest) (*repository.TaskPage, error) {
+	owner, err := ownerID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	token, err := decodePageToken(page.Token)
+	if err != nil {
+		return nil, translateError(err, "task", 0)
+	}
+
+	query := applyTaskFilter(r.owned(owner).Model(&dto.Task{}), filter)
+
+	var total int
+	if err := query.Count(&total).Error; err != nil {
+		return nil, translateError(err, "task", 0)
+	}
+
+	if page.Token != "" {
+		if query, err = afterToken(query, filter.OrderBy, token); err != nil {
+			return nil, err
+		}
+	}
+
+	// 次ページの有無を判定するために 1 件多く取得する
+	var taskDTOs []dto.Task
+	err = orderTasks(query, filter.OrderBy).
+		Limit(page.Size + 1).
+		Find(&taskDTOs).Error
+	if err != nil {
+		return nil, translateError(err, "task", 0)
+	}
+
+	hasNext := len(taskDTOs) > page.Size
+	if hasNext {
+		taskDTOs = taskDTOs[:page.Size]
+	}
+
+	res := &repository.TaskPage{
+		Tasks:      make([]model.Task, 0, len(taskDTOs)),
+		Cursors:    make([]string, 0, len(taskDTOs)),
+		TotalCount: total,
+	}
+	for _, t := range taskDTOs {
+		res.Tasks = append(res.Tasks, t.ToModel())
+		res.Cursors = append(res.Cursors, encodePageToken(pageToken{LastID: t.ID, Values: orderValues(t, filter.OrderBy)}))
+	}
+	if hasNext {
+		res.NextPageToken = res.Cursors[len(res.Cursors)-1]
+	}
+	if err := attachTaskDetails(r.db, res.Tasks); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// Search ranks the owner's tasks against query using the ngram FULLTEXT indexes on
+// tasks(title, note) and sub_tasks(title). A task scores the relevance of its own
+// text plus that of its best matching subtask.
+func (r *TaskRepository) Search(ctx context.Context, query string, page repository.PageRequest) (*repository.TaskSearchPage, error) {
+	owner, err := ownerID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	token, err := decodePageToken(page.Token)
+	if err != nil {
+		return nil, translateError(err, "task", 0)
+	}
+
+	subTaskScores := r.db.Table("sub_tasks").
+		Select("task_id, MAX(MATCH(title) AGAINST (?)) AS score", query).
+		Where("MATCH(title) AGAINST (?)", query).
+		Group("task_id").
+		SubQuery()
+	matches := r.owned(owner).Model(&dto.Task{}).
+		Joins("LEFT JOIN ? AS st ON st.task_id = tasks.id", subTaskScores).
+		Where("MATCH(tasks.title, tasks.note) AGAINST (?) OR st.task_id IS NOT NULL", query)
+
+	var total int
+	if err := matches.Count(&total).Error; err != nil {
+		return nil, translateError(err, "task", 0)
+	}
+
+	// 次ページの有無を判定するために 1 件多く取得する
+	rows, err := matches.
+		Select("tasks.*, MATCH(tasks.title, tasks.note) AGAINST (?) + COALESCE(st.score, 0) AS score", query).
+		Order("score DESC, tasks.id DESC").
+		Offset(token.Offset).
+		Limit(page.Size + 1).
+		Rows()
+	if err != nil {
+		return nil, translateError(err, "task", 0)
+	}
+	defer rows.Close()
+
+	var hits []taskSearchRow
+	for rows.Next() {
+		var hit taskSearchRow
+		if err := r.db.ScanRows(rows, &hit); err != nil {
+			return nil, translateError(err, "task", 0)
+		}
+		hits = append(hits, hit)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, translateError(err, "task", 0)
+	}
+
+	hasNext := len(hits) > page.Size
+	if hasNext {
+		hits = hits[:page.Size]
+	}
+
+	tasks := make([]model.Task, 0, len(hits))
+	for _, hit := range hits {
+		tasks = append(tasks, hit.Task.ToModel())
+	}
+	if err := attachTaskDetails(r.db, tasks); err != nil {
+		return nil, err
+	}
+
+	res := &repository.TaskSearchPage{
+		Results:    make([]model.TaskSearchResult, 0, len(hits)),
+		Cursors:    make([]string, 0, len(hits)),
+		TotalCount: total,
+	}
+	for i, hit := range hits {
+		res.Results = append(res.Results, model.TaskSearchResult{Task: tasks[i], Score: hit.Score})
+		res.Cursors = append(res.Cursors, encodePageToken(pageToken{Offset: token.Offset + i + 1}))
+	}
+	if hasNext {
+		res.NextPageToken = res.Cursors[len(res.Cursors)-1]
+	}
+
+	return res, nil
+}
+
+// taskSearchRow is a task row selected together with its relevance.
+type taskSearchRow struct {
+	dto.Task
+	Score float64 `gorm:"column:score"`
+}
+
 // FindByID retrieves a task by its identifier.
 func (r *TaskRepository) FindByID(ctx context.Context, id uint64) (*model.Task, error) {
-	var task model.Task
-	if err := r.db.First(&task, "id = ?", id).Error; err != nil {
+	owner, err := ownerID(ctx)
+	if err != nil {
 		return nil, err
 	}
 
-	return &task, nil
+	var d dto.Task
+	if err := r.owned(owner).First(&d, "id = ?", id).Error; err != nil {
+		return nil, translateError(err, "task", id)
+	}
+	tasks := []model.Task{d.ToModel()}
+	if err := attachTaskDetails(r.db, tasks); err != nil {
+		return nil, err
+	}
+
+	return &tasks[0], nil
 }
 
-// Create persists a new task entity.
+// Create persists a new task entity owned by the calling user.
 func (r *TaskRepository) Create(ctx context.Context, in model.Task) (*model.Task, error) {
+	owner, err := ownerID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	d := dto.FromModel(in)
+	d.UserID = owner
+	d.Version = 1
 
-	if err := r.db.Create(&d).Error; err != nil {
+	err = r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(&d).Error; err != nil {
+			return translateError(err, "task", 0)
+		}
+		return replaceTaskTags(tx, d.ID, in.TagIDs)
+	})
+	if err != nil {
 		return nil, err
 	}
 	res := d.ToModel()
+	res.TagIDs = in.TagIDs
 
 	return &res, nil
 }
 
-// Update persists updates to an existing task entity.
-func (r *TaskRepository) Update(ctx context.Context, in model.Task) (*model.Task, error) {
+// CreateWithSubTasks persists a new task and its subtasks in one transaction.
+// Subtasks keep the positions they are given.
+func (r *TaskRepository) CreateWithSubTasks(ctx context.Context, in model.Task) (*model.Task, error) {
+	owner, err := ownerID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	d := dto.FromModel(in)
+	d.UserID = owner
+	d.Version = 1
+
+	var res model.Task
+	err = r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(&d).Error; err != nil {
+			return translateError(err, "task", 0)
+		}
+		if err := replaceTaskTags(tx, d.ID, in.TagIDs); err != nil {
+			return err
+		}
+		res = d.ToModel()
+		res.TagIDs = in.TagIDs
 
-	err := r.db.Save(&d).Error
+		for _, st := range in.SubTasks {
+			sd := dto.SubTaskFromModel(st)
+			sd.ID = 0
+			sd.TaskID = d.ID
+			sd.Version = 1
+			if err := tx.Create(&sd).Error; err != nil {
+				return translateError(err, "sub task", 0)
+			}
+			res.SubTasks = append(res.SubTasks, sd.ToModel())
+			res.SubTaskTotal++
+			if sd.Completed != 0 {
+				res.SubTaskCompleted++
+			}
+		}
+		return nil
+	})
 	if err != nil {
 		return nil, err
 	}
 
-	res := d.ToModel()
 	return &res, nil
 }
 
-// Delete removes a task by id.
+// Update persists updates to an existing task entity. in.Version must be the version the task
+// was loaded with; when the task has been updated since, an Aborted error carrying the
+// current task is returned. Other users' tasks are reported as not found.
+func (r *TaskRepository) Update(ctx context.Context, in model.Task) (*model.Task, error) {
+	owner, err := ownerID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	d := dto.FromModel(in)
+	d.UserID = owner
+	d.Version = in.Version + 1
+
+	err = r.db.Transaction(func(tx *gorm.DB) error {
+		ok, err := bumpVersion(tx.Where("user_id = ?", owner), &dto.Task{}, in.ID, in.Version)
+		if err != nil {
+			return translateError(err, "task", in.ID)
+		}
+		if !ok {
+			return staleTask(tx, owner, in.ID)
+		}
+		if err := tx.Save(&d).Error; err != nil {
+			return translateError(err, "task", in.ID)
+		}
+		return replaceTaskTags(tx, in.ID, in.TagIDs)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	tasks := []model.Task{d.ToModel()}
+	tasks[0].TagIDs = in.TagIDs
+	if err := attachSubTaskCounts(r.db, tasks); err != nil {
+		return nil, err
+	}
+	return &tasks[0], nil
+}
+
+// Delete moves a task to the trash by setting deleted_at.
 func (r *TaskRepository) Delete(ctx context.Context, id uint64) error {
-	return r.db.Delete(&model.Task{}, "id = ?", id).Error
+	owner, err := ownerID(ctx)
+	if err != nil {
+		return err
+	}
+
+	res := r.owned(owner).Delete(&dto.Task{}, "id = ?", id)
+	if res.Error != nil {
+		return translateError(res.Error, "task", id)
+	}
+	if res.RowsAffected == 0 {
+		return apperr.NotFound("task", id)
+	}
+	return nil
+}
+
+// FindDeleted retrieves every trashed task, most recently deleted first.
+func (r *TaskRepository) FindDeleted(ctx context.Context) ([]model.Task, error) {
+	owner, err := ownerID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var taskDTOs []dto.Task
+	err = r.owned(owner).Unscoped().
+		Where("deleted_at IS NOT NULL").
+		Order("deleted_at DESC").
+		Find(&taskDTOs).Error
+	if err != nil {
+		return nil, translateError(err, "task", 0)
+	}
+
+	tasks := make([]model.Task, 0, len(taskDTOs))
+	for _, t := range taskDTOs {
+		tasks = append(tasks, t.ToModel())
+	}
+	if err := attachTaskDetails(r.db, tasks); err != nil {
+		return nil, err
+	}
+
+	return tasks, nil
+}
+
+// Restore takes a task out of the trash.
+func (r *TaskRepository) Restore(ctx context.Context, id uint64) (*model.Task, error) {
+	owner, err := ownerID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	res := r.owned(owner).Unscoped().
+		Model(&dto.Task{}).
+		Where("id = ? AND deleted_at IS NOT NULL", id).
+		UpdateColumns(map[string]interface{}{"deleted_at": nil, "version": gorm.Expr("version + 1")})
+	if res.Error != nil {
+		return nil, translateError(res.Error, "task", id)
+	}
+	if res.RowsAffected == 0 {
+		return nil, apperr.NotFound("task", id)
+	}
+
+	return r.FindByID(ctx, id)
+}
+
+// Purge permanently removes a trashed task. Its subtasks and tags go with it through ON DELETE
+// CASCADE; its history is kept.
+func (r *TaskRepository) Purge(ctx context.Context, id uint64) error {
+	owner, err := ownerID(ctx)
+	if err != nil {
+		return err
+	}
+
+	res := r.owned(owner).Unscoped().Delete(&dto.Task{}, "id = ? AND deleted_at IS NOT NULL", id)
+	if res.Error != nil {
+		return translateError(res.Error, "task", id)
+	}
+	if res.RowsAffected == 0 {
+		return apperr.NotFound("task", id)
+	}
+	return nil
+}
+
+// FindDeletedBefore returns every task trashed before the cutoff.
+// It serves a background job for all users and is therefore not scoped to an owner.
+func (r *TaskRepository) FindDeletedBefore(ctx context.Context, cutoff time.Time) ([]repository.ExpiredTask, error) {
+	var expired []repository.ExpiredTask
+	err := r.db.Unscoped().
+		Set("gorm:query_option", "FOR UPDATE").
+		Model(&dto.Task{}).
+		Select("id, user_id").
+		Where("deleted_at IS NOT NULL AND deleted_at < ?", cutoff).
+		Order("id ASC").
+		Scan(&expired).Error
+	if err != nil {
+		return nil, translateError(err, "task", 0)
+	}
+	return expired, nil
+}
+
+// staleTask builds the error for an update that lost against a concurrent one.
+func staleTask(tx *gorm.DB, owner, id uint64) error {
+	var current dto.Task
+	if err := tx.Where("user_id = ?", owner).First(&current, "id = ?", id).Error; err != nil {
+		return translateError(err, "task", id)
+	}
+	tasks := []model.Task{current.ToModel()}
+	if err := attachTaskDetails(tx, tasks); err != nil {
+		return err
+	}
+	return apperr.Aborted(fmt.Sprintf("task %d has been modified by another request", id), &tasks[0])
+}
+
+// owned restricts queries to the tasks of owner.
+func (r *TaskRepository) owned(owner uint64) *gorm.DB {
+	return r.db.Where("user_id = ?", owner)
+}
+
+func applyTaskFilter(query *gorm.DB, filter repository.TaskFilter) *gorm.DB {
+	if filter.IDs != nil {
+		query = query.Where("id IN (?)", filter.IDs)
+	}
+	if filter.CategoryID != nil {
+		query = query.Where("category_id = ?", *filter.CategoryID)
+	}
+	if filter.DueDateFrom != nil {
+		query = query.Where("due_date >= ?", filter.DueDateFrom.Format("2006-01-02"))
+	}
+	if filter.DueDateTo != nil {
+		query = query.Where("due_date <= ?", filter.DueDateTo.Format("2006-01-02"))
+	}
+	if filter.IncompleteOnly != nil && *filter.IncompleteOnly {
+		query = query.Where("completed = ?", 0)
+	}
+	if filter.MinPriority != nil {
+		query = query.Where("priority >= ?", *filter.MinPriority)
+	}
+	if len(filter.TagIDs) > 0 {
+		query = query.Where("id IN ?", taggedTaskIDs(query.New(), filter.TagIDs, filter.TagMatch))
+	}
+	return query
 }
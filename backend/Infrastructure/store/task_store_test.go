@@ -0,0 +1,37 @@
+package store
+
+import (
+	"context"
+	"reflect"
+	"regexp"
+	"testing"
+	"time"
+
+	"backend/domain/repository"
+
+	"github.com/DATA-DOG/go-sqlmock"
+)
+
+// TestTaskRepository_FindDeletedBefore verifies that expired tasks of every user are found and
+// locked, so that a concurrent restore cannot race the purge.
+func TestTaskRepository_FindDeletedBefore(t *testing.T) {
+	t.Parallel()
+
+	cutoff := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	db, mock := newTestDB(t)
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT id, user_id FROM `tasks` WHERE (deleted_at IS NOT NULL AND deleted_at < ?) ORDER BY id ASC FOR UPDATE")).
+		WithArgs(cutoff).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "user_id"}).AddRow(1, 7).AddRow(2, 8))
+
+	res, err := NewTaskRepository(db).FindDeletedBefore(context.Background(), cutoff)
+	if err != nil {
+		t.Fatalf("FindDeletedBefore returned error: %v", err)
+	}
+	want := []repository.ExpiredTask{{ID: 1, UserID: 7}, {ID: 2, UserID: 8}}
+	if !reflect.DeepEqual(res, want) {
+		t.Fatalf("FindDeletedBefore = %v, want %v", res, want)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Fatalf("unexpected queries: %v", err)
+	}
+}
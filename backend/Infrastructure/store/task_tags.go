@@ -0,0 +1,60 @@
+package store
+
+import (
+	"backend/Infrastructure/store/dto"
+	"backend/domain/model"
+	"backend/domain/repository"
+
+	"github.com/jinzhu/gorm"
+)
+
+// attachTagIDs fills TagIDs of every task with a single query on task_tags.
+func attachTagIDs(db *gorm.DB, tasks []model.Task) error {
+	if len(tasks) == 0 {
+		return nil
+	}
+
+	ids := make([]uint64, 0, len(tasks))
+	for _, t := range tasks {
+		ids = append(ids, t.ID)
+	}
+
+	var rows []dto.TaskTag
+	if err := db.Where("task_id IN (?)", ids).Order("tag_id ASC").Find(&rows).Error; err != nil {
+		return translateError(err, "task", 0)
+	}
+
+	byTask := make(map[uint64][]uint64, len(tasks))
+	for _, row := range rows {
+		byTask[row.TaskID] = append(byTask[row.TaskID], row.TagID)
+	}
+	for i := range tasks {
+		tasks[i].TagIDs = byTask[tasks[i].ID]
+		if tasks[i].TagIDs == nil {
+			tasks[i].TagIDs = []uint64{}
+		}
+	}
+	return nil
+}
+
+// replaceTaskTags makes tagIDs the complete set of tags of the task.
+func replaceTaskTags(tx *gorm.DB, taskID uint64, tagIDs []uint64) error {
+	if err := tx.Delete(&dto.TaskTag{}, "task_id = ?", taskID).Error; err != nil {
+		return translateError(err, "task", taskID)
+	}
+	for _, tagID := range tagIDs {
+		if err := tx.Create(&dto.TaskTag{TaskID: taskID, TagID: tagID}).Error; err != nil {
+			return translateError(err, "tag", tagID)
+		}
+	}
+	return nil
+}
+
+// taggedTaskIDs is a subquery selecting the ids of tasks carrying the tags according to match.
+func taggedTaskIDs(db *gorm.DB, tagIDs []uint64, match repository.TagMatch) *gorm.SqlExpr {
+	query := db.Table("task_tags").Select("task_id").Where("tag_id IN (?)", tagIDs)
+	if match == repository.TagMatchAll {
+		query = query.Group("task_id").Having("COUNT(DISTINCT tag_id) = ?", len(tagIDs))
+	}
+	return query.SubQuery()
+}
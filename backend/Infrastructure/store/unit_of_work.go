@@ -0,0 +1,33 @@
+package store
+
+import (
+	"context"
+
+	"backend/domain/repository"
+
+	"github.com/jinzhu/gorm"
+)
+
+// UnitOfWork implements repository.UnitOfWork with GORM transactions.
+type UnitOfWork struct {
+	db *gorm.DB
+}
+
+// NewUnitOfWork creates a UnitOfWork.
+func NewUnitOfWork(db *gorm.DB) repository.UnitOfWork {
+	return &UnitOfWork{db: db}
+}
+
+// Do runs fn with repositories bound to a new transaction.
+func (u *UnitOfWork) Do(ctx context.Context, fn func(tx repository.Repositories) error) error {
+	return u.db.Transaction(func(tx *gorm.DB) error {
+		return fn(repository.Repositories{
+			Tasks:       NewTaskRepository(tx),
+			SubTasks:    NewSubTaskRepository(tx),
+			TaskHistory: NewTaskHistoryRepository(tx),
+			Categories:  NewCategoryRepository(tx),
+			Tags:        NewTagRepository(tx),
+			TaskStats:   NewTaskStatsRepository(tx),
+		})
+	})
+}
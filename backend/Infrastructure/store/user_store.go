@@ -0,0 +1,41 @@
+package store
+
+import (
+	"context"
+
+	"backend/Infrastructure/store/dto"
+	"backend/domain/model"
+	"backend/domain/repository"
+
+	"github.com/jinzhu/gorm"
+)
+
+// UserRepository implements user account persistence.
+type UserRepository struct {
+	db *gorm.DB
+}
+
+// NewUserRepository creates a UserRepository.
+func NewUserRepository(db *gorm.DB) repository.UserRepository {
+	return &UserRepository{db: db}
+}
+
+// Create persists a new user. A duplicated email is reported as a Conflict.
+func (r *UserRepository) Create(ctx context.Context, in model.User) (*model.User, error) {
+	d := dto.UserFromModel(in)
+	if err := r.db.Create(&d).Error; err != nil {
+		return nil, translateError(err, "user", 0)
+	}
+	res := d.ToModel()
+	return &res, nil
+}
+
+// FindByEmail retrieves a user by email address.
+func (r *UserRepository) FindByEmail(ctx context.Context, email string) (*model.User, error) {
+	var d dto.User
+	if err := r.db.First(&d, "email = ?", email).Error; err != nil {
+		return nil, translateError(err, "user", 0)
+	}
+	res := d.ToModel()
+	return &res, nil
+}
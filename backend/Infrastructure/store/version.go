@@ -0,0 +1,14 @@
+package store
+
+import "github.com/jinzhu/gorm"
+
+// bumpVersion moves the row of table with id from version to version+1 and reports whether
+// it did. It does not when the row has been updated since the caller read it at version.
+// Run it in the transaction that writes the rest of the row, so that the row stays locked
+// until that write commits.
+func bumpVersion(tx *gorm.DB, table interface{}, id uint64, version int32) (bool, error) {
+	res := tx.Model(table).
+		Where("id = ? AND version = ?", id, version).
+		UpdateColumn("version", gorm.Expr("version + 1"))
+	return res.RowsAffected == 1, res.Error
+}
@@ -1,10 +1,16 @@
 package config
 
-import "github.com/kelseyhightower/envconfig"
+import (
+	"time"
+
+	"github.com/kelseyhightower/envconfig"
+)
 
 // Config represents application configuration.
 type Config struct {
 	Database DatabaseConfig
+	Trash    TrashConfig
+	Auth     AuthConfig
 }
 
 // DatabaseConfig bundles database related environment variables.
@@ -16,11 +22,28 @@ type DatabaseConfig struct {
 	Name     string `envconfig:"DB_DATABASE" default:"test"`
 }
 
+// TrashConfig controls how long deleted tasks are kept before being purged.
+type TrashConfig struct {
+	Retention     time.Duration `envconfig:"TRASH_RETENTION" default:"720h"`
+	PurgeInterval time.Duration `envconfig:"TRASH_PURGE_INTERVAL" default:"1h"`
+}
+
+// AuthConfig holds the secret the BFF authenticates itself with.
+type AuthConfig struct {
+	ServiceToken string `envconfig:"BACKEND_SERVICE_TOKEN" required:"true"`
+}
+
 // Load reads environment variables into Config using envconfig.
 func Load() (*Config, error) {
 	cfg := &Config{}
 	if err := envconfig.Process("", &cfg.Database); err != nil {
 		return nil, err
 	}
+	if err := envconfig.Process("", &cfg.Trash); err != nil {
+		return nil, err
+	}
+	if err := envconfig.Process("", &cfg.Auth); err != nil {
+		return nil, err
+	}
 	return cfg, nil
 }
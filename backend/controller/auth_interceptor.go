@@ -0,0 +1,115 @@
+package controller
+
+import (
+	"context"
+	"crypto/subtle"
+	"strconv"
+
+	"backend/domain/apperr"
+	"backend/domain/auth"
+
+	pb "backend/pkg/pb"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// ServiceTokenMetadataKey is the gRPC metadata key carrying the secret the BFF shares
+// with the backend. Every call must present it, so that only the BFF can reach the
+// services even if the port is exposed by mistake.
+const ServiceTokenMetadataKey = "x-service-token"
+
+// UserIDMetadataKey is the gRPC metadata key through which the BFF passes the
+// id of the authenticated user. The BFF verifies the user's token; the backend
+// trusts the value only on calls that carry the service token.
+const UserIDMetadataKey = "x-user-id"
+
+// anonymousMethods lists the RPCs that may be called without a user.
+var anonymousMethods = map[string]struct{}{
+	pb.UserService_SignUp_FullMethodName:       {},
+	pb.UserService_Authenticate_FullMethodName: {},
+}
+
+var (
+	errInvalidServiceToken = apperr.Unauthenticated("missing or invalid " + ServiceTokenMetadataKey + " metadata")
+	errMissingUser         = apperr.Unauthenticated("missing or invalid " + UserIDMetadataKey + " metadata")
+)
+
+// AuthInterceptor authenticates the BFF by the service token and puts the calling
+// user from the request metadata into the context.
+type AuthInterceptor struct {
+	serviceToken []byte
+}
+
+// NewAuthInterceptor creates an AuthInterceptor accepting calls that carry serviceToken.
+func NewAuthInterceptor(serviceToken string) *AuthInterceptor {
+	return &AuthInterceptor{serviceToken: []byte(serviceToken)}
+}
+
+// Unary rejects requests without the service token, and requests without a user
+// unless the method is anonymous.
+func (a *AuthInterceptor) Unary(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	if !a.fromService(ctx) {
+		return nil, errInvalidServiceToken
+	}
+	if _, ok := anonymousMethods[info.FullMethod]; ok {
+		return handler(ctx, req)
+	}
+
+	userID, ok := userIDFromMetadata(ctx)
+	if !ok {
+		return nil, errMissingUser
+	}
+
+	return handler(auth.WithUserID(ctx, userID), req)
+}
+
+// Stream is the streaming counterpart of Unary.
+func (a *AuthInterceptor) Stream(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	if !a.fromService(ss.Context()) {
+		return errInvalidServiceToken
+	}
+	userID, ok := userIDFromMetadata(ss.Context())
+	if !ok {
+		return errMissingUser
+	}
+
+	return handler(srv, &authenticatedStream{ServerStream: ss, ctx: auth.WithUserID(ss.Context(), userID)})
+}
+
+// fromService reports whether the call carries the service token. An empty token
+// configured by mistake accepts nothing.
+func (a *AuthInterceptor) fromService(ctx context.Context) bool {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok || len(a.serviceToken) == 0 {
+		return false
+	}
+	values := md.Get(ServiceTokenMetadataKey)
+	return len(values) == 1 && subtle.ConstantTimeCompare([]byte(values[0]), a.serviceToken) == 1
+}
+
+// authenticatedStream overrides the context of a ServerStream with one carrying the user.
+type authenticatedStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *authenticatedStream) Context() context.Context {
+	return s.ctx
+}
+
+func userIDFromMetadata(ctx context.Context) (uint64, bool) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return 0, false
+	}
+	values := md.Get(UserIDMetadataKey)
+	if len(values) != 1 {
+		return 0, false
+	}
+	userID, err := strconv.ParseUint(values[0], 10, 64)
+	if err != nil || userID == 0 {
+		return 0, false
+	}
+	return userID, true
+}
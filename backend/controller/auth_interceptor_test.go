@@ -0,0 +1,109 @@
+package controller
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"backend/domain/apperr"
+	"backend/domain/auth"
+
+	pb "backend/pkg/pb"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+func TestAuthInterceptor_Unary(t *testing.T) {
+	t.Parallel()
+
+	const serviceToken = "bff-secret"
+
+	tests := []struct {
+		name       string
+		method     string
+		md         metadata.MD
+		wantUserID uint64
+		wantErr    bool
+	}{
+		{
+			name:       "user from metadata",
+			method:     pb.TaskService_GetTasks_FullMethodName,
+			md:         metadata.Pairs(ServiceTokenMetadataKey, serviceToken, UserIDMetadataKey, "42"),
+			wantUserID: 42,
+		},
+		{
+			name:    "missing metadata",
+			method:  pb.TaskService_GetTasks_FullMethodName,
+			wantErr: true,
+		},
+		{
+			name:    "missing user",
+			method:  pb.TaskService_GetTasks_FullMethodName,
+			md:      metadata.Pairs(ServiceTokenMetadataKey, serviceToken),
+			wantErr: true,
+		},
+		{
+			name:    "malformed user id",
+			method:  pb.TaskService_GetTasks_FullMethodName,
+			md:      metadata.Pairs(ServiceTokenMetadataKey, serviceToken, UserIDMetadataKey, "alice"),
+			wantErr: true,
+		},
+		{
+			name:    "user without service token",
+			method:  pb.TaskService_GetTasks_FullMethodName,
+			md:      metadata.Pairs(UserIDMetadataKey, "42"),
+			wantErr: true,
+		},
+		{
+			name:    "wrong service token",
+			method:  pb.TaskService_GetTasks_FullMethodName,
+			md:      metadata.Pairs(ServiceTokenMetadataKey, "guess", UserIDMetadataKey, "42"),
+			wantErr: true,
+		},
+		{
+			name:   "anonymous method",
+			method: pb.UserService_SignUp_FullMethodName,
+			md:     metadata.Pairs(ServiceTokenMetadataKey, serviceToken),
+		},
+		{
+			name:    "anonymous method without service token",
+			method:  pb.UserService_SignUp_FullMethodName,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+			if tt.md != nil {
+				ctx = metadata.NewIncomingContext(ctx, tt.md)
+			}
+
+			var gotUserID uint64
+			handler := func(ctx context.Context, req any) (any, error) {
+				gotUserID, _ = auth.UserIDFromContext(ctx)
+				return nil, nil
+			}
+
+			_, err := NewAuthInterceptor(serviceToken).Unary(ctx, nil, &grpc.UnaryServerInfo{FullMethod: tt.method}, handler)
+
+			if tt.wantErr {
+				var appErr *apperr.Error
+				if !errors.As(err, &appErr) || appErr.Code != apperr.CodeUnauthenticated {
+					t.Fatalf("error = %v, want Unauthenticated", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Unary returned error: %v", err)
+			}
+			if gotUserID != tt.wantUserID {
+				t.Fatalf("user id = %d, want %d", gotUserID, tt.wantUserID)
+			}
+		})
+	}
+}
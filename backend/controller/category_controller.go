@@ -3,6 +3,7 @@ package controller
 import (
 	"context"
 
+	"backend/domain/model"
 	"backend/usecase"
 
 	pb "backend/pkg/pb"
@@ -30,12 +31,49 @@ func (h *CategoryController) GetCategories(ctx context.Context, _ *emptypb.Empty
 
 	pbCategories := make([]*pb.Category, 0, len(categories))
 	for _, c := range categories {
-		category := c
-		pbCategories = append(pbCategories, &pb.Category{
-			Id:   category.ID,
-			Name: category.Name,
-		})
+		pbCategories = append(pbCategories, toPBCategory(c))
 	}
 
 	return &pb.CategoryList{Categories: pbCategories}, nil
 }
+
+// CreateCategory handles creation of a category.
+func (h *CategoryController) CreateCategory(ctx context.Context, in *pb.CreateCategoryRequest) (*pb.Category, error) {
+	category, err := h.usecase.CreateCategory(ctx, in.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	return toPBCategory(*category), nil
+}
+
+// UpdateCategory handles renaming a category.
+func (h *CategoryController) UpdateCategory(ctx context.Context, in *pb.UpdateCategoryRequest) (*pb.Category, error) {
+	category, err := h.usecase.RenameCategory(ctx, in.Id, in.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	return toPBCategory(*category), nil
+}
+
+// DeleteCategory handles deleting a category.
+func (h *CategoryController) DeleteCategory(ctx context.Context, in *pb.DeleteCategoryRequest) (*pb.DeleteCategoryResponse, error) {
+	req := model.DeleteCategoryRequest{
+		ID:         in.Id,
+		Policy:     model.DeleteCategoryPolicy(in.Policy),
+		ReassignTo: in.ReassignTo,
+	}
+	if err := h.usecase.DeleteCategory(ctx, req); err != nil {
+		return nil, err
+	}
+
+	return &pb.DeleteCategoryResponse{Success: true}, nil
+}
+
+func toPBCategory(c model.Category) *pb.Category {
+	return &pb.Category{
+		Id:   c.ID,
+		Name: c.Name,
+	}
+}
@@ -0,0 +1,64 @@
+package controller
+
+import (
+	"context"
+	"regexp"
+	"testing"
+
+	"backend/Infrastructure/store"
+	"backend/domain/auth"
+	"backend/usecase"
+
+	pb "backend/pkg/pb"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/jinzhu/gorm"
+)
+
+// TestCategoryController_DeleteCategory_Nullify verifies that clearing the
+// category of its tasks records a history entry per task in the same transaction.
+func TestCategoryController_DeleteCategory_Nullify(t *testing.T) {
+	t.Parallel()
+
+	sqlDB, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("failed to open sqlmock: %v", err)
+	}
+	db, err := gorm.Open("mysql", sqlDB)
+	if err != nil {
+		t.Fatalf("failed to open gorm: %v", err)
+	}
+	t.Cleanup(func() { db.Close() })
+	h := NewCategoryController(usecase.NewCategoryUseCase(store.NewCategoryRepository(db), store.NewTaskRepository(db), store.NewUnitOfWork(db), usecase.NewTaskFeed()))
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `categories` WHERE (user_id IS NULL OR user_id = ?) AND (id = ?)")).
+		WithArgs(testUserID, 5).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "user_id", "name"}).AddRow(5, testUserID, "Home"))
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT id FROM `tasks` WHERE (category_id = ? AND user_id = ?)")).
+		WithArgs(5, testUserID).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(3).AddRow(4))
+	mock.ExpectExec(regexp.QuoteMeta("UPDATE `tasks` SET `category_id` = ?, `updated_at` = ?, `version` = version + 1 WHERE (category_id = ? AND user_id = ?)")).
+		WithArgs(nil, sqlmock.AnyArg(), 5, testUserID).
+		WillReturnResult(sqlmock.NewResult(0, 2))
+	mock.ExpectExec(regexp.QuoteMeta("DELETE FROM `categories` WHERE (id = ? AND user_id = ?)")).
+		WithArgs(5, testUserID).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	for _, taskID := range []uint64{3, 4} {
+		mock.ExpectExec(regexp.QuoteMeta("INSERT INTO `task_events`")).
+			WithArgs(taskID, nil, testUserID, int32(pb.TaskHistoryAction_TASK_HISTORY_ACTION_UPDATED), "category_id", "5", nil, sqlmock.AnyArg()).
+			WillReturnResult(sqlmock.NewResult(int64(taskID), 1))
+	}
+	mock.ExpectCommit()
+
+	_, err = h.DeleteCategory(auth.WithUserID(context.Background(), testUserID), &pb.DeleteCategoryRequest{
+		Id:     5,
+		Policy: pb.DeleteCategoryPolicy_DELETE_CATEGORY_POLICY_NULLIFY,
+	})
+	if err != nil {
+		t.Fatalf("DeleteCategory returned error: %v", err)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Fatalf("unexpected queries: %v", err)
+	}
+}
@@ -0,0 +1,124 @@
+package controller
+
+import (
+	"context"
+	"errors"
+	"strconv"
+
+	"backend/domain/apperr"
+	"backend/domain/model"
+
+	"github.com/labstack/gommon/log"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/protoadapt"
+)
+
+// UnaryErrorInterceptor converts errors returned by handlers into gRPC status
+// errors whose codes and details reflect the underlying apperr.Error.
+func UnaryErrorInterceptor(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	resp, err := handler(ctx, req)
+	if err != nil {
+		return nil, toStatusError(info.FullMethod, err)
+	}
+	return resp, nil
+}
+
+// StreamErrorInterceptor is the streaming counterpart of UnaryErrorInterceptor.
+func StreamErrorInterceptor(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	if err := handler(srv, ss); err != nil {
+		return toStatusError(info.FullMethod, err)
+	}
+	return nil
+}
+
+func toStatusError(method string, err error) error {
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
+
+	var appErr *apperr.Error
+	if !errors.As(err, &appErr) {
+		log.Errorf("%s: unexpected error: %v", method, err)
+		return status.Error(codes.Internal, err.Error())
+	}
+
+	st := status.New(toGRPCCode(appErr.Code), appErr.Message)
+	if detail := toErrorDetail(appErr); detail != nil {
+		withDetails, detailErr := st.WithDetails(detail)
+		if detailErr == nil {
+			st = withDetails
+		}
+	}
+
+	return st.Err()
+}
+
+func toGRPCCode(code apperr.Code) codes.Code {
+	switch code {
+	case apperr.CodeNotFound:
+		return codes.NotFound
+	case apperr.CodeInvalidArgument:
+		return codes.InvalidArgument
+	case apperr.CodeConflict:
+		return codes.AlreadyExists
+	case apperr.CodeFailedPrecondition:
+		return codes.FailedPrecondition
+	case apperr.CodeUnavailable:
+		return codes.Unavailable
+	case apperr.CodeUnauthenticated:
+		return codes.Unauthenticated
+	case apperr.CodeAborted:
+		return codes.Aborted
+	default:
+		return codes.Unknown
+	}
+}
+
+func toErrorDetail(e *apperr.Error) protoadapt.MessageV1 {
+	switch e.Code {
+	case apperr.CodeNotFound:
+		return &errdetails.ResourceInfo{
+			ResourceType: e.Resource,
+			ResourceName: strconv.FormatUint(e.ID, 10),
+			Description:  e.Message,
+		}
+	case apperr.CodeInvalidArgument:
+		if len(e.Violations) == 0 {
+			return nil
+		}
+		violations := make([]*errdetails.BadRequest_FieldViolation, 0, len(e.Violations))
+		for _, v := range e.Violations {
+			violations = append(violations, &errdetails.BadRequest_FieldViolation{
+				Field:       v.Field,
+				Description: v.Description,
+			})
+		}
+		return &errdetails.BadRequest{FieldViolations: violations}
+	case apperr.CodeFailedPrecondition:
+		return &errdetails.PreconditionFailure{
+			Violations: []*errdetails.PreconditionFailure_Violation{{
+				Type:        "STATE",
+				Subject:     e.Subject,
+				Description: e.Message,
+			}},
+		}
+	case apperr.CodeAborted:
+		// 競合時はクライアントがマージできるようサーバー側の最新の状態を返す
+		switch current := e.Current.(type) {
+		case *model.Task:
+			task, err := toPBTask(*current)
+			if err != nil {
+				return nil
+			}
+			return protoadapt.MessageV1Of(task)
+		case *model.SubTask:
+			return protoadapt.MessageV1Of(toPBSubTask(*current))
+		}
+		return nil
+	default:
+		return nil
+	}
+}
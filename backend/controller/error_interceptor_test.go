@@ -0,0 +1,121 @@
+package controller
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"backend/domain/apperr"
+	"backend/domain/model"
+	"backend/pkg/pb"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestUnaryErrorInterceptor(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		err        error
+		wantCode   codes.Code
+		wantDetail func(t *testing.T, details []any)
+	}{
+		{
+			name:     "not found",
+			err:      fmt.Errorf("wrapped: %w", apperr.NotFound("task", 42)),
+			wantCode: codes.NotFound,
+			wantDetail: func(t *testing.T, details []any) {
+				info, ok := details[0].(*errdetails.ResourceInfo)
+				if !ok || info.ResourceType != "task" || info.ResourceName != "42" {
+					t.Fatalf("details = %v, want ResourceInfo for task 42", details)
+				}
+			},
+		},
+		{
+			name: "invalid argument",
+			err: apperr.InvalidArgument("bad input", apperr.FieldViolation{
+				Field:       "title",
+				Description: "must not be empty",
+			}),
+			wantCode: codes.InvalidArgument,
+			wantDetail: func(t *testing.T, details []any) {
+				br, ok := details[0].(*errdetails.BadRequest)
+				if !ok || len(br.FieldViolations) != 1 || br.FieldViolations[0].Field != "title" {
+					t.Fatalf("details = %v, want BadRequest for title", details)
+				}
+			},
+		},
+		{
+			name:     "conflict",
+			err:      apperr.Conflict("already exists"),
+			wantCode: codes.AlreadyExists,
+		},
+		{
+			name:     "failed precondition",
+			err:      apperr.FailedPrecondition("category", "in use"),
+			wantCode: codes.FailedPrecondition,
+			wantDetail: func(t *testing.T, details []any) {
+				pf, ok := details[0].(*errdetails.PreconditionFailure)
+				if !ok || pf.Violations[0].Subject != "category" {
+					t.Fatalf("details = %v, want PreconditionFailure for category", details)
+				}
+			},
+		},
+		{
+			name:     "aborted",
+			err:      apperr.Aborted("task 7 is at version 3, not 2", &model.Task{ID: 7, Title: "latest", Version: 3}),
+			wantCode: codes.Aborted,
+			wantDetail: func(t *testing.T, details []any) {
+				task, ok := details[0].(*pb.Task)
+				if !ok || task.Id != 7 || task.Version != 3 {
+					t.Fatalf("details = %v, want the current task 7 at version 3", details)
+				}
+			},
+		},
+		{
+			name:     "unavailable",
+			err:      apperr.Unavailable("database is unavailable", errors.New("dial tcp: connection refused")),
+			wantCode: codes.Unavailable,
+		},
+		{
+			name:     "unexpected error",
+			err:      errors.New("boom"),
+			wantCode: codes.Internal,
+		},
+		{
+			name:     "status error passes through",
+			err:      status.Error(codes.PermissionDenied, "nope"),
+			wantCode: codes.PermissionDenied,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			handler := func(ctx context.Context, req any) (any, error) {
+				return nil, tt.err
+			}
+			info := &grpc.UnaryServerInfo{FullMethod: "/task.TaskService/Test"}
+
+			_, err := UnaryErrorInterceptor(context.Background(), nil, info, handler)
+
+			st, ok := status.FromError(err)
+			if !ok {
+				t.Fatalf("error %v is not a gRPC status", err)
+			}
+			if st.Code() != tt.wantCode {
+				t.Fatalf("code = %v, want %v", st.Code(), tt.wantCode)
+			}
+			if tt.wantDetail != nil {
+				tt.wantDetail(t, st.Details())
+			}
+		})
+	}
+}
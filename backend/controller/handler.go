@@ -11,16 +11,30 @@ import (
 )
 
 // RegisterTaskService wires the Task service into the provided gRPC server.
-func RegisterService(grpcServer *grpc.Server, db *gorm.DB) {
+// Task changes are published to feed.
+func RegisterService(grpcServer *grpc.Server, db *gorm.DB, feed *usecase.TaskFeed) {
 	taskRepo := store.NewTaskRepository(db)
-	taskUsecase := usecase.NewTaskUseCase(taskRepo)
+	categoryRepo := store.NewCategoryRepository(db)
 	subTaskRepo := store.NewSubTaskRepository(db)
-	subTaskUsecase := usecase.NewSubTaskUseCase(subTaskRepo)
-	taskController := NewTaskController(taskUsecase, subTaskUsecase)
+	tagRepo := store.NewTagRepository(db)
+	historyRepo := store.NewTaskHistoryRepository(db)
+	uow := store.NewUnitOfWork(db)
+	taskUsecase := usecase.NewTaskUseCase(taskRepo, categoryRepo, subTaskRepo, historyRepo, uow, feed)
+	subTaskUsecase := usecase.NewSubTaskUseCase(subTaskRepo, taskRepo, uow, feed)
+	statsUsecase := usecase.NewTaskStatsUseCase(categoryRepo, uow)
+	taskController := NewTaskController(taskUsecase, subTaskUsecase, statsUsecase)
 	pb.RegisterTaskServiceServer(grpcServer, taskController)
 
-	categoryRepo := store.NewCategoryRepository(db)
-	categoryUsecase := usecase.NewCategoryUseCase(categoryRepo)
+	categoryUsecase := usecase.NewCategoryUseCase(categoryRepo, taskRepo, uow, feed)
 	categoryController := NewCategoryController(categoryUsecase)
 	pb.RegisterCategoryServiceServer(grpcServer, categoryController)
+
+	tagUsecase := usecase.NewTagUseCase(tagRepo)
+	tagController := NewTagController(tagUsecase)
+	pb.RegisterTagServiceServer(grpcServer, tagController)
+
+	userRepo := store.NewUserRepository(db)
+	userUsecase := usecase.NewUserUseCase(userRepo)
+	userController := NewUserController(userUsecase)
+	pb.RegisterUserServiceServer(grpcServer, userController)
 }
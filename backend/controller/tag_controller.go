@@ -0,0 +1,74 @@
+package controller
+
+import (
+	"context"
+
+	"backend/domain/model"
+	"backend/usecase"
+
+	pb "backend/pkg/pb"
+
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// TagController bridges tag gRPC requests with the use case layer.
+type TagController struct {
+	pb.UnimplementedTagServiceServer
+	usecase usecase.TagUseCase
+}
+
+// NewTagController constructs a TagController.
+func NewTagController(uc usecase.TagUseCase) *TagController {
+	return &TagController{usecase: uc}
+}
+
+// GetTags returns the tags of the calling user.
+func (h *TagController) GetTags(ctx context.Context, _ *emptypb.Empty) (*pb.TagList, error) {
+	tags, err := h.usecase.ListTags(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	pbTags := make([]*pb.Tag, 0, len(tags))
+	for _, t := range tags {
+		pbTags = append(pbTags, toPBTag(t))
+	}
+
+	return &pb.TagList{Tags: pbTags}, nil
+}
+
+// CreateTag handles creation of a tag.
+func (h *TagController) CreateTag(ctx context.Context, in *pb.CreateTagRequest) (*pb.Tag, error) {
+	tag, err := h.usecase.CreateTag(ctx, in.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	return toPBTag(*tag), nil
+}
+
+// UpdateTag handles renaming a tag.
+func (h *TagController) UpdateTag(ctx context.Context, in *pb.UpdateTagRequest) (*pb.Tag, error) {
+	tag, err := h.usecase.RenameTag(ctx, in.Id, in.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	return toPBTag(*tag), nil
+}
+
+// DeleteTag handles deleting a tag.
+func (h *TagController) DeleteTag(ctx context.Context, in *pb.DeleteTagRequest) (*pb.DeleteTagResponse, error) {
+	if err := h.usecase.DeleteTag(ctx, in.Id); err != nil {
+		return nil, err
+	}
+
+	return &pb.DeleteTagResponse{Success: true}, nil
+}
+
+func toPBTag(t model.Tag) *pb.Tag {
+	return &pb.Tag{
+		Id:   t.ID,
+		Name: t.Name,
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"time"
 
+	"backend/domain/apperr"
 	"backend/domain/model"
 	"backend/domain/repository"
 	"backend/usecase"
@@ -11,57 +12,69 @@ import (
 	pb "backend/pkg/pb"
 
 	"github.com/labstack/gommon/log"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/types/known/emptypb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
+// errMissingInput is returned when a mutation arrives without its input message.
+var errMissingInput = apperr.InvalidArgument("input is required", apperr.FieldViolation{
+	Field:       "input",
+	Description: "must be set",
+})
+
 // TaskController bridges gRPC requests with task use cases.
 type TaskController struct {
 	pb.UnimplementedTaskServiceServer
 	usecase        usecase.TaskUseCase
 	subTaskUsecase usecase.SubTaskUseCase
+	statsUsecase   usecase.TaskStatsUseCase
 }
 
 // NewTaskController constructs a TaskController.
-func NewTaskController(uc usecase.TaskUseCase, sub usecase.SubTaskUseCase) *TaskController {
-	return &TaskController{usecase: uc, subTaskUsecase: sub}
+func NewTaskController(uc usecase.TaskUseCase, sub usecase.SubTaskUseCase, stats usecase.TaskStatsUseCase) *TaskController {
+	return &TaskController{usecase: uc, subTaskUsecase: sub, statsUsecase: stats}
 }
 
-// GetTasks handles retrieval of all tasks with optional filtering.
+// GetTasks handles retrieval of tasks with optional filtering and pagination.
 func (h *TaskController) GetTasks(ctx context.Context, in *pb.GetTasksRequest) (*pb.TaskList, error) {
-	filter := repository.TaskFilter{}
+	filter := toTaskFilter(in)
+	page := repository.PageRequest{}
 	if in != nil {
-		filter.CategoryID = in.CategoryId
-		filter.DueDateFrom = timestampToTime(in.DueDateStart)
-		filter.DueDateTo = timestampToTime(in.DueDateEnd)
-		filter.IncompleteOnly = in.IncompleteOnly
+		page.Size = int(in.PageSize)
+		page.Token = in.PageToken
 	}
-	tasks, err := h.usecase.ListTasks(ctx, filter)
+
+	result, err := h.usecase.ListTasksPage(ctx, filter, page)
 	if err != nil {
 		return nil, err
 	}
-	for i := range tasks {
-		subTasks, err := h.subTaskUsecase.ListByTaskID(ctx, tasks[i].ID)
-		if err != nil {
+
+	tasks := result.Tasks
+	if !in.GetSkipSubTasks() {
+		if err := h.attachSubTasks(ctx, tasks); err != nil {
 			return nil, err
 		}
-		tasks[i].SubTasks = subTasks
 	}
-	pbTasks := make([]*pb.Task, 0, len(tasks))
-	for _, task := range tasks {
-		converted, err := toPBTask(task)
-		if err != nil {
-			log.Errorf("failed to convert task to pb.Task: %v", err)
-			return nil, err
-		}
-		pbTasks = append(pbTasks, converted)
+	pbTasks, err := toPBTasks(tasks)
+	if err != nil {
+		return nil, err
 	}
 
-	return &pb.TaskList{Tasks: pbTasks}, nil
+	return &pb.TaskList{
+		Tasks:         pbTasks,
+		NextPageToken: result.NextPageToken,
+		TotalCount:    int32(result.TotalCount),
+		Cursors:       result.Cursors,
+	}, nil
 }
 
 // CreateTask handles creation of a task.
 func (h *TaskController) CreateTask(ctx context.Context, in *pb.CreateTaskRequest) (*pb.Task, error) {
-	task := toModelTaskFromCreateTaskRequest(in)
+	task, err := toModelTaskFromCreateTaskRequest(in)
+	if err != nil {
+		return nil, err
+	}
 	res, err := h.usecase.CreateTask(ctx, task)
 	if err != nil {
 		return nil, err
@@ -72,7 +85,11 @@ func (h *TaskController) CreateTask(ctx context.Context, in *pb.CreateTaskReques
 
 // UpdateTask handles updates to a task.
 func (h *TaskController) UpdateTask(ctx context.Context, in *pb.UpdateTaskRequest) (*pb.Task, error) {
-	task, err := h.usecase.UpdateTask(ctx, toUpdateTaskRequest(in))
+	req, err := toUpdateTaskRequest(in)
+	if err != nil {
+		return nil, err
+	}
+	task, err := h.usecase.UpdateTask(ctx, req)
 	if err != nil {
 		return nil, err
 	}
@@ -80,10 +97,123 @@ func (h *TaskController) UpdateTask(ctx context.Context, in *pb.UpdateTaskReques
 	return toPBTask(*task)
 }
 
-// DeleteTask handles deleting a task.
+// DeleteTask handles moving a task to the trash.
 func (h *TaskController) DeleteTask(ctx context.Context, in *pb.TaskId) (*pb.DeleteTaskResponse, error) {
 	if err := h.usecase.DeleteTask(ctx, in.Id); err != nil {
-		return &pb.DeleteTaskResponse{Success: false}, err
+		return nil, err
+	}
+
+	return &pb.DeleteTaskResponse{Success: true}, nil
+}
+
+// GetTask returns a single task with its subtasks.
+func (h *TaskController) GetTask(ctx context.Context, in *pb.TaskId) (*pb.Task, error) {
+	task, err := h.usecase.GetTask(ctx, in.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	tasks := []model.Task{*task}
+	if err := h.attachSubTasks(ctx, tasks); err != nil {
+		return nil, err
+	}
+	return toPBTask(tasks[0])
+}
+
+// BatchGetTasks returns the listed tasks in the order given.
+func (h *TaskController) BatchGetTasks(ctx context.Context, in *pb.BatchGetTasksRequest) (*pb.TaskList, error) {
+	tasks, err := h.usecase.BatchGetTasks(ctx, in.Ids)
+	if err != nil {
+		return nil, err
+	}
+
+	if !in.SkipSubTasks {
+		if err := h.attachSubTasks(ctx, tasks); err != nil {
+			return nil, err
+		}
+	}
+	pbTasks, err := toPBTasks(tasks)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.TaskList{Tasks: pbTasks}, nil
+}
+
+// BulkUpdateTasks handles changing many tasks at once.
+func (h *TaskController) BulkUpdateTasks(ctx context.Context, in *pb.BulkUpdateTasksRequest) (*pb.BulkTasksResponse, error) {
+	change := model.BulkTaskChange{
+		Completed:        in.Completed,
+		CategoryID:       in.CategoryId,
+		ShiftDueDateDays: in.ShiftDueDateDays,
+	}
+	if in.DueDate != nil {
+		change.DueDate = timestampToTime(in.DueDate)
+	}
+
+	res, err := h.usecase.BulkUpdateTasks(ctx, toBulkTaskTarget(in.Target), change)
+	if err != nil {
+		return nil, err
+	}
+	return toPBBulkTasksResponse(res)
+}
+
+// BulkDeleteTasks handles moving many tasks to the trash at once.
+func (h *TaskController) BulkDeleteTasks(ctx context.Context, in *pb.BulkDeleteTasksRequest) (*pb.BulkTasksResponse, error) {
+	res, err := h.usecase.BulkDeleteTasks(ctx, toBulkTaskTarget(in.Target))
+	if err != nil {
+		return nil, err
+	}
+	return toPBBulkTasksResponse(res)
+}
+
+// ListDeletedTasks returns the tasks currently in the trash.
+func (h *TaskController) ListDeletedTasks(ctx context.Context, _ *emptypb.Empty) (*pb.TaskList, error) {
+	tasks, err := h.usecase.ListDeletedTasks(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := h.attachSubTasks(ctx, tasks); err != nil {
+		return nil, err
+	}
+	pbTasks, err := toPBTasks(tasks)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.TaskList{Tasks: pbTasks, TotalCount: int32(len(pbTasks))}, nil
+}
+
+// ListTasksNeedingAttention returns the overdue tasks of high priority or above.
+func (h *TaskController) ListTasksNeedingAttention(ctx context.Context, _ *emptypb.Empty) (*pb.TaskList, error) {
+	tasks, err := h.usecase.ListTasksNeedingAttention(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := h.attachSubTasks(ctx, tasks); err != nil {
+		return nil, err
+	}
+	pbTasks, err := toPBTasks(tasks)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.TaskList{Tasks: pbTasks, TotalCount: int32(len(pbTasks))}, nil
+}
+
+// RestoreTask handles taking a task out of the trash.
+func (h *TaskController) RestoreTask(ctx context.Context, in *pb.TaskId) (*pb.Task, error) {
+	task, err := h.usecase.RestoreTask(ctx, in.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	return toPBTask(*task)
+}
+
+// PurgeTask handles permanently deleting a trashed task.
+func (h *TaskController) PurgeTask(ctx context.Context, in *pb.TaskId) (*pb.DeleteTaskResponse, error) {
+	if err := h.usecase.PurgeTask(ctx, in.Id); err != nil {
+		return nil, err
 	}
 
 	return &pb.DeleteTaskResponse{Success: true}, nil
@@ -91,7 +221,10 @@ func (h *TaskController) DeleteTask(ctx context.Context, in *pb.TaskId) (*pb.Del
 
 // CreateSubTask handles creation of a sub task.
 func (h *TaskController) CreateSubTask(ctx context.Context, in *pb.CreateSubTaskRequest) (*pb.SubTask, error) {
-	subTask := toModelSubTaskFromCreateRequest(in)
+	subTask, err := toModelSubTaskFromCreateRequest(in)
+	if err != nil {
+		return nil, err
+	}
 	res, err := h.subTaskUsecase.Create(ctx, subTask)
 	if err != nil {
 		return nil, err
@@ -99,9 +232,55 @@ func (h *TaskController) CreateSubTask(ctx context.Context, in *pb.CreateSubTask
 	return toPBSubTask(*res), nil
 }
 
+// UpdateSubTask handles partial updates to a sub task.
+func (h *TaskController) UpdateSubTask(ctx context.Context, in *pb.UpdateSubTaskRequest) (*pb.SubTask, error) {
+	req, err := toUpdateSubTaskRequest(in)
+	if err != nil {
+		return nil, err
+	}
+	res, err := h.subTaskUsecase.Update(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return toPBSubTask(*res), nil
+}
+
 // ToggleSubTask handles toggling completion of a sub task.
 func (h *TaskController) ToggleSubTask(ctx context.Context, in *pb.ToggleSubTaskRequest) (*pb.SubTask, error) {
-	res, err := h.subTaskUsecase.ToggleCompletion(ctx, in.Id, in.Completed)
+	res, err := h.subTaskUsecase.ToggleCompletion(ctx, in.Id, in.Completed, in.ExpectedVersion)
+	if err != nil {
+		return nil, err
+	}
+	return toPBSubTask(*res), nil
+}
+
+// DeleteSubTask handles deleting a sub task.
+func (h *TaskController) DeleteSubTask(ctx context.Context, in *pb.SubTaskId) (*pb.DeleteSubTaskResponse, error) {
+	if err := h.subTaskUsecase.Delete(ctx, in.Id); err != nil {
+		return nil, err
+	}
+
+	return &pb.DeleteSubTaskResponse{Success: true}, nil
+}
+
+// ReorderSubTasks rearranges the subtasks of a task and returns them in their new order.
+func (h *TaskController) ReorderSubTasks(ctx context.Context, in *pb.ReorderSubTasksRequest) (*pb.SubTaskList, error) {
+	subTasks, err := h.subTaskUsecase.Reorder(ctx, in.TaskId, in.SubTaskIds)
+	if err != nil {
+		return nil, err
+	}
+
+	pbSubTasks := make([]*pb.SubTask, 0, len(subTasks))
+	for _, st := range subTasks {
+		pbSubTasks = append(pbSubTasks, toPBSubTask(st))
+	}
+
+	return &pb.SubTaskList{SubTasks: pbSubTasks}, nil
+}
+
+// GetSubTask returns a single sub task.
+func (h *TaskController) GetSubTask(ctx context.Context, in *pb.SubTaskId) (*pb.SubTask, error) {
+	res, err := h.subTaskUsecase.Get(ctx, in.Id)
 	if err != nil {
 		return nil, err
 	}
@@ -123,14 +302,250 @@ func (h *TaskController) ListSubTasks(ctx context.Context, in *pb.TaskId) (*pb.S
 	return &pb.SubTaskList{SubTasks: pbSubTasks}, nil
 }
 
-func toModelTaskFromCreateTaskRequest(in *pb.CreateTaskRequest) model.Task {
+// BatchListSubTasks returns the subtasks of several tasks at once, keyed by task id.
+func (h *TaskController) BatchListSubTasks(ctx context.Context, in *pb.TaskIds) (*pb.SubTasksByTask, error) {
+	subTasksByTask, err := h.subTaskUsecase.ListByTaskIDs(ctx, in.Ids)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &pb.SubTasksByTask{SubTasks: make(map[uint64]*pb.SubTaskList, len(subTasksByTask))}
+	for taskID, subTasks := range subTasksByTask {
+		pbSubTasks := make([]*pb.SubTask, 0, len(subTasks))
+		for _, st := range subTasks {
+			pbSubTasks = append(pbSubTasks, toPBSubTask(st))
+		}
+		res.SubTasks[taskID] = &pb.SubTaskList{SubTasks: pbSubTasks}
+	}
+
+	return res, nil
+}
+
+// WatchTasks streams changes to the calling user's tasks until the client goes away.
+func (h *TaskController) WatchTasks(in *pb.WatchTasksRequest, stream pb.TaskService_WatchTasksServer) error {
+	ctx := stream.Context()
+	events, cancel, err := h.usecase.WatchTasks(ctx)
+	if err != nil {
+		return err
+	}
+	defer cancel()
+
+	// 購読の登録が済んだことをクライアントに伝える。これ以降の変更は取りこぼさない
+	if err := stream.SendHeader(metadata.MD{}); err != nil {
+		return err
+	}
+
+	wanted := make(map[model.TaskEventType]struct{}, len(in.Types))
+	for _, t := range in.Types {
+		wanted[model.TaskEventType(t)] = struct{}{}
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case event, ok := <-events:
+			if !ok {
+				return usecase.ErrTaskWatchLagged
+			}
+			if _, ok := wanted[event.Type]; len(wanted) > 0 && !ok {
+				continue
+			}
+			res, err := toPBTaskEvent(event)
+			if err != nil {
+				return err
+			}
+			if err := stream.Send(res); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// SearchTasks handles full-text search over the caller's tasks.
+func (h *TaskController) SearchTasks(ctx context.Context, in *pb.SearchTasksRequest) (*pb.SearchTasksResponse, error) {
+	page := repository.PageRequest{Size: int(in.PageSize), Token: in.PageToken}
+	result, err := h.usecase.SearchTasks(ctx, in.Query, page)
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]*pb.TaskSearchResult, 0, len(result.Results))
+	for _, r := range result.Results {
+		task, err := toPBTask(r.Task)
+		if err != nil {
+			return nil, err
+		}
+		highlights := make([]*pb.SearchHighlight, 0, len(r.Highlights))
+		for _, hl := range r.Highlights {
+			highlights = append(highlights, &pb.SearchHighlight{Field: hl.Field, Snippet: hl.Snippet})
+		}
+		results = append(results, &pb.TaskSearchResult{Task: task, Score: r.Score, Highlights: highlights})
+	}
+
+	return &pb.SearchTasksResponse{
+		Results:       results,
+		NextPageToken: result.NextPageToken,
+		TotalCount:    int32(result.TotalCount),
+		Cursors:       result.Cursors,
+	}, nil
+}
+
+// GetTaskStats returns counts and completion figures for the calling user's tasks.
+func (h *TaskController) GetTaskStats(ctx context.Context, in *pb.GetTaskStatsRequest) (*pb.TaskStats, error) {
+	stats, err := h.statsUsecase.GetTaskStats(ctx, in.Weeks, in.CategoryId)
+	if err != nil {
+		return nil, err
+	}
+
+	return toPBTaskStats(*stats), nil
+}
+
+// ListTaskHistory returns the changes made to a task and its subtasks, most recent first.
+func (h *TaskController) ListTaskHistory(ctx context.Context, in *pb.TaskId) (*pb.TaskHistory, error) {
+	entries, err := h.usecase.ListTaskHistory(ctx, in.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &pb.TaskHistory{Entries: make([]*pb.TaskHistoryEntry, 0, len(entries))}
+	for _, e := range entries {
+		res.Entries = append(res.Entries, &pb.TaskHistoryEntry{
+			Id:        e.ID,
+			TaskId:    e.TaskID,
+			SubTaskId: e.SubTaskID,
+			UserId:    e.UserID,
+			Action:    pb.TaskHistoryAction(e.Action),
+			Field:     e.Field,
+			OldValue:  e.OldValue,
+			NewValue:  e.NewValue,
+			CreatedAt: timestamppb.New(e.CreatedAt),
+		})
+	}
+	return res, nil
+}
+
+// attachSubTasks fills SubTasks of every task using a single batched query.
+func (h *TaskController) attachSubTasks(ctx context.Context, tasks []model.Task) error {
+	taskIDs := make([]uint64, 0, len(tasks))
+	for _, task := range tasks {
+		taskIDs = append(taskIDs, task.ID)
+	}
+	subTasksByTask, err := h.subTaskUsecase.ListByTaskIDs(ctx, taskIDs)
+	if err != nil {
+		return err
+	}
+	for i := range tasks {
+		tasks[i].SubTasks = subTasksByTask[tasks[i].ID]
+	}
+	return nil
+}
+
+func toModelTaskFromCreateTaskRequest(in *pb.CreateTaskRequest) (model.Task, error) {
+	if in.GetInput() == nil {
+		return model.Task{}, errMissingInput
+	}
 	return model.Task{
-		Title:      in.Input.Title,
-		Note:       in.Input.Note,
-		DueDate:    timestampToTime(in.Input.DueDate),
-		CategoryID: in.Input.CategoryId,
-		Completed:  0,
+		Title:          in.Input.Title,
+		Note:           in.Input.Note,
+		DueDate:        timestampToTime(in.Input.DueDate),
+		CategoryID:     in.Input.CategoryId,
+		Completed:      0,
+		Recurrence:     toModelRecurrence(in.Input.Recurrence),
+		TagIDs:         in.Input.TagIds,
+		Priority:       model.Priority(in.Input.Priority),
+		SubTasks:       toModelNewSubTasks(in.Input.SubTasks),
+		SyncCompletion: in.Input.SyncCompletion,
+	}, nil
+}
+
+func toModelNewSubTasks(in []*pb.NewTaskSubTask) []model.SubTask {
+	if len(in) == 0 {
+		return nil
+	}
+	subTasks := make([]model.SubTask, 0, len(in))
+	for _, st := range in {
+		subTasks = append(subTasks, model.SubTask{
+			Title:   st.Title,
+			Note:    st.Note,
+			DueDate: timestampToTime(st.DueDate),
+		})
+	}
+	return subTasks
+}
+
+func toPBTaskEvent(event model.TaskEvent) (*pb.TaskEvent, error) {
+	res := &pb.TaskEvent{
+		Type:   pb.TaskEventType(event.Type),
+		TaskId: event.TaskID,
+	}
+	if event.Task != nil {
+		task, err := toPBTask(*event.Task)
+		if err != nil {
+			return nil, err
+		}
+		res.Task = task
+	}
+	if event.SubTask != nil {
+		res.SubTask = toPBSubTask(*event.SubTask)
 	}
+	return res, nil
+}
+
+// toTaskFilter reads the filtering and ordering fields of a listing request.
+func toTaskFilter(in *pb.GetTasksRequest) repository.TaskFilter {
+	filter := repository.TaskFilter{}
+	if in == nil {
+		return filter
+	}
+	filter.CategoryID = in.CategoryId
+	filter.DueDateFrom = timestampToTime(in.DueDateStart)
+	filter.DueDateTo = timestampToTime(in.DueDateEnd)
+	filter.IncompleteOnly = in.IncompleteOnly
+	filter.TagIDs = in.TagIds
+	filter.TagMatch = repository.TagMatch(in.TagMatch)
+	if in.MinPriority != nil {
+		p := model.Priority(*in.MinPriority)
+		filter.MinPriority = &p
+	}
+	for _, o := range in.OrderBy {
+		filter.OrderBy = append(filter.OrderBy, repository.TaskOrder{
+			Field: repository.TaskOrderField(o.Field),
+			Desc:  o.Direction == pb.SortDirection_SORT_DIRECTION_DESC,
+		})
+	}
+	return filter
+}
+
+func toBulkTaskTarget(in *pb.BulkTaskTarget) usecase.BulkTaskTarget {
+	target := usecase.BulkTaskTarget{IDs: in.GetIds()}
+	if in.GetFilter() != nil {
+		filter := toTaskFilter(in.GetFilter())
+		target.Filter = &filter
+	}
+	return target
+}
+
+func toPBBulkTasksResponse(res *model.BulkTaskResult) (*pb.BulkTasksResponse, error) {
+	results := make([]*pb.BulkTaskResult, 0, len(res.Outcomes))
+	for _, o := range res.Outcomes {
+		r := &pb.BulkTaskResult{TaskId: o.TaskID}
+		if o.Err != nil {
+			r.Error = &pb.BulkTaskError{
+				Code:    int32(toGRPCCode(apperr.CodeOf(o.Err))),
+				Message: o.Err.Error(),
+			}
+		}
+		if o.Task != nil {
+			task, err := toPBTask(*o.Task)
+			if err != nil {
+				return nil, err
+			}
+			r.Task = task
+		}
+		results = append(results, r)
+	}
+	return &pb.BulkTasksResponse{Applied: res.Applied, Results: results}, nil
 }
 
 func toPBTask(task model.Task) (*pb.Task, error) {
@@ -139,20 +554,73 @@ func toPBTask(task model.Task) (*pb.Task, error) {
 		pbSubTasks = append(pbSubTasks, toPBSubTask(st))
 	}
 	return &pb.Task{
-		Id:          task.ID,
-		Title:       task.Title,
-		Note:        task.Note,
-		Completed:   task.Completed,
-		CompletedAt: timeToTimestamp(task.CompletedAt),
-		CategoryId:  task.CategoryID,
-		DueDate:     timeToTimestamp(task.DueDate),
-		CreatedAt:   timestamppb.New(task.CreatedAt),
-		UpdatedAt:   timestamppb.New(task.UpdatedAt),
-		SubTasks:    pbSubTasks,
+		Id:               task.ID,
+		Title:            task.Title,
+		Note:             task.Note,
+		Completed:        task.Completed,
+		CompletedAt:      timeToTimestamp(task.CompletedAt),
+		CategoryId:       task.CategoryID,
+		DueDate:          timeToTimestamp(task.DueDate),
+		CreatedAt:        timestamppb.New(task.CreatedAt),
+		UpdatedAt:        timestamppb.New(task.UpdatedAt),
+		DeletedAt:        timeToTimestamp(task.DeletedAt),
+		Recurrence:       toPBRecurrence(task.Recurrence),
+		TagIds:           task.TagIDs,
+		Priority:         pb.Priority(task.Priority),
+		Version:          task.Version,
+		SubTasks:         pbSubTasks,
+		SubtaskTotal:     task.SubTaskTotal,
+		SubtaskCompleted: task.SubTaskCompleted,
+		Progress:         task.Progress(),
+		SyncCompletion:   task.SyncCompletion,
 	}, nil
 }
 
-func toUpdateTaskRequest(in *pb.UpdateTaskRequest) model.UpdateTaskRequest {
+func toPBTaskStats(stats model.TaskStats) *pb.TaskStats {
+	res := &pb.TaskStats{
+		From:            timestamppb.New(stats.From),
+		To:              timestamppb.New(stats.To),
+		Categories:      make([]*pb.CategoryTaskCount, 0, len(stats.Categories)),
+		Overdue:         stats.Overdue,
+		CompletedPerDay: make([]*pb.DailyCompletionCount, 0, len(stats.CompletedPerDay)),
+	}
+	for _, c := range stats.Categories {
+		res.Categories = append(res.Categories, &pb.CategoryTaskCount{
+			CategoryId: c.CategoryID,
+			Open:       c.Open,
+			Completed:  c.Completed,
+		})
+	}
+	for _, d := range stats.CompletedPerDay {
+		res.CompletedPerDay = append(res.CompletedPerDay, &pb.DailyCompletionCount{
+			Date:      timestamppb.New(d.Date),
+			Completed: d.Completed,
+		})
+	}
+	if stats.AverageCompletionTime != nil {
+		seconds := stats.AverageCompletionTime.Seconds()
+		res.AverageCompletionSeconds = &seconds
+	}
+	return res
+}
+
+func toPBTasks(tasks []model.Task) ([]*pb.Task, error) {
+	pbTasks := make([]*pb.Task, 0, len(tasks))
+	for _, task := range tasks {
+		converted, err := toPBTask(task)
+		if err != nil {
+			log.Errorf("failed to convert task to pb.Task: %v", err)
+			return nil, err
+		}
+		pbTasks = append(pbTasks, converted)
+	}
+	return pbTasks, nil
+}
+
+func toUpdateTaskRequest(in *pb.UpdateTaskRequest) (model.UpdateTaskRequest, error) {
+	if in.GetInput() == nil {
+		return model.UpdateTaskRequest{}, errMissingInput
+	}
 	req := model.UpdateTaskRequest{
 		ID: in.Input.Id,
 	}
@@ -174,8 +642,71 @@ func toUpdateTaskRequest(in *pb.UpdateTaskRequest) model.UpdateTaskRequest {
 	if in.Input.CompletedAt != nil {
 		req.CompletedAt = timestampToTime(in.Input.CompletedAt)
 	}
-	return req
+	req.Recurrence = toModelRecurrence(in.Input.Recurrence)
+	req.ClearRecurrence = in.Input.ClearRecurrence
+	req.ClearCategory = in.Input.ClearCategory
+	req.ClearDueDate = in.Input.ClearDueDate
+	if in.Input.TagIds != nil {
+		// 空のリストは「すべてのタグを外す」なので nil と区別する
+		req.TagIDs = append([]uint64{}, in.Input.TagIds.Ids...)
+	}
+	if in.Input.Priority != nil {
+		p := model.Priority(*in.Input.Priority)
+		req.Priority = &p
+	}
+	req.ExpectedVersion = in.Input.ExpectedVersion
+	req.SyncCompletion = in.Input.SyncCompletion
+	return req, nil
+}
+
+func toModelRecurrence(in *pb.Recurrence) *model.Recurrence {
+	if in == nil {
+		return nil
+	}
+
+	res := &model.Recurrence{
+		Frequency: model.RecurrenceFrequency(in.Frequency),
+		Interval:  in.Interval,
+		Until:     timestampToTime(in.Until),
+	}
+	for _, d := range in.Weekdays {
+		res.Weekdays = append(res.Weekdays, toWeekday(d))
+	}
+	return res
+}
+
+func toPBRecurrence(r *model.Recurrence) *pb.Recurrence {
+	if r == nil {
+		return nil
+	}
+
+	res := &pb.Recurrence{
+		Frequency: pb.RecurrenceFrequency(r.Frequency),
+		Interval:  r.Interval,
+		Until:     timeToTimestamp(r.Until),
+	}
+	for _, d := range r.Weekdays {
+		res.Weekdays = append(res.Weekdays, toPBWeekday(d))
+	}
+	return res
+}
+
+// toWeekday converts a Monday-first pb.Weekday. Unspecified and unknown values
+// become an out-of-range weekday so that validation rejects them.
+func toWeekday(d pb.Weekday) time.Weekday {
+	if d < pb.Weekday_WEEKDAY_MONDAY || d > pb.Weekday_WEEKDAY_SUNDAY {
+		return -1
+	}
+	return time.Weekday(d % 7)
+}
+
+func toPBWeekday(d time.Weekday) pb.Weekday {
+	if d == time.Sunday {
+		return pb.Weekday_WEEKDAY_SUNDAY
+	}
+	return pb.Weekday(d)
 }
+
 func timestampToTime(ts *timestamppb.Timestamp) *time.Time {
 	if ts == nil {
 		return nil
@@ -193,20 +724,44 @@ func timeToTimestamp(t *time.Time) *timestamppb.Timestamp {
 	return timestamppb.New(*t)
 }
 
-func toModelSubTaskFromCreateRequest(in *pb.CreateSubTaskRequest) model.SubTask {
+func toModelSubTaskFromCreateRequest(in *pb.CreateSubTaskRequest) (model.SubTask, error) {
+	if in.GetInput() == nil {
+		return model.SubTask{}, errMissingInput
+	}
 	return model.SubTask{
 		TaskID:    in.Input.TaskId,
 		Title:     in.Input.Title,
 		Note:      in.Input.Note,
 		DueDate:   timestampToTime(in.Input.DueDate),
 		Completed: 0,
+	}, nil
+}
+
+func toUpdateSubTaskRequest(in *pb.UpdateSubTaskRequest) (model.UpdateSubTaskRequest, error) {
+	if in.GetInput() == nil {
+		return model.UpdateSubTaskRequest{}, errMissingInput
+	}
+	req := model.UpdateSubTaskRequest{
+		ID: in.Input.Id,
+	}
+	if in.Input.Title != nil {
+		req.Title = in.Input.Title
+	}
+	if in.Input.Note != nil {
+		req.Note = in.Input.Note
+	}
+	if in.Input.DueDate != nil {
+		req.DueDate = timestampToTime(in.Input.DueDate)
 	}
+	req.ExpectedVersion = in.Input.ExpectedVersion
+	return req, nil
 }
 
 func toPBSubTask(sub model.SubTask) *pb.SubTask {
 	return &pb.SubTask{
 		Id:          sub.ID,
 		TaskId:      sub.TaskID,
+		Position:    sub.Position,
 		Title:       sub.Title,
 		Note:        sub.Note,
 		Completed:   sub.Completed,
@@ -214,5 +769,6 @@ func toPBSubTask(sub model.SubTask) *pb.SubTask {
 		DueDate:     timeToTimestamp(sub.DueDate),
 		CreatedAt:   timestamppb.New(sub.CreatedAt),
 		UpdatedAt:   timestamppb.New(sub.UpdatedAt),
+		Version:     sub.Version,
 	}
 }
@@ -0,0 +1,414 @@
+package controller
+
+import (
+	"context"
+	"database/sql/driver"
+	"fmt"
+	"regexp"
+	"testing"
+	"time"
+
+	"backend/Infrastructure/store"
+	"backend/domain/apperr"
+	"backend/domain/auth"
+	"backend/usecase"
+
+	pb "backend/pkg/pb"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/jinzhu/gorm"
+)
+
+const testUserID = uint64(1)
+
+func newTestTaskController(t *testing.T) (*TaskController, sqlmock.Sqlmock) {
+	t.Helper()
+
+	sqlDB, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("failed to open sqlmock: %v", err)
+	}
+	db, err := gorm.Open("mysql", sqlDB)
+	if err != nil {
+		t.Fatalf("failed to open gorm: %v", err)
+	}
+	t.Cleanup(func() { db.Close() })
+
+	taskRepo := store.NewTaskRepository(db)
+	subTaskRepo := store.NewSubTaskRepository(db)
+	uow := store.NewUnitOfWork(db)
+	taskUsecase := usecase.NewTaskUseCase(taskRepo, store.NewCategoryRepository(db), subTaskRepo, store.NewTaskHistoryRepository(db), uow, usecase.NewTaskFeed())
+	subTaskUsecase := usecase.NewSubTaskUseCase(subTaskRepo, taskRepo, uow, usecase.NewTaskFeed())
+	statsUsecase := usecase.NewTaskStatsUseCase(store.NewCategoryRepository(db), uow)
+	return NewTaskController(taskUsecase, subTaskUsecase, statsUsecase), mock
+}
+
+// TestTaskController_GetTasks_QueryCount verifies that listing tasks issues one
+// query for the tasks, one for their tags, one for their subtask counts and one
+// for all of their subtasks, however many tasks the page holds.
+func TestTaskController_GetTasks_QueryCount(t *testing.T) {
+	t.Parallel()
+
+	for _, n := range []int{1, 10, 100} {
+		n := n
+		t.Run(fmt.Sprintf("%d tasks", n), func(t *testing.T) {
+			t.Parallel()
+
+			h, mock := newTestTaskController(t)
+			now := time.Now()
+
+			taskRows := sqlmock.NewRows([]string{"id", "title", "note", "completed", "created_at", "updated_at"})
+			tagRows := sqlmock.NewRows([]string{"task_id", "tag_id"})
+			countRows := sqlmock.NewRows([]string{"task_id", "total", "completed"})
+			subTaskRows := sqlmock.NewRows([]string{"id", "task_id", "title", "note", "completed", "created_at", "updated_at"})
+			taskIDs := make([]driver.Value, 0, n)
+			for i := 1; i <= n; i++ {
+				taskRows.AddRow(i, fmt.Sprintf("task %d", i), "", 0, now, now)
+				tagRows.AddRow(i, 1)
+				countRows.AddRow(i, 1, 0)
+				subTaskRows.AddRow(i, i, fmt.Sprintf("sub task %d", i), "", 0, now, now)
+				taskIDs = append(taskIDs, uint64(i))
+			}
+
+			mock.ExpectQuery(regexp.QuoteMeta("SELECT count(*) FROM `tasks`")).
+				WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(n))
+			mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `tasks` WHERE `tasks`.`deleted_at` IS NULL AND ((user_id = ?)) ORDER BY id ASC LIMIT 101")).
+				WithArgs(testUserID).
+				WillReturnRows(taskRows)
+			mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `task_tags` WHERE (task_id IN (")).
+				WithArgs(taskIDs...).
+				WillReturnRows(tagRows)
+			mock.ExpectQuery(regexp.QuoteMeta("SELECT task_id, COUNT(*) AS total, COALESCE(SUM(completed <> 0), 0) AS completed FROM `sub_tasks`")).
+				WithArgs(taskIDs...).
+				WillReturnRows(countRows)
+			mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `sub_tasks` WHERE (task_id IN (")).
+				WithArgs(append([]driver.Value{testUserID}, taskIDs...)...).
+				WillReturnRows(subTaskRows)
+
+			res, err := h.GetTasks(auth.WithUserID(context.Background(), testUserID), &pb.GetTasksRequest{PageSize: 100})
+			if err != nil {
+				t.Fatalf("GetTasks returned error: %v", err)
+			}
+
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Fatalf("unexpected queries: %v", err)
+			}
+
+			if len(res.Tasks) != n {
+				t.Fatalf("GetTasks returned %d tasks, want %d", len(res.Tasks), n)
+			}
+			for _, task := range res.Tasks {
+				if len(task.SubTasks) != 1 || task.SubTasks[0].TaskId != task.Id {
+					t.Fatalf("task %d has sub tasks %v, want exactly its own", task.Id, task.SubTasks)
+				}
+				if len(task.TagIds) != 1 || task.TagIds[0] != 1 {
+					t.Fatalf("task %d has tags %v, want [1]", task.Id, task.TagIds)
+				}
+				if task.SubtaskTotal != 1 || task.SubtaskCompleted != 0 || task.Progress != 0 {
+					t.Fatalf("task %d has progress %d/%d (%v), want 0/1", task.Id, task.SubtaskCompleted, task.SubtaskTotal, task.Progress)
+				}
+			}
+		})
+	}
+}
+
+// TestTaskController_SearchTasks verifies the search query binds its arguments in
+// order and that ranked rows come back as results with resumable cursors.
+func TestTaskController_SearchTasks(t *testing.T) {
+	t.Parallel()
+
+	h, mock := newTestTaskController(t)
+	now := time.Now()
+	query := "report"
+
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT count(*) FROM `tasks` LEFT JOIN (SELECT task_id, MAX(MATCH(title) AGAINST (?)) AS score FROM `sub_tasks`")).
+		WithArgs(query, query, testUserID, query).
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(3))
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT tasks.*, MATCH(tasks.title, tasks.note) AGAINST (?) + COALESCE(st.score, 0) AS score FROM `tasks`")).
+		WithArgs(query, query, query, testUserID, query).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "title", "note", "completed", "created_at", "updated_at", "score"}).
+			AddRow(7, "Write report", "", 0, now, now, 1.5).
+			AddRow(3, "Read report", "", 0, now, now, 0.5).
+			AddRow(9, "report", "", 0, now, now, 0.2))
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `task_tags` WHERE (task_id IN (")).
+		WithArgs(uint64(7), uint64(3)).
+		WillReturnRows(sqlmock.NewRows([]string{"task_id", "tag_id"}))
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT task_id, COUNT(*) AS total, COALESCE(SUM(completed <> 0), 0) AS completed FROM `sub_tasks`")).
+		WithArgs(uint64(7), uint64(3)).
+		WillReturnRows(sqlmock.NewRows([]string{"task_id", "total", "completed"}))
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `sub_tasks` WHERE (task_id IN (")).
+		WithArgs(testUserID, uint64(7), uint64(3)).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "task_id", "title", "note", "completed", "created_at", "updated_at"}))
+
+	res, err := h.SearchTasks(auth.WithUserID(context.Background(), testUserID), &pb.SearchTasksRequest{Query: query, PageSize: 2})
+	if err != nil {
+		t.Fatalf("SearchTasks returned error: %v", err)
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Fatalf("unexpected queries: %v", err)
+	}
+
+	if len(res.Results) != 2 || res.Results[0].Task.Id != 7 || res.Results[0].Score != 1.5 {
+		t.Fatalf("SearchTasks results = %v, want tasks 7 and 3 ranked by score", res.Results)
+	}
+	if res.TotalCount != 3 || res.NextPageToken == "" || res.NextPageToken != res.Cursors[1] {
+		t.Fatalf("SearchTasks page = total %d, next %q, cursors %v", res.TotalCount, res.NextPageToken, res.Cursors)
+	}
+	if hl := res.Results[0].Highlights; len(hl) != 1 || hl[0].Snippet != "Write <mark>report</mark>" {
+		t.Fatalf("SearchTasks highlights = %v", hl)
+	}
+}
+
+// TestTaskController_GetTasks_DefaultPageSize verifies that a request without
+// page_size is limited to the default page size.
+func TestTaskController_GetTasks_DefaultPageSize(t *testing.T) {
+	t.Parallel()
+
+	h, mock := newTestTaskController(t)
+	now := time.Now()
+
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT count(*) FROM `tasks`")).
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(120))
+	mock.ExpectQuery(regexp.QuoteMeta("ORDER BY id ASC LIMIT 51")).
+		WithArgs(testUserID).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "title", "note", "completed", "created_at", "updated_at"}).
+			AddRow(1, "task 1", "", 0, now, now))
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `task_tags`")).
+		WillReturnRows(sqlmock.NewRows([]string{"task_id", "tag_id"}))
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT task_id, COUNT(*) AS total")).
+		WillReturnRows(sqlmock.NewRows([]string{"task_id", "total", "completed"}))
+
+	res, err := h.GetTasks(auth.WithUserID(context.Background(), testUserID), &pb.GetTasksRequest{SkipSubTasks: true})
+	if err != nil {
+		t.Fatalf("GetTasks returned error: %v", err)
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Fatalf("unexpected queries: %v", err)
+	}
+	if res.TotalCount != 120 || len(res.Tasks) != 1 {
+		t.Fatalf("GetTasks returned %d of %d tasks", len(res.Tasks), res.TotalCount)
+	}
+}
+
+// TestTaskController_TrashOnlyActions verifies that restoring and purging only
+// touch trashed tasks and report anything else as not found.
+func TestTaskController_TrashOnlyActions(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		stmt string
+		call func(h *TaskController, ctx context.Context) error
+	}{
+		{
+			name: "restore a task outside the trash",
+			stmt: "UPDATE `tasks` SET `deleted_at` = ?, `version` = version + 1 WHERE (user_id = ?) AND (id = ? AND deleted_at IS NOT NULL)",
+			call: func(h *TaskController, ctx context.Context) error {
+				_, err := h.RestoreTask(ctx, &pb.TaskId{Id: 1})
+				return err
+			},
+		},
+		{
+			name: "purge a live task",
+			stmt: "DELETE FROM `tasks` WHERE (user_id = ?) AND (id = ? AND deleted_at IS NOT NULL)",
+			call: func(h *TaskController, ctx context.Context) error {
+				_, err := h.PurgeTask(ctx, &pb.TaskId{Id: 1})
+				return err
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			h, mock := newTestTaskController(t)
+			mock.ExpectBegin()
+			mock.ExpectExec(regexp.QuoteMeta(tt.stmt)).WillReturnResult(sqlmock.NewResult(0, 0))
+			mock.ExpectRollback()
+
+			err := tt.call(h, auth.WithUserID(context.Background(), testUserID))
+			if !apperr.IsNotFound(err) {
+				t.Fatalf("error = %v, want NotFound", err)
+			}
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Fatalf("unexpected queries: %v", err)
+			}
+		})
+	}
+}
+
+// TestTaskController_MissingInput verifies that mutations without an input
+// message are rejected before they reach the database.
+func TestTaskController_MissingInput(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		call func(h *TaskController, ctx context.Context) error
+	}{
+		{
+			name: "create task",
+			call: func(h *TaskController, ctx context.Context) error {
+				_, err := h.CreateTask(ctx, &pb.CreateTaskRequest{})
+				return err
+			},
+		},
+		{
+			name: "update task",
+			call: func(h *TaskController, ctx context.Context) error {
+				_, err := h.UpdateTask(ctx, &pb.UpdateTaskRequest{})
+				return err
+			},
+		},
+		{
+			name: "create sub task",
+			call: func(h *TaskController, ctx context.Context) error {
+				_, err := h.CreateSubTask(ctx, &pb.CreateSubTaskRequest{})
+				return err
+			},
+		},
+		{
+			name: "update sub task",
+			call: func(h *TaskController, ctx context.Context) error {
+				_, err := h.UpdateSubTask(ctx, &pb.UpdateSubTaskRequest{})
+				return err
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			h, mock := newTestTaskController(t)
+
+			err := tt.call(h, auth.WithUserID(context.Background(), testUserID))
+			if apperr.CodeOf(err) != apperr.CodeInvalidArgument {
+				t.Fatalf("error = %v, want InvalidArgument", err)
+			}
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Fatalf("unexpected queries: %v", err)
+			}
+		})
+	}
+}
+
+// TestTaskController_GetTasks_OrderedPages verifies that ordered listings sort NULL
+// due dates last and that the next page resumes after the sort keys of the last task.
+func TestTaskController_GetTasks_OrderedPages(t *testing.T) {
+	t.Parallel()
+
+	h, mock := newTestTaskController(t)
+	ctx := auth.WithUserID(context.Background(), testUserID)
+	now := time.Now()
+	dueDate := time.Date(2025, time.March, 1, 0, 0, 0, 0, time.Local)
+	req := &pb.GetTasksRequest{
+		PageSize: 1,
+		OrderBy: []*pb.TaskOrder{
+			{Field: pb.TaskOrderField_TASK_ORDER_FIELD_DUE_DATE, Direction: pb.SortDirection_SORT_DIRECTION_DESC},
+			{Field: pb.TaskOrderField_TASK_ORDER_FIELD_TITLE},
+		},
+	}
+	columns := []string{"id", "title", "note", "completed", "due_date", "created_at", "updated_at"}
+	orderBy := "ORDER BY due_date IS NULL,due_date DESC,title ASC,id ASC LIMIT 2"
+
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT count(*) FROM `tasks`")).
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(3))
+	mock.ExpectQuery(regexp.QuoteMeta(orderBy)).
+		WithArgs(testUserID).
+		WillReturnRows(sqlmock.NewRows(columns).
+			AddRow(4, "pay rent", "", 0, dueDate, now, now).
+			AddRow(2, "buy milk", "", 0, nil, now, now))
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `task_tags`")).
+		WillReturnRows(sqlmock.NewRows([]string{"task_id", "tag_id"}))
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT task_id, COUNT(*) AS total")).
+		WillReturnRows(sqlmock.NewRows([]string{"task_id", "total", "completed"}))
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `sub_tasks`")).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "task_id"}))
+
+	first, err := h.GetTasks(ctx, req)
+	if err != nil {
+		t.Fatalf("GetTasks returned error: %v", err)
+	}
+	if first.NextPageToken == "" {
+		t.Fatalf("GetTasks returned no next page token")
+	}
+
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT count(*) FROM `tasks`")).
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(3))
+	mock.ExpectQuery(regexp.QuoteMeta("((due_date < ? OR due_date IS NULL)) OR (due_date = ? AND title > ?) OR (due_date = ? AND title = ? AND id > ?))) "+orderBy)).
+		WithArgs(testUserID, "2025-03-01", "2025-03-01", "pay rent", "2025-03-01", "pay rent", uint64(4)).
+		WillReturnRows(sqlmock.NewRows(columns).AddRow(2, "buy milk", "", 0, nil, now, now))
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `task_tags`")).
+		WillReturnRows(sqlmock.NewRows([]string{"task_id", "tag_id"}))
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT task_id, COUNT(*) AS total")).
+		WillReturnRows(sqlmock.NewRows([]string{"task_id", "total", "completed"}))
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `sub_tasks`")).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "task_id"}))
+
+	req.PageToken = first.NextPageToken
+	second, err := h.GetTasks(ctx, req)
+	if err != nil {
+		t.Fatalf("GetTasks returned error: %v", err)
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Fatalf("unexpected queries: %v", err)
+	}
+	if len(second.Tasks) != 1 || second.Tasks[0].Id != 2 || second.NextPageToken != "" {
+		t.Fatalf("second page = %v (next %q), want only task 2", second.Tasks, second.NextPageToken)
+	}
+}
+
+// TestTaskController_GetTaskStats verifies that statistics are aggregated in SQL within one
+// transaction, scoped to the caller and the category, and that days without completions are filled in.
+func TestTaskController_GetTaskStats(t *testing.T) {
+	t.Parallel()
+
+	h, mock := newTestTaskController(t)
+	ctx := auth.WithUserID(context.Background(), testUserID)
+	now := time.Now().UTC()
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+	categoryID := uint64(3)
+
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `categories` WHERE (user_id IS NULL OR user_id = ?) AND (id = ?)")).
+		WithArgs(testUserID, categoryID).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "name"}).AddRow(categoryID, "work"))
+	mock.ExpectBegin()
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT category_id, COALESCE(SUM(completed = 0), 0) AS open_count")).
+		WithArgs(sqlmock.AnyArg(), testUserID, categoryID).
+		WillReturnRows(sqlmock.NewRows([]string{"category_id", "open_count", "completed_count"}).AddRow(categoryID, 2, 4))
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT count(*) FROM `tasks`")).
+		WithArgs(testUserID, categoryID, today.Format("2006-01-02")).
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT DATE(completed_at) AS day, COUNT(*) AS completed FROM `tasks`")).
+		WithArgs(testUserID, categoryID, sqlmock.AnyArg()).
+		WillReturnRows(sqlmock.NewRows([]string{"day", "completed"}).AddRow(today, 4))
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT AVG(TIMESTAMPDIFF(SECOND, created_at, completed_at)) AS seconds FROM `tasks`")).
+		WithArgs(testUserID, categoryID, sqlmock.AnyArg()).
+		WillReturnRows(sqlmock.NewRows([]string{"seconds"}).AddRow(5400.0))
+	mock.ExpectCommit()
+
+	res, err := h.GetTaskStats(ctx, &pb.GetTaskStatsRequest{Weeks: 1, CategoryId: &categoryID})
+	if err != nil {
+		t.Fatalf("GetTaskStats returned error: %v", err)
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Fatalf("unexpected queries: %v", err)
+	}
+	if len(res.Categories) != 1 || res.Categories[0].Open != 2 || res.Categories[0].Completed != 4 || res.Overdue != 1 {
+		t.Fatalf("GetTaskStats counts = %v, overdue %d", res.Categories, res.Overdue)
+	}
+	if len(res.CompletedPerDay) != 7 || res.CompletedPerDay[6].Completed != 4 || res.CompletedPerDay[0].Completed != 0 {
+		t.Fatalf("GetTaskStats completed per day = %v, want 7 days ending with 4 today", res.CompletedPerDay)
+	}
+	if res.AverageCompletionSeconds == nil || *res.AverageCompletionSeconds != 5400 {
+		t.Fatalf("GetTaskStats average = %v, want 5400 seconds", res.AverageCompletionSeconds)
+	}
+}
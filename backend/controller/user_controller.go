@@ -0,0 +1,51 @@
+package controller
+
+import (
+	"context"
+
+	"backend/domain/model"
+	"backend/usecase"
+
+	pb "backend/pkg/pb"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// UserController bridges account gRPC requests with the use case layer.
+type UserController struct {
+	pb.UnimplementedUserServiceServer
+	usecase usecase.UserUseCase
+}
+
+// NewUserController constructs a UserController.
+func NewUserController(uc usecase.UserUseCase) *UserController {
+	return &UserController{usecase: uc}
+}
+
+// SignUp handles account registration.
+func (h *UserController) SignUp(ctx context.Context, in *pb.SignUpRequest) (*pb.User, error) {
+	user, err := h.usecase.SignUp(ctx, in.Email, in.Password)
+	if err != nil {
+		return nil, err
+	}
+
+	return toPBUser(*user), nil
+}
+
+// Authenticate handles credential checks.
+func (h *UserController) Authenticate(ctx context.Context, in *pb.AuthenticateRequest) (*pb.User, error) {
+	user, err := h.usecase.Authenticate(ctx, in.Email, in.Password)
+	if err != nil {
+		return nil, err
+	}
+
+	return toPBUser(*user), nil
+}
+
+func toPBUser(u model.User) *pb.User {
+	return &pb.User{
+		Id:        u.ID,
+		Email:     u.Email,
+		CreatedAt: timestamppb.New(u.CreatedAt),
+	}
+}
@@ -0,0 +1,151 @@
+// Package apperr defines the typed errors that use cases and repositories
+// return, independent of the transport used to report them.
+package apperr
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Code classifies an Error.
+type Code int
+
+const (
+	// CodeUnknown is reported for errors that are not an *Error.
+	CodeUnknown Code = iota
+	// CodeNotFound means the requested resource does not exist.
+	CodeNotFound
+	// CodeInvalidArgument means the request itself is malformed.
+	CodeInvalidArgument
+	// CodeConflict means the request clashes with an existing resource.
+	CodeConflict
+	// CodeFailedPrecondition means the system is not in a state that allows the request.
+	CodeFailedPrecondition
+	// CodeUnavailable means a dependency such as the database could not be reached.
+	CodeUnavailable
+	// CodeUnauthenticated means the caller could not be identified.
+	CodeUnauthenticated
+	// CodeAborted means the request raced with a concurrent change and may be retried after re-reading.
+	CodeAborted
+)
+
+// String returns a readable name of the code.
+func (c Code) String() string {
+	switch c {
+	case CodeNotFound:
+		return "NotFound"
+	case CodeInvalidArgument:
+		return "InvalidArgument"
+	case CodeConflict:
+		return "Conflict"
+	case CodeFailedPrecondition:
+		return "FailedPrecondition"
+	case CodeUnavailable:
+		return "Unavailable"
+	case CodeUnauthenticated:
+		return "Unauthenticated"
+	case CodeAborted:
+		return "Aborted"
+	default:
+		return "Unknown"
+	}
+}
+
+// FieldViolation describes why a single request field was rejected.
+type FieldViolation struct {
+	Field       string
+	Description string
+}
+
+// Error is a domain error carrying a Code and optional structured details.
+type Error struct {
+	Code    Code
+	Message string
+	// Resource and ID identify the missing entity of a NotFound error.
+	Resource string
+	ID       uint64
+	// Violations lists the offending fields of an InvalidArgument error.
+	Violations []FieldViolation
+	// Subject names what a FailedPrecondition error is about.
+	Subject string
+	// Current is the server copy of the resource an Aborted error is about, e.g. a *model.Task.
+	Current any
+	// Err is the underlying cause, if any.
+	Err error
+}
+
+// Error implements the error interface.
+func (e *Error) Error() string {
+	if e.Err != nil {
+		return fmt.Sprintf("%s: %v", e.Message, e.Err)
+	}
+	return e.Message
+}
+
+// Unwrap exposes the underlying cause.
+func (e *Error) Unwrap() error {
+	return e.Err
+}
+
+// NotFound reports that the resource with the given id does not exist.
+func NotFound(resource string, id uint64) *Error {
+	return &Error{
+		Code:     CodeNotFound,
+		Message:  fmt.Sprintf("%s %d not found", resource, id),
+		Resource: resource,
+		ID:       id,
+	}
+}
+
+// InvalidArgument reports a malformed request, optionally listing the offending fields.
+func InvalidArgument(message string, violations ...FieldViolation) *Error {
+	return &Error{
+		Code:       CodeInvalidArgument,
+		Message:    message,
+		Violations: violations,
+	}
+}
+
+// Conflict reports that the request clashes with existing data.
+func Conflict(message string) *Error {
+	return &Error{Code: CodeConflict, Message: message}
+}
+
+// FailedPrecondition reports that subject is not in a state that allows the request.
+func FailedPrecondition(subject, message string) *Error {
+	return &Error{
+		Code:    CodeFailedPrecondition,
+		Message: message,
+		Subject: subject,
+	}
+}
+
+// Unavailable reports that a dependency could not be reached.
+func Unavailable(message string, cause error) *Error {
+	return &Error{Code: CodeUnavailable, Message: message, Err: cause}
+}
+
+// Aborted reports that the request was based on a stale copy of a resource. current is
+// the copy the server holds now, so that the caller can merge and retry.
+func Aborted(message string, current any) *Error {
+	return &Error{Code: CodeAborted, Message: message, Current: current}
+}
+
+// Unauthenticated reports that the caller is unknown or presented invalid credentials.
+func Unauthenticated(message string) *Error {
+	return &Error{Code: CodeUnauthenticated, Message: message}
+}
+
+// CodeOf returns the Code of the first *Error in err's chain, or CodeUnknown.
+func CodeOf(err error) Code {
+	var e *Error
+	if errors.As(err, &e) {
+		return e.Code
+	}
+	return CodeUnknown
+}
+
+// IsNotFound reports whether err is a NotFound error.
+func IsNotFound(err error) bool {
+	return CodeOf(err) == CodeNotFound
+}
@@ -0,0 +1,17 @@
+// Package auth carries the identity of the calling user through a request.
+package auth
+
+import "context"
+
+type userIDKey struct{}
+
+// WithUserID returns a copy of ctx that carries the calling user's id.
+func WithUserID(ctx context.Context, userID uint64) context.Context {
+	return context.WithValue(ctx, userIDKey{}, userID)
+}
+
+// UserIDFromContext returns the calling user's id, if ctx carries one.
+func UserIDFromContext(ctx context.Context) (uint64, bool) {
+	userID, ok := ctx.Value(userIDKey{}).(uint64)
+	return userID, ok && userID != 0
+}
@@ -0,0 +1,31 @@
+package model
+
+import "time"
+
+// BulkTaskChange lists the fields a bulk update sets on every selected task.
+// Nil fields are left as they are.
+type BulkTaskChange struct {
+	Completed *int32
+	// CategoryID moves the tasks to a category. Zero removes their category.
+	CategoryID *uint64
+	DueDate    *time.Time
+	// ShiftDueDateDays moves each due date by this many days. Tasks without a due date are
+	// left as they are. It cannot be combined with DueDate.
+	ShiftDueDateDays *int32
+}
+
+// BulkTaskOutcome is what a bulk operation did to one task.
+type BulkTaskOutcome struct {
+	TaskID uint64
+	// Task is the updated task. It is only set by applied bulk updates.
+	Task *Task
+	// Err is why the task could not be changed.
+	Err error
+}
+
+// BulkTaskResult reports a bulk operation. Bulk operations are all or nothing: when any task
+// fails, Applied is false and no task is changed.
+type BulkTaskResult struct {
+	Applied  bool
+	Outcomes []BulkTaskOutcome
+}
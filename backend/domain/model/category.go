@@ -9,3 +9,22 @@ type Category struct {
 	CreatedAt time.Time
 	UpdatedAt time.Time
 }
+
+// DeleteCategoryPolicy decides how tasks referencing a deleted category are handled.
+type DeleteCategoryPolicy int32
+
+const (
+	// DeleteCategoryReject refuses the deletion while tasks still reference the category.
+	DeleteCategoryReject DeleteCategoryPolicy = iota
+	// DeleteCategoryReassign moves the referencing tasks to another category.
+	DeleteCategoryReassign
+	// DeleteCategoryNullify clears the category of the referencing tasks.
+	DeleteCategoryNullify
+)
+
+// DeleteCategoryRequest carries the parameters of a category deletion.
+type DeleteCategoryRequest struct {
+	ID         uint64
+	Policy     DeleteCategoryPolicy
+	ReassignTo *uint64
+}
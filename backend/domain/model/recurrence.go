@@ -0,0 +1,126 @@
+package model
+
+import "time"
+
+// RecurrenceFrequency is the unit a recurring task repeats in.
+type RecurrenceFrequency int32
+
+const (
+	RecurrenceDaily RecurrenceFrequency = iota + 1
+	RecurrenceWeekly
+	RecurrenceMonthly
+	RecurrenceYearly
+)
+
+// Recurrence is an RRULE-style schedule of a recurring task.
+type Recurrence struct {
+	Frequency RecurrenceFrequency
+	// Interval is the number of frequency units between occurrences, at least 1.
+	Interval int32
+	// Weekdays restricts weekly schedules to these days. Empty repeats on the weekday of the due date.
+	Weekdays []time.Weekday
+	// Until is the last date an occurrence may fall on.
+	Until *time.Time
+	// MonthDay, and Month for yearly schedules, pin the schedule to the date the series
+	// started on, so that an occurrence clamped to the end of a short month does not move
+	// the ones after it (Jan 31, Feb 28, Mar 31). Zero takes them from the due date.
+	MonthDay int32
+	Month    time.Month
+}
+
+// AnchoredAt returns the schedule pinned to the day and month of start, unless it is
+// pinned already. Daily and weekly schedules cannot drift and are returned unchanged.
+func (r Recurrence) AnchoredAt(start time.Time) Recurrence {
+	if r.MonthDay != 0 {
+		return r
+	}
+	switch r.Frequency {
+	case RecurrenceMonthly:
+		r.MonthDay = int32(start.Day())
+	case RecurrenceYearly:
+		r.MonthDay = int32(start.Day())
+		r.Month = start.Month()
+	}
+	return r
+}
+
+// Unanchored returns the schedule without the date it is pinned to.
+func (r Recurrence) Unanchored() Recurrence {
+	r.MonthDay = 0
+	r.Month = 0
+	return r
+}
+
+// Next returns the first occurrence after due, or false when the schedule has ended.
+func (r Recurrence) Next(due time.Time) (time.Time, bool) {
+	interval := int(r.Interval)
+	if interval < 1 {
+		interval = 1
+	}
+
+	day, month := due.Day(), due.Month()
+	if r.MonthDay > 0 {
+		day = int(r.MonthDay)
+	}
+	if r.Month > 0 {
+		month = r.Month
+	}
+
+	var next time.Time
+	switch r.Frequency {
+	case RecurrenceDaily:
+		next = due.AddDate(0, 0, interval)
+	case RecurrenceWeekly:
+		next = r.nextWeekly(due, interval)
+	case RecurrenceMonthly:
+		next = dateClamped(due, due.Year(), due.Month()+time.Month(interval), day)
+	case RecurrenceYearly:
+		next = dateClamped(due, due.Year()+interval, month, day)
+	default:
+		return time.Time{}, false
+	}
+
+	if r.Until != nil && next.After(*r.Until) {
+		return time.Time{}, false
+	}
+	return next, true
+}
+
+// nextWeekly finds the next listed weekday, skipping weeks that are not a
+// multiple of interval away from the week of due. Weeks start on Monday.
+func (r Recurrence) nextWeekly(due time.Time, interval int) time.Time {
+	if len(r.Weekdays) == 0 {
+		return due.AddDate(0, 0, 7*interval)
+	}
+
+	days := make(map[time.Weekday]bool, len(r.Weekdays))
+	for _, d := range r.Weekdays {
+		days[d] = true
+	}
+	start := weekStart(due)
+	for i := 1; i <= 7*interval; i++ {
+		candidate := due.AddDate(0, 0, i)
+		weeks := int(weekStart(candidate).Sub(start).Hours()+12) / (24 * 7)
+		if days[candidate.Weekday()] && weeks%interval == 0 {
+			return candidate
+		}
+	}
+	// Unreachable with a non-empty weekday set: the same weekday interval weeks later always matches.
+	return due.AddDate(0, 0, 7*interval)
+}
+
+// weekStart returns the Monday of the week containing t.
+func weekStart(t time.Time) time.Time {
+	offset := (int(t.Weekday()) + 6) % 7
+	return time.Date(t.Year(), t.Month(), t.Day()-offset, 0, 0, 0, 0, t.Location())
+}
+
+// dateClamped returns the date at the time of day of t, clamping day to the end of
+// the month instead of overflowing into the next one (February 31 is Feb 28).
+func dateClamped(t time.Time, year int, month time.Month, day int) time.Time {
+	first := time.Date(year, month, 1, t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), t.Location())
+	if lastDay := first.AddDate(0, 1, -1).Day(); day > lastDay {
+		day = lastDay
+	}
+	return first.AddDate(0, 0, day-1)
+}
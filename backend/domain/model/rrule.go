@@ -0,0 +1,102 @@
+package model
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// rruleDateLayout is the date form of the RRULE UNTIL part.
+const rruleDateLayout = "20060102"
+
+var (
+	rruleFrequencies = map[RecurrenceFrequency]string{
+		RecurrenceDaily:   "DAILY",
+		RecurrenceWeekly:  "WEEKLY",
+		RecurrenceMonthly: "MONTHLY",
+		RecurrenceYearly:  "YEARLY",
+	}
+	rruleWeekdays = map[time.Weekday]string{
+		time.Monday:    "MO",
+		time.Tuesday:   "TU",
+		time.Wednesday: "WE",
+		time.Thursday:  "TH",
+		time.Friday:    "FR",
+		time.Saturday:  "SA",
+		time.Sunday:    "SU",
+	}
+)
+
+// RRule renders the schedule as an RFC 5545 RRULE value, e.g.
+// "FREQ=WEEKLY;INTERVAL=2;BYDAY=MO,FR;UNTIL=20251231". Unlike RFC 5545, a BYMONTHDAY
+// beyond the end of a month falls on its last day rather than skipping the month.
+func (r Recurrence) RRule() string {
+	parts := []string{"FREQ=" + rruleFrequencies[r.Frequency]}
+	if r.Interval > 1 {
+		parts = append(parts, fmt.Sprintf("INTERVAL=%d", r.Interval))
+	}
+	if len(r.Weekdays) > 0 {
+		days := make([]string, 0, len(r.Weekdays))
+		for _, d := range r.Weekdays {
+			days = append(days, rruleWeekdays[d])
+		}
+		parts = append(parts, "BYDAY="+strings.Join(days, ","))
+	}
+	if r.Month > 0 {
+		parts = append(parts, fmt.Sprintf("BYMONTH=%d", r.Month))
+	}
+	if r.MonthDay > 0 {
+		parts = append(parts, fmt.Sprintf("BYMONTHDAY=%d", r.MonthDay))
+	}
+	if r.Until != nil {
+		parts = append(parts, "UNTIL="+r.Until.Format(rruleDateLayout))
+	}
+
+	return strings.Join(parts, ";")
+}
+
+// ParseRRule reads a rule written by Recurrence.RRule. Unknown parts are ignored, and
+// rules without a known frequency yield nil.
+func ParseRRule(rule string) *Recurrence {
+	r := &Recurrence{Interval: 1}
+	for _, part := range strings.Split(rule, ";") {
+		key, value, _ := strings.Cut(part, "=")
+		switch key {
+		case "FREQ":
+			for freq, name := range rruleFrequencies {
+				if name == value {
+					r.Frequency = freq
+				}
+			}
+		case "INTERVAL":
+			if n, err := strconv.ParseInt(value, 10, 32); err == nil && n > 0 {
+				r.Interval = int32(n)
+			}
+		case "BYDAY":
+			for _, day := range strings.Split(value, ",") {
+				for weekday, name := range rruleWeekdays {
+					if name == day {
+						r.Weekdays = append(r.Weekdays, weekday)
+					}
+				}
+			}
+		case "BYMONTH":
+			if n, err := strconv.ParseInt(value, 10, 32); err == nil && n >= 1 && n <= 12 {
+				r.Month = time.Month(n)
+			}
+		case "BYMONTHDAY":
+			if n, err := strconv.ParseInt(value, 10, 32); err == nil && n >= 1 && n <= 31 {
+				r.MonthDay = int32(n)
+			}
+		case "UNTIL":
+			if until, err := time.ParseInLocation(rruleDateLayout, value, time.Local); err == nil {
+				r.Until = &until
+			}
+		}
+	}
+	if r.Frequency == 0 {
+		return nil
+	}
+	return r
+}
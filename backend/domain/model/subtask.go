@@ -6,6 +6,7 @@ import "time"
 type SubTask struct {
 	ID          uint64
 	TaskID      uint64
+	Position    int32
 	Title       string
 	Note        string
 	Completed   int32
@@ -13,4 +14,16 @@ type SubTask struct {
 	DueDate     *time.Time
 	CreatedAt   time.Time
 	UpdatedAt   time.Time
+	// Version increases with every update and guards against lost updates.
+	Version int32
+}
+
+// UpdateSubTaskRequest carries a partial update of a sub task. Nil fields are left untouched.
+type UpdateSubTaskRequest struct {
+	ID      uint64
+	Title   *string
+	Note    *string
+	DueDate *time.Time
+	// ExpectedVersion rejects the update when the sub task has changed since the caller read it.
+	ExpectedVersion *int32
 }
@@ -0,0 +1,11 @@
+package model
+
+import "time"
+
+// Tag represents a label that can be attached to any number of tasks.
+type Tag struct {
+	ID        uint64
+	Name      string
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
@@ -13,9 +13,46 @@ type Task struct {
 	CategoryID  uint64
 	CreatedAt   time.Time
 	UpdatedAt   time.Time
-	SubTasks    []SubTask
+	DeletedAt   *time.Time
+	// Recurrence is set for tasks that are recreated with the next due date once completed.
+	Recurrence *Recurrence
+	TagIDs     []uint64
+	Priority   Priority
+	// Version increases with every update and guards against lost updates.
+	Version  int32
+	SubTasks []SubTask
+	// SubTaskTotal and SubTaskCompleted count the subtasks of the task, whether or not
+	// SubTasks is loaded.
+	SubTaskTotal     int32
+	SubTaskCompleted int32
+	// SyncCompletion keeps the task in step with its subtasks: it is completed once every
+	// subtask is, reopened when one is unchecked, and completing it completes its open subtasks.
+	SyncCompletion bool
 }
 
+// Progress is the share of completed subtasks, from 0 to 1. A task without subtasks
+// counts as fully done once it is completed itself.
+func (t Task) Progress() float64 {
+	if t.SubTaskTotal == 0 {
+		if t.Completed != 0 {
+			return 1
+		}
+		return 0
+	}
+	return float64(t.SubTaskCompleted) / float64(t.SubTaskTotal)
+}
+
+// Priority ranks how important a task is. Higher values are more important.
+type Priority int32
+
+const (
+	PriorityNone Priority = iota
+	PriorityLow
+	PriorityMedium
+	PriorityHigh
+	PriorityUrgent
+)
+
 type UpdateTaskRequest struct {
 	ID          uint64
 	Title       *string
@@ -24,4 +61,17 @@ type UpdateTaskRequest struct {
 	CompletedAt *time.Time
 	CategoryID  *uint64
 	DueDate     *time.Time
+	// ClearCategory and ClearDueDate remove the category and due date. They take precedence
+	// over CategoryID and DueDate.
+	ClearCategory bool
+	ClearDueDate  bool
+	// Recurrence replaces the schedule when set. ClearRecurrence removes it.
+	Recurrence      *Recurrence
+	ClearRecurrence bool
+	// TagIDs replaces the tags of the task when non-nil. An empty slice removes every tag.
+	TagIDs   []uint64
+	Priority *Priority
+	// ExpectedVersion rejects the update when the task has changed since the caller read it.
+	ExpectedVersion *int32
+	SyncCompletion  *bool
 }
@@ -0,0 +1,26 @@
+package model
+
+// TaskEventType classifies a change to a task.
+type TaskEventType int32
+
+const (
+	// TaskCreated is published when a task is created or restored from the trash.
+	TaskCreated TaskEventType = iota + 1
+	// TaskUpdated is published when a task or one of its subtasks changes.
+	TaskUpdated
+	// TaskDeleted is published when a task is moved to the trash.
+	TaskDeleted
+	// SubTaskToggled is published when a subtask is completed or reopened.
+	SubTaskToggled
+)
+
+// TaskEvent describes a single change to the tasks of a user.
+type TaskEvent struct {
+	Type   TaskEventType
+	UserID uint64
+	TaskID uint64
+	// Task is set for TaskCreated and TaskUpdated.
+	Task *Task
+	// SubTask is set for SubTaskToggled.
+	SubTask *SubTask
+}
@@ -0,0 +1,31 @@
+package model
+
+import "time"
+
+// TaskHistoryAction is the kind of change a TaskHistoryEntry records.
+type TaskHistoryAction int32
+
+const (
+	TaskHistoryCreated TaskHistoryAction = iota + 1
+	TaskHistoryUpdated
+	TaskHistoryDeleted
+	TaskHistoryRestored
+	TaskHistoryPurged
+)
+
+// TaskHistoryEntry records a single change to a task or one of its subtasks.
+type TaskHistoryEntry struct {
+	ID     uint64
+	TaskID uint64
+	// SubTaskID is set when the change concerns a subtask rather than the task itself.
+	SubTaskID *uint64
+	// UserID is the user who made the change.
+	UserID uint64
+	Action TaskHistoryAction
+	// Field, OldValue and NewValue describe TaskHistoryUpdated entries. A nil value stands
+	// for a field that was not set.
+	Field     string
+	OldValue  *string
+	NewValue  *string
+	CreatedAt time.Time
+}
@@ -0,0 +1,16 @@
+package model
+
+// SearchHighlight is an excerpt of a task field that matched a search query.
+type SearchHighlight struct {
+	// Field is "title", "note" or "sub_tasks.title".
+	Field string
+	// Snippet is HTML-escaped, with the matched terms wrapped in <mark></mark>.
+	Snippet string
+}
+
+// TaskSearchResult is a task matching a full-text search, with its relevance.
+type TaskSearchResult struct {
+	Task       Task
+	Score      float64
+	Highlights []SearchHighlight
+}
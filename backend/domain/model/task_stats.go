@@ -0,0 +1,34 @@
+package model
+
+import "time"
+
+// TaskStats summarises the tasks of a user for reviews and dashboards. Open and overdue
+// counts describe the tasks as they are now; completion figures cover the days from
+// From to To, both inclusive.
+type TaskStats struct {
+	From time.Time
+	To   time.Time
+	// Categories lists one entry per category that has open tasks or tasks completed in the
+	// range. Tasks without a category are counted under CategoryID 0.
+	Categories []CategoryTaskCount
+	// Overdue counts open tasks whose due date has passed.
+	Overdue int32
+	// CompletedPerDay has an entry for every day of the range, including days without completions.
+	CompletedPerDay []DailyCompletionCount
+	// AverageCompletionTime is the mean time from creation to completion of the tasks
+	// completed in the range, or nil when there are none.
+	AverageCompletionTime *time.Duration
+}
+
+// CategoryTaskCount counts the tasks of one category.
+type CategoryTaskCount struct {
+	CategoryID uint64
+	Open       int32
+	Completed  int32
+}
+
+// DailyCompletionCount counts the tasks completed on one day.
+type DailyCompletionCount struct {
+	Date      time.Time
+	Completed int32
+}
@@ -0,0 +1,12 @@
+package model
+
+import "time"
+
+// User represents an account that owns tasks and categories.
+type User struct {
+	ID           uint64
+	Email        string
+	PasswordHash string
+	CreatedAt    time.Time
+	UpdatedAt    time.Time
+}
@@ -1,11 +1,24 @@
 package repository
 
 import (
+	"backend/domain/apperr"
 	"backend/domain/model"
 	"context"
 )
 
+var (
+	// ErrCategoryInUse is returned when a category still referenced by tasks is deleted with the reject policy.
+	ErrCategoryInUse = apperr.FailedPrecondition("category", "category is still referenced by tasks")
+	// ErrSharedCategory is returned when a category shared by all users is renamed or deleted.
+	ErrSharedCategory = apperr.FailedPrecondition("category", "shared categories cannot be modified")
+)
+
 // CategoryRepository defines persistence operations for categories.
 type CategoryRepository interface {
 	ListCategories(ctx context.Context) ([]model.Category, error)
+	FindCategoryByID(ctx context.Context, id uint64) (*model.Category, error)
+	CreateCategory(ctx context.Context, in model.Category) (*model.Category, error)
+	UpdateCategory(ctx context.Context, in model.Category) (*model.Category, error)
+	// DeleteCategory removes a category and returns the ids of the tasks moved out of it.
+	DeleteCategory(ctx context.Context, in model.DeleteCategoryRequest) ([]uint64, error)
 }
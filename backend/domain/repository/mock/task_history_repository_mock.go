@@ -0,0 +1,65 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: backend/domain/repository (interfaces: TaskHistoryRepository)
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	model "backend/domain/model"
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockTaskHistoryRepository is a mock of TaskHistoryRepository interface.
+type MockTaskHistoryRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockTaskHistoryRepositoryMockRecorder
+}
+
+// MockTaskHistoryRepositoryMockRecorder is the mock recorder for MockTaskHistoryRepository.
+type MockTaskHistoryRepositoryMockRecorder struct {
+	mock *MockTaskHistoryRepository
+}
+
+// NewMockTaskHistoryRepository creates a new mock instance.
+func NewMockTaskHistoryRepository(ctrl *gomock.Controller) *MockTaskHistoryRepository {
+	mock := &MockTaskHistoryRepository{ctrl: ctrl}
+	mock.recorder = &MockTaskHistoryRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTaskHistoryRepository) EXPECT() *MockTaskHistoryRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Append mocks base method.
+func (m *MockTaskHistoryRepository) Append(arg0 context.Context, arg1 []model.TaskHistoryEntry) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Append", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Append indicates an expected call of Append.
+func (mr *MockTaskHistoryRepositoryMockRecorder) Append(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Append", reflect.TypeOf((*MockTaskHistoryRepository)(nil).Append), arg0, arg1)
+}
+
+// ListByTaskID mocks base method.
+func (m *MockTaskHistoryRepository) ListByTaskID(arg0 context.Context, arg1 uint64) ([]model.TaskHistoryEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListByTaskID", arg0, arg1)
+	ret0, _ := ret[0].([]model.TaskHistoryEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListByTaskID indicates an expected call of ListByTaskID.
+func (mr *MockTaskHistoryRepositoryMockRecorder) ListByTaskID(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByTaskID", reflect.TypeOf((*MockTaskHistoryRepository)(nil).ListByTaskID), arg0, arg1)
+}
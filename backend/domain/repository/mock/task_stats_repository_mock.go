@@ -0,0 +1,98 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: backend/domain/repository (interfaces: TaskStatsRepository)
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	model "backend/domain/model"
+	repository "backend/domain/repository"
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockTaskStatsRepository is a mock of TaskStatsRepository interface.
+type MockTaskStatsRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockTaskStatsRepositoryMockRecorder
+}
+
+// MockTaskStatsRepositoryMockRecorder is the mock recorder for MockTaskStatsRepository.
+type MockTaskStatsRepositoryMockRecorder struct {
+	mock *MockTaskStatsRepository
+}
+
+// NewMockTaskStatsRepository creates a new mock instance.
+func NewMockTaskStatsRepository(ctrl *gomock.Controller) *MockTaskStatsRepository {
+	mock := &MockTaskStatsRepository{ctrl: ctrl}
+	mock.recorder = &MockTaskStatsRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTaskStatsRepository) EXPECT() *MockTaskStatsRepositoryMockRecorder {
+	return m.recorder
+}
+
+// AverageCompletionTime mocks base method.
+func (m *MockTaskStatsRepository) AverageCompletionTime(arg0 context.Context, arg1 repository.TaskStatsFilter) (*time.Duration, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AverageCompletionTime", arg0, arg1)
+	ret0, _ := ret[0].(*time.Duration)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AverageCompletionTime indicates an expected call of AverageCompletionTime.
+func (mr *MockTaskStatsRepositoryMockRecorder) AverageCompletionTime(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AverageCompletionTime", reflect.TypeOf((*MockTaskStatsRepository)(nil).AverageCompletionTime), arg0, arg1)
+}
+
+// CompletedPerDay mocks base method.
+func (m *MockTaskStatsRepository) CompletedPerDay(arg0 context.Context, arg1 repository.TaskStatsFilter) ([]model.DailyCompletionCount, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CompletedPerDay", arg0, arg1)
+	ret0, _ := ret[0].([]model.DailyCompletionCount)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CompletedPerDay indicates an expected call of CompletedPerDay.
+func (mr *MockTaskStatsRepositoryMockRecorder) CompletedPerDay(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CompletedPerDay", reflect.TypeOf((*MockTaskStatsRepository)(nil).CompletedPerDay), arg0, arg1)
+}
+
+// CountByCategory mocks base method.
+func (m *MockTaskStatsRepository) CountByCategory(arg0 context.Context, arg1 repository.TaskStatsFilter) ([]model.CategoryTaskCount, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountByCategory", arg0, arg1)
+	ret0, _ := ret[0].([]model.CategoryTaskCount)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountByCategory indicates an expected call of CountByCategory.
+func (mr *MockTaskStatsRepositoryMockRecorder) CountByCategory(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountByCategory", reflect.TypeOf((*MockTaskStatsRepository)(nil).CountByCategory), arg0, arg1)
+}
+
+// CountOverdue mocks base method.
+func (m *MockTaskStatsRepository) CountOverdue(arg0 context.Context, arg1 repository.TaskStatsFilter, arg2 time.Time) (int32, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountOverdue", arg0, arg1, arg2)
+	ret0, _ := ret[0].(int32)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountOverdue indicates an expected call of CountOverdue.
+func (mr *MockTaskStatsRepositoryMockRecorder) CountOverdue(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountOverdue", reflect.TypeOf((*MockTaskStatsRepository)(nil).CountOverdue), arg0, arg1, arg2)
+}
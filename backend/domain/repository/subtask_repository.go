@@ -7,7 +7,11 @@ import (
 
 type SubTaskRepository interface {
 	ListByTaskID(ctx context.Context, taskID uint64) ([]model.SubTask, error)
+	ListByTaskIDs(ctx context.Context, taskIDs []uint64) (map[uint64][]model.SubTask, error)
 	Create(ctx context.Context, in model.SubTask) (*model.SubTask, error)
 	Update(ctx context.Context, in model.SubTask) (*model.SubTask, error)
 	FindByID(ctx context.Context, id uint64) (*model.SubTask, error)
+	Delete(ctx context.Context, id uint64) error
+	// Reorder assigns positions following the order of ids, which must belong to taskID.
+	Reorder(ctx context.Context, taskID uint64, ids []uint64) error
 }
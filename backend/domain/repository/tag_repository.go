@@ -0,0 +1,18 @@
+package repository
+
+import (
+	"backend/domain/model"
+	"context"
+)
+
+// TagRepository defines persistence operations for tags.
+type TagRepository interface {
+	ListTags(ctx context.Context) ([]model.Tag, error)
+	// FindTagsByIDs returns the calling user's tags among ids. Unknown ids are skipped.
+	FindTagsByIDs(ctx context.Context, ids []uint64) ([]model.Tag, error)
+	FindTagByID(ctx context.Context, id uint64) (*model.Tag, error)
+	CreateTag(ctx context.Context, in model.Tag) (*model.Tag, error)
+	UpdateTag(ctx context.Context, in model.Tag) (*model.Tag, error)
+	// DeleteTag removes a tag together with its task associations.
+	DeleteTag(ctx context.Context, id uint64) error
+}
@@ -0,0 +1,14 @@
+package repository
+
+import (
+	"backend/domain/model"
+	"context"
+)
+
+// TaskHistoryRepository defines the contract for the change history of tasks.
+type TaskHistoryRepository interface {
+	// Append records entries as changes made by the calling user.
+	Append(ctx context.Context, entries []model.TaskHistoryEntry) error
+	// ListByTaskID returns the history of a task and its subtasks, most recent first.
+	ListByTaskID(ctx context.Context, taskID uint64) ([]model.TaskHistoryEntry, error)
+}
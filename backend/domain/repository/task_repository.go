@@ -1,23 +1,110 @@
 package repository
 
 import (
+	"backend/domain/apperr"
 	"backend/domain/model"
 	"context"
 	"time"
 )
 
+// ErrInvalidPageToken is returned when a page token cannot be decoded.
+var ErrInvalidPageToken = apperr.InvalidArgument("invalid page token", apperr.FieldViolation{
+	Field:       "page_token",
+	Description: "must be a token returned by a previous call",
+})
+
 // TaskRepository defines the contract for task persistence operations.
 type TaskRepository interface {
 	FindAll(ctx context.Context, filter TaskFilter) ([]model.Task, error)
+	FindPage(ctx context.Context, filter TaskFilter, page PageRequest) (*TaskPage, error)
 	FindByID(ctx context.Context, id uint64) (*model.Task, error)
+	// Search runs a full-text query over task titles, notes and subtask titles, best matches first.
+	Search(ctx context.Context, query string, page PageRequest) (*TaskSearchPage, error)
 	Create(ctx context.Context, in model.Task) (*model.Task, error)
+	// CreateWithSubTasks persists a new task together with in.SubTasks in a single transaction.
+	CreateWithSubTasks(ctx context.Context, in model.Task) (*model.Task, error)
 	Update(ctx context.Context, in model.Task) (*model.Task, error)
+	// Delete moves a task to the trash.
 	Delete(ctx context.Context, id uint64) error
+	FindDeleted(ctx context.Context) ([]model.Task, error)
+	Restore(ctx context.Context, id uint64) (*model.Task, error)
+	// Purge permanently removes a trashed task.
+	Purge(ctx context.Context, id uint64) error
+	// FindDeletedBefore returns the tasks of every user trashed before the cutoff and locks
+	// them until the surrounding transaction ends.
+	FindDeletedBefore(ctx context.Context, cutoff time.Time) ([]ExpiredTask, error)
+}
+
+// ExpiredTask is a trashed task due to be purged.
+type ExpiredTask struct {
+	ID     uint64
+	UserID uint64
 }
 
 type TaskFilter struct {
+	// IDs keeps only the listed tasks when non-nil.
+	IDs            []uint64
 	CategoryID     *uint64
 	DueDateFrom    *time.Time
 	DueDateTo      *time.Time
 	IncompleteOnly *bool
+	// TagIDs keeps the tasks carrying these tags, combined according to TagMatch.
+	TagIDs   []uint64
+	TagMatch TagMatch
+	// MinPriority keeps the tasks at this priority or above.
+	MinPriority *model.Priority
+	// OrderBy lists the sort keys, most significant first. Ties and an empty list fall back to id order.
+	OrderBy []TaskOrder
+}
+
+// TaskOrderField is a column tasks can be sorted by.
+type TaskOrderField int32
+
+const (
+	TaskOrderDueDate TaskOrderField = iota + 1
+	TaskOrderCreatedAt
+	TaskOrderUpdatedAt
+	TaskOrderTitle
+	TaskOrderCompletedAt
+	TaskOrderPriority
+)
+
+// TaskOrder is a single sort key. Tasks with no value for the field sort last in both directions.
+type TaskOrder struct {
+	Field TaskOrderField
+	Desc  bool
+}
+
+// TagMatch decides how the tags of a TaskFilter are combined.
+type TagMatch int32
+
+const (
+	// TagMatchAny keeps tasks carrying at least one of the tags.
+	TagMatchAny TagMatch = iota
+	// TagMatchAll keeps tasks carrying every one of the tags.
+	TagMatchAll
+)
+
+// PageRequest describes which slice of a listing to return.
+type PageRequest struct {
+	Size  int
+	Token string
+}
+
+// TaskPage is a single page of tasks.
+type TaskPage struct {
+	Tasks []model.Task
+	// Cursors[i] is a token resuming the listing right after Tasks[i].
+	Cursors       []string
+	NextPageToken string
+	TotalCount    int
+}
+
+// TaskSearchPage is a single page of search results.
+type TaskSearchPage struct {
+	Results []model.TaskSearchResult
+	// Cursors[i] is a token resuming the search right after Results[i].
+	Cursors       []string
+	NextPageToken string
+	TotalCount    int
 }
@@ -0,0 +1,30 @@
+package repository
+
+import (
+	"backend/domain/model"
+	"context"
+	"time"
+)
+
+// TaskStatsRepository computes aggregates over the tasks of the calling user. Trashed
+// tasks are never counted. Days are UTC days, the time zone the database session works in.
+type TaskStatsRepository interface {
+	// CountByCategory counts open tasks and tasks completed since filter.CompletedSince per category.
+	CountByCategory(ctx context.Context, filter TaskStatsFilter) ([]model.CategoryTaskCount, error)
+	// CountOverdue counts open tasks due before today.
+	CountOverdue(ctx context.Context, filter TaskStatsFilter, today time.Time) (int32, error)
+	// CompletedPerDay counts the tasks completed since filter.CompletedSince per day, oldest
+	// first. Days without completions are left out.
+	CompletedPerDay(ctx context.Context, filter TaskStatsFilter) ([]model.DailyCompletionCount, error)
+	// AverageCompletionTime averages the time from creation to completion of the tasks completed
+	// since filter.CompletedSince. It returns nil when there are none.
+	AverageCompletionTime(ctx context.Context, filter TaskStatsFilter) (*time.Duration, error)
+}
+
+type TaskStatsFilter struct {
+	// CategoryID restricts the statistics to one category when set. Zero selects the tasks
+	// without a category.
+	CategoryID *uint64
+	// CompletedSince is the start of the range completion figures cover.
+	CompletedSince time.Time
+}
@@ -0,0 +1,22 @@
+package repository
+
+import "context"
+
+// UnitOfWork runs several repository calls as a single database transaction.
+// Usecase methods that write more than one row, or check a row before writing another,
+// go through it so that a failure leaves no partial state behind.
+type UnitOfWork interface {
+	// Do runs fn with repositories bound to a new transaction. The transaction is committed
+	// when fn returns nil and rolled back otherwise.
+	Do(ctx context.Context, fn func(tx Repositories) error) error
+}
+
+// Repositories are the repositories taking part in a unit of work.
+type Repositories struct {
+	Tasks       TaskRepository
+	SubTasks    SubTaskRepository
+	TaskHistory TaskHistoryRepository
+	Categories  CategoryRepository
+	Tags        TagRepository
+	TaskStats   TaskStatsRepository
+}
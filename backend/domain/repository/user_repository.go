@@ -0,0 +1,12 @@
+package repository
+
+import (
+	"backend/domain/model"
+	"context"
+)
+
+// UserRepository defines persistence operations for user accounts.
+type UserRepository interface {
+	Create(ctx context.Context, in model.User) (*model.User, error)
+	FindByEmail(ctx context.Context, email string) (*model.User, error)
+}
@@ -1,29 +1,55 @@
 package main
 
 import (
+	"context"
 	"log"
 	"net"
 
 	infrastructure "backend/Infrastructure"
+	"backend/Infrastructure/store"
+	"backend/config"
 	"backend/controller"
+	"backend/usecase"
 
 	"google.golang.org/grpc"
 )
 
 func main() {
+	cfg, err := config.Load()
+	if err != nil {
+		log.Fatalf("Failed to load config: %v", err)
+	}
+
 	db, err := infrastructure.NewMySQLConnection()
 	if err != nil {
 		log.Fatalf("Failed to connect to database: %v", err)
 	}
 	defer db.Close()
 
+	// タスクの変更は WatchTasks の購読者に配信される
+	feed := usecase.NewTaskFeed()
+
+	// ゴミ箱の保持期間を過ぎたタスクを定期的に完全削除する
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	purgeUsecase := usecase.NewTaskUseCase(store.NewTaskRepository(db), store.NewCategoryRepository(db), store.NewSubTaskRepository(db), store.NewTaskHistoryRepository(db), store.NewUnitOfWork(db), feed)
+	go usecase.RunTrashPurger(ctx, purgeUsecase, cfg.Trash.Retention, cfg.Trash.PurgeInterval)
+
 	listener, err := net.Listen("tcp", ":50051")
 	if err != nil {
 		log.Fatalf("failed to listen: %v", err)
 	}
 
-	grpcServer := grpc.NewServer()
-	controller.RegisterService(grpcServer, db)
+	// BFF 以外からの呼び出しはサービストークンで弾く
+	authInterceptor := controller.NewAuthInterceptor(cfg.Auth.ServiceToken)
+	grpcServer := grpc.NewServer(grpc.ChainUnaryInterceptor(
+		controller.UnaryErrorInterceptor,
+		authInterceptor.Unary,
+	), grpc.ChainStreamInterceptor(
+		controller.StreamErrorInterceptor,
+		authInterceptor.Stream,
+	))
+	controller.RegisterService(grpcServer, db, feed)
 
 	log.Println("Server is running on port 50051")
 	if err := grpcServer.Serve(listener); err != nil {
@@ -9,6 +9,7 @@ package pb
 import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
 	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
@@ -22,37 +23,2477 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type Priority int32
+
+const (
+	Priority_PRIORITY_NONE   Priority = 0
+	Priority_PRIORITY_LOW    Priority = 1
+	Priority_PRIORITY_MEDIUM Priority = 2
+	Priority_PRIORITY_HIGH   Priority = 3
+	Priority_PRIORITY_URGENT Priority = 4
+)
+
+// Enum value maps for Priority.
+var (
+	Priority_name = map[int32]string{
+		0: "PRIORITY_NONE",
+		1: "PRIORITY_LOW",
+		2: "PRIORITY_MEDIUM",
+		3: "PRIORITY_HIGH",
+		4: "PRIORITY_URGENT",
+	}
+	Priority_value = map[string]int32{
+		"PRIORITY_NONE":   0,
+		"PRIORITY_LOW":    1,
+		"PRIORITY_MEDIUM": 2,
+		"PRIORITY_HIGH":   3,
+		"PRIORITY_URGENT": 4,
+	}
+)
+
+func (x Priority) Enum() *Priority {
+	p := new(Priority)
+	*p = x
+	return p
+}
+
+func (x Priority) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Priority) Descriptor() protoreflect.EnumDescriptor {
+	return file_grpc_proto_todo_proto_enumTypes[0].Descriptor()
+}
+
+func (Priority) Type() protoreflect.EnumType {
+	return &file_grpc_proto_todo_proto_enumTypes[0]
+}
+
+func (x Priority) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Priority.Descriptor instead.
+func (Priority) EnumDescriptor() ([]byte, []int) {
+	return file_grpc_proto_todo_proto_rawDescGZIP(), []int{0}
+}
+
+type RecurrenceFrequency int32
+
+const (
+	RecurrenceFrequency_RECURRENCE_FREQUENCY_UNSPECIFIED RecurrenceFrequency = 0
+	RecurrenceFrequency_RECURRENCE_FREQUENCY_DAILY       RecurrenceFrequency = 1
+	RecurrenceFrequency_RECURRENCE_FREQUENCY_WEEKLY      RecurrenceFrequency = 2
+	RecurrenceFrequency_RECURRENCE_FREQUENCY_MONTHLY     RecurrenceFrequency = 3
+	RecurrenceFrequency_RECURRENCE_FREQUENCY_YEARLY      RecurrenceFrequency = 4
+)
+
+// Enum value maps for RecurrenceFrequency.
+var (
+	RecurrenceFrequency_name = map[int32]string{
+		0: "RECURRENCE_FREQUENCY_UNSPECIFIED",
+		1: "RECURRENCE_FREQUENCY_DAILY",
+		2: "RECURRENCE_FREQUENCY_WEEKLY",
+		3: "RECURRENCE_FREQUENCY_MONTHLY",
+		4: "RECURRENCE_FREQUENCY_YEARLY",
+	}
+	RecurrenceFrequency_value = map[string]int32{
+		"RECURRENCE_FREQUENCY_UNSPECIFIED": 0,
+		"RECURRENCE_FREQUENCY_DAILY":       1,
+		"RECURRENCE_FREQUENCY_WEEKLY":      2,
+		"RECURRENCE_FREQUENCY_MONTHLY":     3,
+		"RECURRENCE_FREQUENCY_YEARLY":      4,
+	}
+)
+
+func (x RecurrenceFrequency) Enum() *RecurrenceFrequency {
+	p := new(RecurrenceFrequency)
+	*p = x
+	return p
+}
+
+func (x RecurrenceFrequency) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (RecurrenceFrequency) Descriptor() protoreflect.EnumDescriptor {
+	return file_grpc_proto_todo_proto_enumTypes[1].Descriptor()
+}
+
+func (RecurrenceFrequency) Type() protoreflect.EnumType {
+	return &file_grpc_proto_todo_proto_enumTypes[1]
+}
+
+func (x RecurrenceFrequency) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use RecurrenceFrequency.Descriptor instead.
+func (RecurrenceFrequency) EnumDescriptor() ([]byte, []int) {
+	return file_grpc_proto_todo_proto_rawDescGZIP(), []int{1}
+}
+
+type Weekday int32
+
+const (
+	Weekday_WEEKDAY_UNSPECIFIED Weekday = 0
+	Weekday_WEEKDAY_MONDAY      Weekday = 1
+	Weekday_WEEKDAY_TUESDAY     Weekday = 2
+	Weekday_WEEKDAY_WEDNESDAY   Weekday = 3
+	Weekday_WEEKDAY_THURSDAY    Weekday = 4
+	Weekday_WEEKDAY_FRIDAY      Weekday = 5
+	Weekday_WEEKDAY_SATURDAY    Weekday = 6
+	Weekday_WEEKDAY_SUNDAY      Weekday = 7
+)
+
+// Enum value maps for Weekday.
+var (
+	Weekday_name = map[int32]string{
+		0: "WEEKDAY_UNSPECIFIED",
+		1: "WEEKDAY_MONDAY",
+		2: "WEEKDAY_TUESDAY",
+		3: "WEEKDAY_WEDNESDAY",
+		4: "WEEKDAY_THURSDAY",
+		5: "WEEKDAY_FRIDAY",
+		6: "WEEKDAY_SATURDAY",
+		7: "WEEKDAY_SUNDAY",
+	}
+	Weekday_value = map[string]int32{
+		"WEEKDAY_UNSPECIFIED": 0,
+		"WEEKDAY_MONDAY":      1,
+		"WEEKDAY_TUESDAY":     2,
+		"WEEKDAY_WEDNESDAY":   3,
+		"WEEKDAY_THURSDAY":    4,
+		"WEEKDAY_FRIDAY":      5,
+		"WEEKDAY_SATURDAY":    6,
+		"WEEKDAY_SUNDAY":      7,
+	}
+)
+
+func (x Weekday) Enum() *Weekday {
+	p := new(Weekday)
+	*p = x
+	return p
+}
+
+func (x Weekday) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Weekday) Descriptor() protoreflect.EnumDescriptor {
+	return file_grpc_proto_todo_proto_enumTypes[2].Descriptor()
+}
+
+func (Weekday) Type() protoreflect.EnumType {
+	return &file_grpc_proto_todo_proto_enumTypes[2]
+}
+
+func (x Weekday) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Weekday.Descriptor instead.
+func (Weekday) EnumDescriptor() ([]byte, []int) {
+	return file_grpc_proto_todo_proto_rawDescGZIP(), []int{2}
+}
+
+// TagMatch decides how GetTasksRequest.tag_ids are combined.
+type TagMatch int32
+
+const (
+	// Tasks carrying at least one of the tags.
+	TagMatch_TAG_MATCH_ANY TagMatch = 0
+	// Tasks carrying every one of the tags.
+	TagMatch_TAG_MATCH_ALL TagMatch = 1
+)
+
+// Enum value maps for TagMatch.
+var (
+	TagMatch_name = map[int32]string{
+		0: "TAG_MATCH_ANY",
+		1: "TAG_MATCH_ALL",
+	}
+	TagMatch_value = map[string]int32{
+		"TAG_MATCH_ANY": 0,
+		"TAG_MATCH_ALL": 1,
+	}
+)
+
+func (x TagMatch) Enum() *TagMatch {
+	p := new(TagMatch)
+	*p = x
+	return p
+}
+
+func (x TagMatch) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TagMatch) Descriptor() protoreflect.EnumDescriptor {
+	return file_grpc_proto_todo_proto_enumTypes[3].Descriptor()
+}
+
+func (TagMatch) Type() protoreflect.EnumType {
+	return &file_grpc_proto_todo_proto_enumTypes[3]
+}
+
+func (x TagMatch) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TagMatch.Descriptor instead.
+func (TagMatch) EnumDescriptor() ([]byte, []int) {
+	return file_grpc_proto_todo_proto_rawDescGZIP(), []int{3}
+}
+
+type TaskOrderField int32
+
+const (
+	TaskOrderField_TASK_ORDER_FIELD_UNSPECIFIED TaskOrderField = 0
+	// Tasks without a due date sort last in both directions.
+	TaskOrderField_TASK_ORDER_FIELD_DUE_DATE   TaskOrderField = 1
+	TaskOrderField_TASK_ORDER_FIELD_CREATED_AT TaskOrderField = 2
+	TaskOrderField_TASK_ORDER_FIELD_UPDATED_AT TaskOrderField = 3
+	TaskOrderField_TASK_ORDER_FIELD_TITLE      TaskOrderField = 4
+	// Open tasks sort last in both directions.
+	TaskOrderField_TASK_ORDER_FIELD_COMPLETED_AT TaskOrderField = 5
+	TaskOrderField_TASK_ORDER_FIELD_PRIORITY     TaskOrderField = 6
+)
+
+// Enum value maps for TaskOrderField.
+var (
+	TaskOrderField_name = map[int32]string{
+		0: "TASK_ORDER_FIELD_UNSPECIFIED",
+		1: "TASK_ORDER_FIELD_DUE_DATE",
+		2: "TASK_ORDER_FIELD_CREATED_AT",
+		3: "TASK_ORDER_FIELD_UPDATED_AT",
+		4: "TASK_ORDER_FIELD_TITLE",
+		5: "TASK_ORDER_FIELD_COMPLETED_AT",
+		6: "TASK_ORDER_FIELD_PRIORITY",
+	}
+	TaskOrderField_value = map[string]int32{
+		"TASK_ORDER_FIELD_UNSPECIFIED":  0,
+		"TASK_ORDER_FIELD_DUE_DATE":     1,
+		"TASK_ORDER_FIELD_CREATED_AT":   2,
+		"TASK_ORDER_FIELD_UPDATED_AT":   3,
+		"TASK_ORDER_FIELD_TITLE":        4,
+		"TASK_ORDER_FIELD_COMPLETED_AT": 5,
+		"TASK_ORDER_FIELD_PRIORITY":     6,
+	}
+)
+
+func (x TaskOrderField) Enum() *TaskOrderField {
+	p := new(TaskOrderField)
+	*p = x
+	return p
+}
+
+func (x TaskOrderField) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TaskOrderField) Descriptor() protoreflect.EnumDescriptor {
+	return file_grpc_proto_todo_proto_enumTypes[4].Descriptor()
+}
+
+func (TaskOrderField) Type() protoreflect.EnumType {
+	return &file_grpc_proto_todo_proto_enumTypes[4]
+}
+
+func (x TaskOrderField) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TaskOrderField.Descriptor instead.
+func (TaskOrderField) EnumDescriptor() ([]byte, []int) {
+	return file_grpc_proto_todo_proto_rawDescGZIP(), []int{4}
+}
+
+type SortDirection int32
+
+const (
+	SortDirection_SORT_DIRECTION_ASC  SortDirection = 0
+	SortDirection_SORT_DIRECTION_DESC SortDirection = 1
+)
+
+// Enum value maps for SortDirection.
+var (
+	SortDirection_name = map[int32]string{
+		0: "SORT_DIRECTION_ASC",
+		1: "SORT_DIRECTION_DESC",
+	}
+	SortDirection_value = map[string]int32{
+		"SORT_DIRECTION_ASC":  0,
+		"SORT_DIRECTION_DESC": 1,
+	}
+)
+
+func (x SortDirection) Enum() *SortDirection {
+	p := new(SortDirection)
+	*p = x
+	return p
+}
+
+func (x SortDirection) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SortDirection) Descriptor() protoreflect.EnumDescriptor {
+	return file_grpc_proto_todo_proto_enumTypes[5].Descriptor()
+}
+
+func (SortDirection) Type() protoreflect.EnumType {
+	return &file_grpc_proto_todo_proto_enumTypes[5]
+}
+
+func (x SortDirection) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SortDirection.Descriptor instead.
+func (SortDirection) EnumDescriptor() ([]byte, []int) {
+	return file_grpc_proto_todo_proto_rawDescGZIP(), []int{5}
+}
+
+type TaskEventType int32
+
+const (
+	TaskEventType_TASK_EVENT_TYPE_UNSPECIFIED TaskEventType = 0
+	// A task was created or restored from the trash. Carries task.
+	TaskEventType_TASK_EVENT_TYPE_CREATED TaskEventType = 1
+	// A task or one of its subtasks changed. Carries task.
+	TaskEventType_TASK_EVENT_TYPE_UPDATED TaskEventType = 2
+	// A task was moved to the trash. Carries task_id.
+	TaskEventType_TASK_EVENT_TYPE_DELETED TaskEventType = 3
+	// A subtask was completed or reopened. Carries sub_task.
+	TaskEventType_TASK_EVENT_TYPE_SUB_TASK_TOGGLED TaskEventType = 4
+)
+
+// Enum value maps for TaskEventType.
+var (
+	TaskEventType_name = map[int32]string{
+		0: "TASK_EVENT_TYPE_UNSPECIFIED",
+		1: "TASK_EVENT_TYPE_CREATED",
+		2: "TASK_EVENT_TYPE_UPDATED",
+		3: "TASK_EVENT_TYPE_DELETED",
+		4: "TASK_EVENT_TYPE_SUB_TASK_TOGGLED",
+	}
+	TaskEventType_value = map[string]int32{
+		"TASK_EVENT_TYPE_UNSPECIFIED":      0,
+		"TASK_EVENT_TYPE_CREATED":          1,
+		"TASK_EVENT_TYPE_UPDATED":          2,
+		"TASK_EVENT_TYPE_DELETED":          3,
+		"TASK_EVENT_TYPE_SUB_TASK_TOGGLED": 4,
+	}
+)
+
+func (x TaskEventType) Enum() *TaskEventType {
+	p := new(TaskEventType)
+	*p = x
+	return p
+}
+
+func (x TaskEventType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TaskEventType) Descriptor() protoreflect.EnumDescriptor {
+	return file_grpc_proto_todo_proto_enumTypes[6].Descriptor()
+}
+
+func (TaskEventType) Type() protoreflect.EnumType {
+	return &file_grpc_proto_todo_proto_enumTypes[6]
+}
+
+func (x TaskEventType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TaskEventType.Descriptor instead.
+func (TaskEventType) EnumDescriptor() ([]byte, []int) {
+	return file_grpc_proto_todo_proto_rawDescGZIP(), []int{6}
+}
+
+type TaskHistoryAction int32
+
+const (
+	TaskHistoryAction_TASK_HISTORY_ACTION_UNSPECIFIED TaskHistoryAction = 0
+	TaskHistoryAction_TASK_HISTORY_ACTION_CREATED     TaskHistoryAction = 1
+	// One field changed; see field, old_value and new_value.
+	TaskHistoryAction_TASK_HISTORY_ACTION_UPDATED TaskHistoryAction = 2
+	// Moved to the trash for tasks, removed for subtasks.
+	TaskHistoryAction_TASK_HISTORY_ACTION_DELETED  TaskHistoryAction = 3
+	TaskHistoryAction_TASK_HISTORY_ACTION_RESTORED TaskHistoryAction = 4
+	// Removed from the trash for good. Entries of purged tasks are kept for auditing.
+	TaskHistoryAction_TASK_HISTORY_ACTION_PURGED TaskHistoryAction = 5
+)
+
+// Enum value maps for TaskHistoryAction.
+var (
+	TaskHistoryAction_name = map[int32]string{
+		0: "TASK_HISTORY_ACTION_UNSPECIFIED",
+		1: "TASK_HISTORY_ACTION_CREATED",
+		2: "TASK_HISTORY_ACTION_UPDATED",
+		3: "TASK_HISTORY_ACTION_DELETED",
+		4: "TASK_HISTORY_ACTION_RESTORED",
+		5: "TASK_HISTORY_ACTION_PURGED",
+	}
+	TaskHistoryAction_value = map[string]int32{
+		"TASK_HISTORY_ACTION_UNSPECIFIED": 0,
+		"TASK_HISTORY_ACTION_CREATED":     1,
+		"TASK_HISTORY_ACTION_UPDATED":     2,
+		"TASK_HISTORY_ACTION_DELETED":     3,
+		"TASK_HISTORY_ACTION_RESTORED":    4,
+		"TASK_HISTORY_ACTION_PURGED":      5,
+	}
+)
+
+func (x TaskHistoryAction) Enum() *TaskHistoryAction {
+	p := new(TaskHistoryAction)
+	*p = x
+	return p
+}
+
+func (x TaskHistoryAction) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TaskHistoryAction) Descriptor() protoreflect.EnumDescriptor {
+	return file_grpc_proto_todo_proto_enumTypes[7].Descriptor()
+}
+
+func (TaskHistoryAction) Type() protoreflect.EnumType {
+	return &file_grpc_proto_todo_proto_enumTypes[7]
+}
+
+func (x TaskHistoryAction) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TaskHistoryAction.Descriptor instead.
+func (TaskHistoryAction) EnumDescriptor() ([]byte, []int) {
+	return file_grpc_proto_todo_proto_rawDescGZIP(), []int{7}
+}
+
 type Task struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Id          uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Title       string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Note        string                 `protobuf:"bytes,3,opt,name=note,proto3" json:"note,omitempty"`
+	Completed   int32                  `protobuf:"varint,4,opt,name=completed,proto3" json:"completed,omitempty"`
+	CreatedAt   *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt   *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	CategoryId  uint64                 `protobuf:"varint,7,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
+	DueDate     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=due_date,json=dueDate,proto3" json:"due_date,omitempty"`
+	CompletedAt *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=completed_at,json=completedAt,proto3" json:"completed_at,omitempty"`
+	SubTasks    []*SubTask             `protobuf:"bytes,10,rep,name=sub_tasks,json=subTasks,proto3" json:"sub_tasks,omitempty"`
+	// Set while the task sits in the trash.
+	DeletedAt *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=deleted_at,json=deletedAt,proto3" json:"deleted_at,omitempty"`
+	// Set for tasks that are recreated with the next due date once completed.
+	Recurrence *Recurrence `protobuf:"bytes,12,opt,name=recurrence,proto3" json:"recurrence,omitempty"`
+	TagIds     []uint64    `protobuf:"varint,13,rep,packed,name=tag_ids,json=tagIds,proto3" json:"tag_ids,omitempty"`
+	Priority   Priority    `protobuf:"varint,14,opt,name=priority,proto3,enum=task.Priority" json:"priority,omitempty"`
+	// Incremented on every update. Pass it back as expected_version to detect lost updates.
+	Version int32 `protobuf:"varint,15,opt,name=version,proto3" json:"version,omitempty"`
+	// Counted whether or not sub_tasks is filled in.
+	SubtaskTotal     int32 `protobuf:"varint,16,opt,name=subtask_total,json=subtaskTotal,proto3" json:"subtask_total,omitempty"`
+	SubtaskCompleted int32 `protobuf:"varint,17,opt,name=subtask_completed,json=subtaskCompleted,proto3" json:"subtask_completed,omitempty"`
+	// Share of completed subtasks from 0 to 1. Without subtasks it is 1 once the task is completed.
+	Progress float64 `protobuf:"fixed64,18,opt,name=progress,proto3" json:"progress,omitempty"`
+	// Completes the task once all subtasks are done, reopens it when one is unchecked and
+	// completes the open subtasks when the task is completed.
+	SyncCompletion bool `protobuf:"varint,19,opt,name=sync_completion,json=syncCompletion,proto3" json:"sync_completion,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *Task) Reset() {
+	*x = Task{}
+	mi := &file_grpc_proto_todo_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Task) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Task) ProtoMessage() {}
+
+func (x *Task) ProtoReflect() protoreflect.Message {
+	mi := &file_grpc_proto_todo_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Task.ProtoReflect.Descriptor instead.
+func (*Task) Descriptor() ([]byte, []int) {
+	return file_grpc_proto_todo_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Task) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Task) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *Task) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+func (x *Task) GetCompleted() int32 {
+	if x != nil {
+		return x.Completed
+	}
+	return 0
+}
+
+func (x *Task) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Task) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *Task) GetCategoryId() uint64 {
+	if x != nil {
+		return x.CategoryId
+	}
+	return 0
+}
+
+func (x *Task) GetDueDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DueDate
+	}
+	return nil
+}
+
+func (x *Task) GetCompletedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CompletedAt
+	}
+	return nil
+}
+
+func (x *Task) GetSubTasks() []*SubTask {
+	if x != nil {
+		return x.SubTasks
+	}
+	return nil
+}
+
+func (x *Task) GetDeletedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DeletedAt
+	}
+	return nil
+}
+
+func (x *Task) GetRecurrence() *Recurrence {
+	if x != nil {
+		return x.Recurrence
+	}
+	return nil
+}
+
+func (x *Task) GetTagIds() []uint64 {
+	if x != nil {
+		return x.TagIds
+	}
+	return nil
+}
+
+func (x *Task) GetPriority() Priority {
+	if x != nil {
+		return x.Priority
+	}
+	return Priority_PRIORITY_NONE
+}
+
+func (x *Task) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *Task) GetSubtaskTotal() int32 {
+	if x != nil {
+		return x.SubtaskTotal
+	}
+	return 0
+}
+
+func (x *Task) GetSubtaskCompleted() int32 {
+	if x != nil {
+		return x.SubtaskCompleted
+	}
+	return 0
+}
+
+func (x *Task) GetProgress() float64 {
+	if x != nil {
+		return x.Progress
+	}
+	return 0
+}
+
+func (x *Task) GetSyncCompletion() bool {
+	if x != nil {
+		return x.SyncCompletion
+	}
+	return false
+}
+
+type Recurrence struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Frequency RecurrenceFrequency    `protobuf:"varint,1,opt,name=frequency,proto3,enum=task.RecurrenceFrequency" json:"frequency,omitempty"`
+	// Number of frequency units between occurrences. Zero means 1.
+	Interval int32 `protobuf:"varint,2,opt,name=interval,proto3" json:"interval,omitempty"`
+	// Only for weekly schedules. Empty repeats on the weekday of the due date.
+	Weekdays []Weekday `protobuf:"varint,3,rep,packed,name=weekdays,proto3,enum=task.Weekday" json:"weekdays,omitempty"`
+	// Last date an occurrence may fall on.
+	Until         *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=until,proto3" json:"until,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Recurrence) Reset() {
+	*x = Recurrence{}
+	mi := &file_grpc_proto_todo_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Recurrence) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Recurrence) ProtoMessage() {}
+
+func (x *Recurrence) ProtoReflect() protoreflect.Message {
+	mi := &file_grpc_proto_todo_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Recurrence.ProtoReflect.Descriptor instead.
+func (*Recurrence) Descriptor() ([]byte, []int) {
+	return file_grpc_proto_todo_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Recurrence) GetFrequency() RecurrenceFrequency {
+	if x != nil {
+		return x.Frequency
+	}
+	return RecurrenceFrequency_RECURRENCE_FREQUENCY_UNSPECIFIED
+}
+
+func (x *Recurrence) GetInterval() int32 {
+	if x != nil {
+		return x.Interval
+	}
+	return 0
+}
+
+func (x *Recurrence) GetWeekdays() []Weekday {
+	if x != nil {
+		return x.Weekdays
+	}
+	return nil
+}
+
+func (x *Recurrence) GetUntil() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Until
+	}
+	return nil
+}
+
+type NewTask struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	Title      string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Note       string                 `protobuf:"bytes,2,opt,name=note,proto3" json:"note,omitempty"`
+	CategoryId uint64                 `protobuf:"varint,3,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
+	DueDate    *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=due_date,json=dueDate,proto3" json:"due_date,omitempty"`
+	Recurrence *Recurrence            `protobuf:"bytes,5,opt,name=recurrence,proto3" json:"recurrence,omitempty"`
+	TagIds     []uint64               `protobuf:"varint,6,rep,packed,name=tag_ids,json=tagIds,proto3" json:"tag_ids,omitempty"`
+	Priority   Priority               `protobuf:"varint,7,opt,name=priority,proto3,enum=task.Priority" json:"priority,omitempty"`
+	// Created together with the task, in this order.
+	SubTasks       []*NewTaskSubTask `protobuf:"bytes,8,rep,name=sub_tasks,json=subTasks,proto3" json:"sub_tasks,omitempty"`
+	SyncCompletion bool              `protobuf:"varint,9,opt,name=sync_completion,json=syncCompletion,proto3" json:"sync_completion,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *NewTask) Reset() {
+	*x = NewTask{}
+	mi := &file_grpc_proto_todo_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NewTask) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NewTask) ProtoMessage() {}
+
+func (x *NewTask) ProtoReflect() protoreflect.Message {
+	mi := &file_grpc_proto_todo_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NewTask.ProtoReflect.Descriptor instead.
+func (*NewTask) Descriptor() ([]byte, []int) {
+	return file_grpc_proto_todo_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *NewTask) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *NewTask) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+func (x *NewTask) GetCategoryId() uint64 {
+	if x != nil {
+		return x.CategoryId
+	}
+	return 0
+}
+
+func (x *NewTask) GetDueDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DueDate
+	}
+	return nil
+}
+
+func (x *NewTask) GetRecurrence() *Recurrence {
+	if x != nil {
+		return x.Recurrence
+	}
+	return nil
+}
+
+func (x *NewTask) GetTagIds() []uint64 {
+	if x != nil {
+		return x.TagIds
+	}
+	return nil
+}
+
+func (x *NewTask) GetPriority() Priority {
+	if x != nil {
+		return x.Priority
+	}
+	return Priority_PRIORITY_NONE
+}
+
+func (x *NewTask) GetSubTasks() []*NewTaskSubTask {
+	if x != nil {
+		return x.SubTasks
+	}
+	return nil
+}
+
+func (x *NewTask) GetSyncCompletion() bool {
+	if x != nil {
+		return x.SyncCompletion
+	}
+	return false
+}
+
+// NewTaskSubTask is a subtask created as part of a NewTask.
+type NewTaskSubTask struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Title         string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Note          string                 `protobuf:"bytes,2,opt,name=note,proto3" json:"note,omitempty"`
+	DueDate       *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=due_date,json=dueDate,proto3" json:"due_date,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NewTaskSubTask) Reset() {
+	*x = NewTaskSubTask{}
+	mi := &file_grpc_proto_todo_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NewTaskSubTask) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NewTaskSubTask) ProtoMessage() {}
+
+func (x *NewTaskSubTask) ProtoReflect() protoreflect.Message {
+	mi := &file_grpc_proto_todo_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NewTaskSubTask.ProtoReflect.Descriptor instead.
+func (*NewTaskSubTask) Descriptor() ([]byte, []int) {
+	return file_grpc_proto_todo_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *NewTaskSubTask) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *NewTaskSubTask) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+func (x *NewTaskSubTask) GetDueDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DueDate
+	}
+	return nil
+}
+
+type UpdateTask struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Id          uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Title       *string                `protobuf:"bytes,2,opt,name=title,proto3,oneof" json:"title,omitempty"`
+	Note        *string                `protobuf:"bytes,3,opt,name=note,proto3,oneof" json:"note,omitempty"`
+	Completed   *int32                 `protobuf:"varint,4,opt,name=completed,proto3,oneof" json:"completed,omitempty"`
+	CategoryId  *uint64                `protobuf:"varint,5,opt,name=category_id,json=categoryId,proto3,oneof" json:"category_id,omitempty"`
+	DueDate     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=due_date,json=dueDate,proto3,oneof" json:"due_date,omitempty"`
+	CompletedAt *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=completed_at,json=completedAt,proto3,oneof" json:"completed_at,omitempty"`
+	// Replaces the schedule when set.
+	Recurrence *Recurrence `protobuf:"bytes,8,opt,name=recurrence,proto3" json:"recurrence,omitempty"`
+	// Removes the schedule. Takes precedence over recurrence.
+	ClearRecurrence bool `protobuf:"varint,9,opt,name=clear_recurrence,json=clearRecurrence,proto3" json:"clear_recurrence,omitempty"`
+	// Replaces the tags of the task when set. An empty list removes every tag.
+	TagIds   *TagIdList `protobuf:"bytes,10,opt,name=tag_ids,json=tagIds,proto3" json:"tag_ids,omitempty"`
+	Priority *Priority  `protobuf:"varint,11,opt,name=priority,proto3,enum=task.Priority,oneof" json:"priority,omitempty"`
+	// Fails the update with ABORTED when the task is no longer at this version.
+	ExpectedVersion *int32 `protobuf:"varint,12,opt,name=expected_version,json=expectedVersion,proto3,oneof" json:"expected_version,omitempty"`
+	// Removes the due date. Takes precedence over due_date.
+	ClearDueDate bool `protobuf:"varint,13,opt,name=clear_due_date,json=clearDueDate,proto3" json:"clear_due_date,omitempty"`
+	// Removes the category. Takes precedence over category_id.
+	ClearCategory  bool  `protobuf:"varint,14,opt,name=clear_category,json=clearCategory,proto3" json:"clear_category,omitempty"`
+	SyncCompletion *bool `protobuf:"varint,15,opt,name=sync_completion,json=syncCompletion,proto3,oneof" json:"sync_completion,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *UpdateTask) Reset() {
+	*x = UpdateTask{}
+	mi := &file_grpc_proto_todo_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateTask) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateTask) ProtoMessage() {}
+
+func (x *UpdateTask) ProtoReflect() protoreflect.Message {
+	mi := &file_grpc_proto_todo_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateTask.ProtoReflect.Descriptor instead.
+func (*UpdateTask) Descriptor() ([]byte, []int) {
+	return file_grpc_proto_todo_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *UpdateTask) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *UpdateTask) GetTitle() string {
+	if x != nil && x.Title != nil {
+		return *x.Title
+	}
+	return ""
+}
+
+func (x *UpdateTask) GetNote() string {
+	if x != nil && x.Note != nil {
+		return *x.Note
+	}
+	return ""
+}
+
+func (x *UpdateTask) GetCompleted() int32 {
+	if x != nil && x.Completed != nil {
+		return *x.Completed
+	}
+	return 0
+}
+
+func (x *UpdateTask) GetCategoryId() uint64 {
+	if x != nil && x.CategoryId != nil {
+		return *x.CategoryId
+	}
+	return 0
+}
+
+func (x *UpdateTask) GetDueDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DueDate
+	}
+	return nil
+}
+
+func (x *UpdateTask) GetCompletedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CompletedAt
+	}
+	return nil
+}
+
+func (x *UpdateTask) GetRecurrence() *Recurrence {
+	if x != nil {
+		return x.Recurrence
+	}
+	return nil
+}
+
+func (x *UpdateTask) GetClearRecurrence() bool {
+	if x != nil {
+		return x.ClearRecurrence
+	}
+	return false
+}
+
+func (x *UpdateTask) GetTagIds() *TagIdList {
+	if x != nil {
+		return x.TagIds
+	}
+	return nil
+}
+
+func (x *UpdateTask) GetPriority() Priority {
+	if x != nil && x.Priority != nil {
+		return *x.Priority
+	}
+	return Priority_PRIORITY_NONE
+}
+
+func (x *UpdateTask) GetExpectedVersion() int32 {
+	if x != nil && x.ExpectedVersion != nil {
+		return *x.ExpectedVersion
+	}
+	return 0
+}
+
+func (x *UpdateTask) GetClearDueDate() bool {
+	if x != nil {
+		return x.ClearDueDate
+	}
+	return false
+}
+
+func (x *UpdateTask) GetClearCategory() bool {
+	if x != nil {
+		return x.ClearCategory
+	}
+	return false
+}
+
+func (x *UpdateTask) GetSyncCompletion() bool {
+	if x != nil && x.SyncCompletion != nil {
+		return *x.SyncCompletion
+	}
+	return false
+}
+
+type TagIdList struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Ids           []uint64               `protobuf:"varint,1,rep,packed,name=ids,proto3" json:"ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TagIdList) Reset() {
+	*x = TagIdList{}
+	mi := &file_grpc_proto_todo_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TagIdList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TagIdList) ProtoMessage() {}
+
+func (x *TagIdList) ProtoReflect() protoreflect.Message {
+	mi := &file_grpc_proto_todo_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TagIdList.ProtoReflect.Descriptor instead.
+func (*TagIdList) Descriptor() ([]byte, []int) {
+	return file_grpc_proto_todo_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *TagIdList) GetIds() []uint64 {
+	if x != nil {
+		return x.Ids
+	}
+	return nil
+}
+
+type TaskList struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Tasks []*Task                `protobuf:"bytes,1,rep,name=tasks,proto3" json:"tasks,omitempty"`
+	// Token for the page after this one; empty on the last page.
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	// Number of tasks matching the filter across all pages.
+	TotalCount int32 `protobuf:"varint,3,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	// cursors[i] is a page_token that resumes the listing right after tasks[i].
+	Cursors       []string `protobuf:"bytes,4,rep,name=cursors,proto3" json:"cursors,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TaskList) Reset() {
+	*x = TaskList{}
+	mi := &file_grpc_proto_todo_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TaskList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TaskList) ProtoMessage() {}
+
+func (x *TaskList) ProtoReflect() protoreflect.Message {
+	mi := &file_grpc_proto_todo_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TaskList.ProtoReflect.Descriptor instead.
+func (*TaskList) Descriptor() ([]byte, []int) {
+	return file_grpc_proto_todo_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *TaskList) GetTasks() []*Task {
+	if x != nil {
+		return x.Tasks
+	}
+	return nil
+}
+
+func (x *TaskList) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *TaskList) GetTotalCount() int32 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+func (x *TaskList) GetCursors() []string {
+	if x != nil {
+		return x.Cursors
+	}
+	return nil
+}
+
+type SubTask struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Id          uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	TaskId      uint64                 `protobuf:"varint,2,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	Title       string                 `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
+	Note        string                 `protobuf:"bytes,4,opt,name=note,proto3" json:"note,omitempty"`
+	Completed   int32                  `protobuf:"varint,5,opt,name=completed,proto3" json:"completed,omitempty"`
+	CompletedAt *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=completed_at,json=completedAt,proto3" json:"completed_at,omitempty"`
+	DueDate     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=due_date,json=dueDate,proto3" json:"due_date,omitempty"`
+	CreatedAt   *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt   *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	Position    int32                  `protobuf:"varint,10,opt,name=position,proto3" json:"position,omitempty"`
+	// Incremented on every update. Pass it back as expected_version to detect lost updates.
+	Version       int32 `protobuf:"varint,11,opt,name=version,proto3" json:"version,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubTask) Reset() {
+	*x = SubTask{}
+	mi := &file_grpc_proto_todo_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubTask) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubTask) ProtoMessage() {}
+
+func (x *SubTask) ProtoReflect() protoreflect.Message {
+	mi := &file_grpc_proto_todo_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubTask.ProtoReflect.Descriptor instead.
+func (*SubTask) Descriptor() ([]byte, []int) {
+	return file_grpc_proto_todo_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *SubTask) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *SubTask) GetTaskId() uint64 {
+	if x != nil {
+		return x.TaskId
+	}
+	return 0
+}
+
+func (x *SubTask) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *SubTask) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+func (x *SubTask) GetCompleted() int32 {
+	if x != nil {
+		return x.Completed
+	}
+	return 0
+}
+
+func (x *SubTask) GetCompletedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CompletedAt
+	}
+	return nil
+}
+
+func (x *SubTask) GetDueDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DueDate
+	}
+	return nil
+}
+
+func (x *SubTask) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *SubTask) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *SubTask) GetPosition() int32 {
+	if x != nil {
+		return x.Position
+	}
+	return 0
+}
+
+func (x *SubTask) GetVersion() int32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+type NewSubTask struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TaskId        uint64                 `protobuf:"varint,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	Title         string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Note          string                 `protobuf:"bytes,3,opt,name=note,proto3" json:"note,omitempty"`
+	DueDate       *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=due_date,json=dueDate,proto3" json:"due_date,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NewSubTask) Reset() {
+	*x = NewSubTask{}
+	mi := &file_grpc_proto_todo_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NewSubTask) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NewSubTask) ProtoMessage() {}
+
+func (x *NewSubTask) ProtoReflect() protoreflect.Message {
+	mi := &file_grpc_proto_todo_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NewSubTask.ProtoReflect.Descriptor instead.
+func (*NewSubTask) Descriptor() ([]byte, []int) {
+	return file_grpc_proto_todo_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *NewSubTask) GetTaskId() uint64 {
+	if x != nil {
+		return x.TaskId
+	}
+	return 0
+}
+
+func (x *NewSubTask) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *NewSubTask) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+func (x *NewSubTask) GetDueDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DueDate
+	}
+	return nil
+}
+
+type UpdateSubTask struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Id      uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Title   *string                `protobuf:"bytes,2,opt,name=title,proto3,oneof" json:"title,omitempty"`
+	Note    *string                `protobuf:"bytes,3,opt,name=note,proto3,oneof" json:"note,omitempty"`
+	DueDate *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=due_date,json=dueDate,proto3,oneof" json:"due_date,omitempty"`
+	// Fails the update with ABORTED when the sub task is no longer at this version.
+	ExpectedVersion *int32 `protobuf:"varint,5,opt,name=expected_version,json=expectedVersion,proto3,oneof" json:"expected_version,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *UpdateSubTask) Reset() {
+	*x = UpdateSubTask{}
+	mi := &file_grpc_proto_todo_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateSubTask) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateSubTask) ProtoMessage() {}
+
+func (x *UpdateSubTask) ProtoReflect() protoreflect.Message {
+	mi := &file_grpc_proto_todo_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateSubTask.ProtoReflect.Descriptor instead.
+func (*UpdateSubTask) Descriptor() ([]byte, []int) {
+	return file_grpc_proto_todo_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *UpdateSubTask) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *UpdateSubTask) GetTitle() string {
+	if x != nil && x.Title != nil {
+		return *x.Title
+	}
+	return ""
+}
+
+func (x *UpdateSubTask) GetNote() string {
+	if x != nil && x.Note != nil {
+		return *x.Note
+	}
+	return ""
+}
+
+func (x *UpdateSubTask) GetDueDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DueDate
+	}
+	return nil
+}
+
+func (x *UpdateSubTask) GetExpectedVersion() int32 {
+	if x != nil && x.ExpectedVersion != nil {
+		return *x.ExpectedVersion
+	}
+	return 0
+}
+
+type ToggleSubTaskRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Id        uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Completed bool                   `protobuf:"varint,2,opt,name=completed,proto3" json:"completed,omitempty"`
+	// Fails the update with ABORTED when the sub task is no longer at this version.
+	ExpectedVersion *int32 `protobuf:"varint,3,opt,name=expected_version,json=expectedVersion,proto3,oneof" json:"expected_version,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ToggleSubTaskRequest) Reset() {
+	*x = ToggleSubTaskRequest{}
+	mi := &file_grpc_proto_todo_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ToggleSubTaskRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ToggleSubTaskRequest) ProtoMessage() {}
+
+func (x *ToggleSubTaskRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_grpc_proto_todo_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ToggleSubTaskRequest.ProtoReflect.Descriptor instead.
+func (*ToggleSubTaskRequest) Descriptor() ([]byte, []int) {
+	return file_grpc_proto_todo_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ToggleSubTaskRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ToggleSubTaskRequest) GetCompleted() bool {
+	if x != nil {
+		return x.Completed
+	}
+	return false
+}
+
+func (x *ToggleSubTaskRequest) GetExpectedVersion() int32 {
+	if x != nil && x.ExpectedVersion != nil {
+		return *x.ExpectedVersion
+	}
+	return 0
+}
+
+type SubTaskList struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SubTasks      []*SubTask             `protobuf:"bytes,1,rep,name=sub_tasks,json=subTasks,proto3" json:"sub_tasks,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubTaskList) Reset() {
+	*x = SubTaskList{}
+	mi := &file_grpc_proto_todo_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubTaskList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubTaskList) ProtoMessage() {}
+
+func (x *SubTaskList) ProtoReflect() protoreflect.Message {
+	mi := &file_grpc_proto_todo_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubTaskList.ProtoReflect.Descriptor instead.
+func (*SubTaskList) Descriptor() ([]byte, []int) {
+	return file_grpc_proto_todo_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *SubTaskList) GetSubTasks() []*SubTask {
+	if x != nil {
+		return x.SubTasks
+	}
+	return nil
+}
+
+type TaskId struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TaskId) Reset() {
+	*x = TaskId{}
+	mi := &file_grpc_proto_todo_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TaskId) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TaskId) ProtoMessage() {}
+
+func (x *TaskId) ProtoReflect() protoreflect.Message {
+	mi := &file_grpc_proto_todo_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TaskId.ProtoReflect.Descriptor instead.
+func (*TaskId) Descriptor() ([]byte, []int) {
+	return file_grpc_proto_todo_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *TaskId) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type TaskIds struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Ids           []uint64               `protobuf:"varint,1,rep,packed,name=ids,proto3" json:"ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TaskIds) Reset() {
+	*x = TaskIds{}
+	mi := &file_grpc_proto_todo_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TaskIds) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TaskIds) ProtoMessage() {}
+
+func (x *TaskIds) ProtoReflect() protoreflect.Message {
+	mi := &file_grpc_proto_todo_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TaskIds.ProtoReflect.Descriptor instead.
+func (*TaskIds) Descriptor() ([]byte, []int) {
+	return file_grpc_proto_todo_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *TaskIds) GetIds() []uint64 {
+	if x != nil {
+		return x.Ids
+	}
+	return nil
+}
+
+type BatchGetTasksRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// At most 100 ids. Unknown ids are left out of the response.
+	Ids []uint64 `protobuf:"varint,1,rep,packed,name=ids,proto3" json:"ids,omitempty"`
+	// Leave Task.sub_tasks empty for callers that load them separately.
+	SkipSubTasks  bool `protobuf:"varint,2,opt,name=skip_sub_tasks,json=skipSubTasks,proto3" json:"skip_sub_tasks,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchGetTasksRequest) Reset() {
+	*x = BatchGetTasksRequest{}
+	mi := &file_grpc_proto_todo_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchGetTasksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchGetTasksRequest) ProtoMessage() {}
+
+func (x *BatchGetTasksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_grpc_proto_todo_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchGetTasksRequest.ProtoReflect.Descriptor instead.
+func (*BatchGetTasksRequest) Descriptor() ([]byte, []int) {
+	return file_grpc_proto_todo_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *BatchGetTasksRequest) GetIds() []uint64 {
+	if x != nil {
+		return x.Ids
+	}
+	return nil
+}
+
+func (x *BatchGetTasksRequest) GetSkipSubTasks() bool {
+	if x != nil {
+		return x.SkipSubTasks
+	}
+	return false
+}
+
+type SubTasksByTask struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Keyed by task id. Tasks without subtasks are omitted.
+	SubTasks      map[uint64]*SubTaskList `protobuf:"bytes,1,rep,name=sub_tasks,json=subTasks,proto3" json:"sub_tasks,omitempty" protobuf_key:"varint,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubTasksByTask) Reset() {
+	*x = SubTasksByTask{}
+	mi := &file_grpc_proto_todo_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubTasksByTask) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubTasksByTask) ProtoMessage() {}
+
+func (x *SubTasksByTask) ProtoReflect() protoreflect.Message {
+	mi := &file_grpc_proto_todo_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubTasksByTask.ProtoReflect.Descriptor instead.
+func (*SubTasksByTask) Descriptor() ([]byte, []int) {
+	return file_grpc_proto_todo_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *SubTasksByTask) GetSubTasks() map[uint64]*SubTaskList {
+	if x != nil {
+		return x.SubTasks
+	}
+	return nil
+}
+
+type GetTasksRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	CategoryId     *uint64                `protobuf:"varint,1,opt,name=category_id,json=categoryId,proto3,oneof" json:"category_id,omitempty"`
+	DueDateStart   *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=due_date_start,json=dueDateStart,proto3,oneof" json:"due_date_start,omitempty"`
+	DueDateEnd     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=due_date_end,json=dueDateEnd,proto3,oneof" json:"due_date_end,omitempty"`
+	IncompleteOnly *bool                  `protobuf:"varint,4,opt,name=incomplete_only,json=incompleteOnly,proto3,oneof" json:"incomplete_only,omitempty"`
+	// Maximum number of tasks to return. Zero uses the default page size.
+	PageSize int32 `protobuf:"varint,5,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// next_page_token (or a cursor) received from a previous GetTasks call.
+	PageToken string `protobuf:"bytes,6,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	// Leave Task.sub_tasks empty for callers that load them separately.
+	SkipSubTasks bool `protobuf:"varint,8,opt,name=skip_sub_tasks,json=skipSubTasks,proto3" json:"skip_sub_tasks,omitempty"`
+	// Only tasks carrying these tags, combined according to tag_match.
+	TagIds   []uint64 `protobuf:"varint,9,rep,packed,name=tag_ids,json=tagIds,proto3" json:"tag_ids,omitempty"`
+	TagMatch TagMatch `protobuf:"varint,10,opt,name=tag_match,json=tagMatch,proto3,enum=task.TagMatch" json:"tag_match,omitempty"`
+	// Sort keys, most significant first. Ties and an empty list fall back to id order.
+	OrderBy []*TaskOrder `protobuf:"bytes,11,rep,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`
+	// Only tasks at this priority or above.
+	MinPriority   *Priority `protobuf:"varint,12,opt,name=min_priority,json=minPriority,proto3,enum=task.Priority,oneof" json:"min_priority,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTasksRequest) Reset() {
+	*x = GetTasksRequest{}
+	mi := &file_grpc_proto_todo_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTasksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTasksRequest) ProtoMessage() {}
+
+func (x *GetTasksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_grpc_proto_todo_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTasksRequest.ProtoReflect.Descriptor instead.
+func (*GetTasksRequest) Descriptor() ([]byte, []int) {
+	return file_grpc_proto_todo_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *GetTasksRequest) GetCategoryId() uint64 {
+	if x != nil && x.CategoryId != nil {
+		return *x.CategoryId
+	}
+	return 0
+}
+
+func (x *GetTasksRequest) GetDueDateStart() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DueDateStart
+	}
+	return nil
+}
+
+func (x *GetTasksRequest) GetDueDateEnd() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DueDateEnd
+	}
+	return nil
+}
+
+func (x *GetTasksRequest) GetIncompleteOnly() bool {
+	if x != nil && x.IncompleteOnly != nil {
+		return *x.IncompleteOnly
+	}
+	return false
+}
+
+func (x *GetTasksRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *GetTasksRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *GetTasksRequest) GetSkipSubTasks() bool {
+	if x != nil {
+		return x.SkipSubTasks
+	}
+	return false
+}
+
+func (x *GetTasksRequest) GetTagIds() []uint64 {
+	if x != nil {
+		return x.TagIds
+	}
+	return nil
+}
+
+func (x *GetTasksRequest) GetTagMatch() TagMatch {
+	if x != nil {
+		return x.TagMatch
+	}
+	return TagMatch_TAG_MATCH_ANY
+}
+
+func (x *GetTasksRequest) GetOrderBy() []*TaskOrder {
+	if x != nil {
+		return x.OrderBy
+	}
+	return nil
+}
+
+func (x *GetTasksRequest) GetMinPriority() Priority {
+	if x != nil && x.MinPriority != nil {
+		return *x.MinPriority
+	}
+	return Priority_PRIORITY_NONE
+}
+
+type TaskOrder struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Field         TaskOrderField         `protobuf:"varint,1,opt,name=field,proto3,enum=task.TaskOrderField" json:"field,omitempty"`
+	Direction     SortDirection          `protobuf:"varint,2,opt,name=direction,proto3,enum=task.SortDirection" json:"direction,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TaskOrder) Reset() {
+	*x = TaskOrder{}
+	mi := &file_grpc_proto_todo_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TaskOrder) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TaskOrder) ProtoMessage() {}
+
+func (x *TaskOrder) ProtoReflect() protoreflect.Message {
+	mi := &file_grpc_proto_todo_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TaskOrder.ProtoReflect.Descriptor instead.
+func (*TaskOrder) Descriptor() ([]byte, []int) {
+	return file_grpc_proto_todo_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *TaskOrder) GetField() TaskOrderField {
+	if x != nil {
+		return x.Field
+	}
+	return TaskOrderField_TASK_ORDER_FIELD_UNSPECIFIED
+}
+
+func (x *TaskOrder) GetDirection() SortDirection {
+	if x != nil {
+		return x.Direction
+	}
+	return SortDirection_SORT_DIRECTION_ASC
+}
+
+type CreateTaskRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Input         *NewTask               `protobuf:"bytes,1,opt,name=input,proto3" json:"input,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateTaskRequest) Reset() {
+	*x = CreateTaskRequest{}
+	mi := &file_grpc_proto_todo_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateTaskRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateTaskRequest) ProtoMessage() {}
+
+func (x *CreateTaskRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_grpc_proto_todo_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateTaskRequest.ProtoReflect.Descriptor instead.
+func (*CreateTaskRequest) Descriptor() ([]byte, []int) {
+	return file_grpc_proto_todo_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *CreateTaskRequest) GetInput() *NewTask {
+	if x != nil {
+		return x.Input
+	}
+	return nil
+}
+
+type UpdateTaskRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Input         *UpdateTask            `protobuf:"bytes,1,opt,name=input,proto3" json:"input,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateTaskRequest) Reset() {
+	*x = UpdateTaskRequest{}
+	mi := &file_grpc_proto_todo_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateTaskRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateTaskRequest) ProtoMessage() {}
+
+func (x *UpdateTaskRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_grpc_proto_todo_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateTaskRequest.ProtoReflect.Descriptor instead.
+func (*UpdateTaskRequest) Descriptor() ([]byte, []int) {
+	return file_grpc_proto_todo_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *UpdateTaskRequest) GetInput() *UpdateTask {
+	if x != nil {
+		return x.Input
+	}
+	return nil
+}
+
+type DeleteTaskResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteTaskResponse) Reset() {
+	*x = DeleteTaskResponse{}
+	mi := &file_grpc_proto_todo_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteTaskResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteTaskResponse) ProtoMessage() {}
+
+func (x *DeleteTaskResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_grpc_proto_todo_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteTaskResponse.ProtoReflect.Descriptor instead.
+func (*DeleteTaskResponse) Descriptor() ([]byte, []int) {
+	return file_grpc_proto_todo_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *DeleteTaskResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type CreateSubTaskRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Input         *NewSubTask            `protobuf:"bytes,1,opt,name=input,proto3" json:"input,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateSubTaskRequest) Reset() {
+	*x = CreateSubTaskRequest{}
+	mi := &file_grpc_proto_todo_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateSubTaskRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateSubTaskRequest) ProtoMessage() {}
+
+func (x *CreateSubTaskRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_grpc_proto_todo_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateSubTaskRequest.ProtoReflect.Descriptor instead.
+func (*CreateSubTaskRequest) Descriptor() ([]byte, []int) {
+	return file_grpc_proto_todo_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *CreateSubTaskRequest) GetInput() *NewSubTask {
+	if x != nil {
+		return x.Input
+	}
+	return nil
+}
+
+type UpdateSubTaskRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Input         *UpdateSubTask         `protobuf:"bytes,1,opt,name=input,proto3" json:"input,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateSubTaskRequest) Reset() {
+	*x = UpdateSubTaskRequest{}
+	mi := &file_grpc_proto_todo_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateSubTaskRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateSubTaskRequest) ProtoMessage() {}
+
+func (x *UpdateSubTaskRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_grpc_proto_todo_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateSubTaskRequest.ProtoReflect.Descriptor instead.
+func (*UpdateSubTaskRequest) Descriptor() ([]byte, []int) {
+	return file_grpc_proto_todo_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *UpdateSubTaskRequest) GetInput() *UpdateSubTask {
+	if x != nil {
+		return x.Input
+	}
+	return nil
+}
+
+type SubTaskId struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Title         string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
-	Note          string                 `protobuf:"bytes,3,opt,name=note,proto3" json:"note,omitempty"`
-	Completed     int32                  `protobuf:"varint,4,opt,name=completed,proto3" json:"completed,omitempty"`
-	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
-	CategoryId    uint64                 `protobuf:"varint,7,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
-	DueDate       *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=due_date,json=dueDate,proto3" json:"due_date,omitempty"`
-	CompletedAt   *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=completed_at,json=completedAt,proto3" json:"completed_at,omitempty"`
-	SubTasks      []*SubTask             `protobuf:"bytes,10,rep,name=sub_tasks,json=subTasks,proto3" json:"sub_tasks,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Task) Reset() {
-	*x = Task{}
-	mi := &file_grpc_proto_todo_proto_msgTypes[0]
+func (x *SubTaskId) Reset() {
+	*x = SubTaskId{}
+	mi := &file_grpc_proto_todo_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubTaskId) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubTaskId) ProtoMessage() {}
+
+func (x *SubTaskId) ProtoReflect() protoreflect.Message {
+	mi := &file_grpc_proto_todo_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubTaskId.ProtoReflect.Descriptor instead.
+func (*SubTaskId) Descriptor() ([]byte, []int) {
+	return file_grpc_proto_todo_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *SubTaskId) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type DeleteSubTaskResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteSubTaskResponse) Reset() {
+	*x = DeleteSubTaskResponse{}
+	mi := &file_grpc_proto_todo_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteSubTaskResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteSubTaskResponse) ProtoMessage() {}
+
+func (x *DeleteSubTaskResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_grpc_proto_todo_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteSubTaskResponse.ProtoReflect.Descriptor instead.
+func (*DeleteSubTaskResponse) Descriptor() ([]byte, []int) {
+	return file_grpc_proto_todo_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *DeleteSubTaskResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type ReorderSubTasksRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	TaskId uint64                 `protobuf:"varint,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	// Every subtask of the task, in the desired order.
+	SubTaskIds    []uint64 `protobuf:"varint,2,rep,packed,name=sub_task_ids,json=subTaskIds,proto3" json:"sub_task_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReorderSubTasksRequest) Reset() {
+	*x = ReorderSubTasksRequest{}
+	mi := &file_grpc_proto_todo_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReorderSubTasksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReorderSubTasksRequest) ProtoMessage() {}
+
+func (x *ReorderSubTasksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_grpc_proto_todo_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReorderSubTasksRequest.ProtoReflect.Descriptor instead.
+func (*ReorderSubTasksRequest) Descriptor() ([]byte, []int) {
+	return file_grpc_proto_todo_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *ReorderSubTasksRequest) GetTaskId() uint64 {
+	if x != nil {
+		return x.TaskId
+	}
+	return 0
+}
+
+func (x *ReorderSubTasksRequest) GetSubTaskIds() []uint64 {
+	if x != nil {
+		return x.SubTaskIds
+	}
+	return nil
+}
+
+type TaskEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Type          TaskEventType          `protobuf:"varint,1,opt,name=type,proto3,enum=task.TaskEventType" json:"type,omitempty"`
+	TaskId        uint64                 `protobuf:"varint,2,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	Task          *Task                  `protobuf:"bytes,3,opt,name=task,proto3" json:"task,omitempty"`
+	SubTask       *SubTask               `protobuf:"bytes,4,opt,name=sub_task,json=subTask,proto3" json:"sub_task,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TaskEvent) Reset() {
+	*x = TaskEvent{}
+	mi := &file_grpc_proto_todo_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TaskEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TaskEvent) ProtoMessage() {}
+
+func (x *TaskEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_grpc_proto_todo_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TaskEvent.ProtoReflect.Descriptor instead.
+func (*TaskEvent) Descriptor() ([]byte, []int) {
+	return file_grpc_proto_todo_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *TaskEvent) GetType() TaskEventType {
+	if x != nil {
+		return x.Type
+	}
+	return TaskEventType_TASK_EVENT_TYPE_UNSPECIFIED
+}
+
+func (x *TaskEvent) GetTaskId() uint64 {
+	if x != nil {
+		return x.TaskId
+	}
+	return 0
+}
+
+func (x *TaskEvent) GetTask() *Task {
+	if x != nil {
+		return x.Task
+	}
+	return nil
+}
+
+func (x *TaskEvent) GetSubTask() *SubTask {
+	if x != nil {
+		return x.SubTask
+	}
+	return nil
+}
+
+type WatchTasksRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Event types to receive. Empty receives every type.
+	Types         []TaskEventType `protobuf:"varint,1,rep,packed,name=types,proto3,enum=task.TaskEventType" json:"types,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchTasksRequest) Reset() {
+	*x = WatchTasksRequest{}
+	mi := &file_grpc_proto_todo_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchTasksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchTasksRequest) ProtoMessage() {}
+
+func (x *WatchTasksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_grpc_proto_todo_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchTasksRequest.ProtoReflect.Descriptor instead.
+func (*WatchTasksRequest) Descriptor() ([]byte, []int) {
+	return file_grpc_proto_todo_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *WatchTasksRequest) GetTypes() []TaskEventType {
+	if x != nil {
+		return x.Types
+	}
+	return nil
+}
+
+type SearchTasksRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Free text matched against task titles, notes and subtask titles.
+	Query string `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	// Maximum number of results to return. Zero uses the default page size.
+	PageSize int32 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// next_page_token (or a cursor) received from a previous SearchTasks call.
+	PageToken     string `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchTasksRequest) Reset() {
+	*x = SearchTasksRequest{}
+	mi := &file_grpc_proto_todo_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchTasksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchTasksRequest) ProtoMessage() {}
+
+func (x *SearchTasksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_grpc_proto_todo_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchTasksRequest.ProtoReflect.Descriptor instead.
+func (*SearchTasksRequest) Descriptor() ([]byte, []int) {
+	return file_grpc_proto_todo_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *SearchTasksRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *SearchTasksRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *SearchTasksRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type SearchHighlight struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Matched field: "title", "note" or "sub_tasks.title".
+	Field string `protobuf:"bytes,1,opt,name=field,proto3" json:"field,omitempty"`
+	// HTML-escaped excerpt of the field with matches wrapped in <mark></mark>.
+	Snippet       string `protobuf:"bytes,2,opt,name=snippet,proto3" json:"snippet,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchHighlight) Reset() {
+	*x = SearchHighlight{}
+	mi := &file_grpc_proto_todo_proto_msgTypes[29]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Task) String() string {
+func (x *SearchHighlight) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Task) ProtoMessage() {}
+func (*SearchHighlight) ProtoMessage() {}
 
-func (x *Task) ProtoReflect() protoreflect.Message {
-	mi := &file_grpc_proto_todo_proto_msgTypes[0]
+func (x *SearchHighlight) ProtoReflect() protoreflect.Message {
+	mi := &file_grpc_proto_todo_proto_msgTypes[29]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -63,106 +2504,114 @@ func (x *Task) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Task.ProtoReflect.Descriptor instead.
-func (*Task) Descriptor() ([]byte, []int) {
-	return file_grpc_proto_todo_proto_rawDescGZIP(), []int{0}
+// Deprecated: Use SearchHighlight.ProtoReflect.Descriptor instead.
+func (*SearchHighlight) Descriptor() ([]byte, []int) {
+	return file_grpc_proto_todo_proto_rawDescGZIP(), []int{29}
 }
 
-func (x *Task) GetId() uint64 {
+func (x *SearchHighlight) GetField() string {
 	if x != nil {
-		return x.Id
+		return x.Field
 	}
-	return 0
+	return ""
 }
 
-func (x *Task) GetTitle() string {
+func (x *SearchHighlight) GetSnippet() string {
 	if x != nil {
-		return x.Title
+		return x.Snippet
 	}
 	return ""
 }
 
-func (x *Task) GetNote() string {
-	if x != nil {
-		return x.Note
-	}
-	return ""
+type TaskSearchResult struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Task  *Task                  `protobuf:"bytes,1,opt,name=task,proto3" json:"task,omitempty"`
+	// Relevance score; results are ordered by it, highest first.
+	Score         float64            `protobuf:"fixed64,2,opt,name=score,proto3" json:"score,omitempty"`
+	Highlights    []*SearchHighlight `protobuf:"bytes,3,rep,name=highlights,proto3" json:"highlights,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Task) GetCompleted() int32 {
-	if x != nil {
-		return x.Completed
-	}
-	return 0
+func (x *TaskSearchResult) Reset() {
+	*x = TaskSearchResult{}
+	mi := &file_grpc_proto_todo_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *Task) GetCreatedAt() *timestamppb.Timestamp {
-	if x != nil {
-		return x.CreatedAt
-	}
-	return nil
+func (x *TaskSearchResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *Task) GetUpdatedAt() *timestamppb.Timestamp {
+func (*TaskSearchResult) ProtoMessage() {}
+
+func (x *TaskSearchResult) ProtoReflect() protoreflect.Message {
+	mi := &file_grpc_proto_todo_proto_msgTypes[30]
 	if x != nil {
-		return x.UpdatedAt
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *Task) GetCategoryId() uint64 {
-	if x != nil {
-		return x.CategoryId
-	}
-	return 0
+// Deprecated: Use TaskSearchResult.ProtoReflect.Descriptor instead.
+func (*TaskSearchResult) Descriptor() ([]byte, []int) {
+	return file_grpc_proto_todo_proto_rawDescGZIP(), []int{30}
 }
 
-func (x *Task) GetDueDate() *timestamppb.Timestamp {
+func (x *TaskSearchResult) GetTask() *Task {
 	if x != nil {
-		return x.DueDate
+		return x.Task
 	}
 	return nil
 }
 
-func (x *Task) GetCompletedAt() *timestamppb.Timestamp {
+func (x *TaskSearchResult) GetScore() float64 {
 	if x != nil {
-		return x.CompletedAt
+		return x.Score
 	}
-	return nil
+	return 0
 }
 
-func (x *Task) GetSubTasks() []*SubTask {
+func (x *TaskSearchResult) GetHighlights() []*SearchHighlight {
 	if x != nil {
-		return x.SubTasks
+		return x.Highlights
 	}
 	return nil
 }
 
-type NewTask struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Title         string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
-	Note          string                 `protobuf:"bytes,2,opt,name=note,proto3" json:"note,omitempty"`
-	CategoryId    uint64                 `protobuf:"varint,3,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
-	DueDate       *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=due_date,json=dueDate,proto3" json:"due_date,omitempty"`
+type SearchTasksResponse struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Results []*TaskSearchResult    `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	// Token for the page after this one; empty on the last page.
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	// Number of matching tasks across all pages.
+	TotalCount int32 `protobuf:"varint,3,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	// cursors[i] is a page_token that resumes the search right after results[i].
+	Cursors       []string `protobuf:"bytes,4,rep,name=cursors,proto3" json:"cursors,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *NewTask) Reset() {
-	*x = NewTask{}
-	mi := &file_grpc_proto_todo_proto_msgTypes[1]
+func (x *SearchTasksResponse) Reset() {
+	*x = SearchTasksResponse{}
+	mi := &file_grpc_proto_todo_proto_msgTypes[31]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *NewTask) String() string {
+func (x *SearchTasksResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*NewTask) ProtoMessage() {}
+func (*SearchTasksResponse) ProtoMessage() {}
 
-func (x *NewTask) ProtoReflect() protoreflect.Message {
-	mi := &file_grpc_proto_todo_proto_msgTypes[1]
+func (x *SearchTasksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_grpc_proto_todo_proto_msgTypes[31]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -173,67 +2622,73 @@ func (x *NewTask) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use NewTask.ProtoReflect.Descriptor instead.
-func (*NewTask) Descriptor() ([]byte, []int) {
-	return file_grpc_proto_todo_proto_rawDescGZIP(), []int{1}
+// Deprecated: Use SearchTasksResponse.ProtoReflect.Descriptor instead.
+func (*SearchTasksResponse) Descriptor() ([]byte, []int) {
+	return file_grpc_proto_todo_proto_rawDescGZIP(), []int{31}
 }
 
-func (x *NewTask) GetTitle() string {
+func (x *SearchTasksResponse) GetResults() []*TaskSearchResult {
 	if x != nil {
-		return x.Title
+		return x.Results
 	}
-	return ""
+	return nil
 }
 
-func (x *NewTask) GetNote() string {
+func (x *SearchTasksResponse) GetNextPageToken() string {
 	if x != nil {
-		return x.Note
+		return x.NextPageToken
 	}
 	return ""
 }
 
-func (x *NewTask) GetCategoryId() uint64 {
+func (x *SearchTasksResponse) GetTotalCount() int32 {
 	if x != nil {
-		return x.CategoryId
+		return x.TotalCount
 	}
 	return 0
 }
 
-func (x *NewTask) GetDueDate() *timestamppb.Timestamp {
+func (x *SearchTasksResponse) GetCursors() []string {
 	if x != nil {
-		return x.DueDate
+		return x.Cursors
 	}
 	return nil
 }
 
-type UpdateTask struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Title         *string                `protobuf:"bytes,2,opt,name=title,proto3,oneof" json:"title,omitempty"`
-	Note          *string                `protobuf:"bytes,3,opt,name=note,proto3,oneof" json:"note,omitempty"`
-	Completed     *int32                 `protobuf:"varint,4,opt,name=completed,proto3,oneof" json:"completed,omitempty"`
-	CategoryId    *uint64                `protobuf:"varint,5,opt,name=category_id,json=categoryId,proto3,oneof" json:"category_id,omitempty"`
-	DueDate       *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=due_date,json=dueDate,proto3,oneof" json:"due_date,omitempty"`
-	CompletedAt   *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=completed_at,json=completedAt,proto3,oneof" json:"completed_at,omitempty"`
+type TaskHistoryEntry struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Id     uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	TaskId uint64                 `protobuf:"varint,2,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	// Set when the change concerns one of the subtasks of the task.
+	SubTaskId *uint64 `protobuf:"varint,3,opt,name=sub_task_id,json=subTaskId,proto3,oneof" json:"sub_task_id,omitempty"`
+	// User who made the change.
+	UserId uint64            `protobuf:"varint,4,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Action TaskHistoryAction `protobuf:"varint,5,opt,name=action,proto3,enum=task.TaskHistoryAction" json:"action,omitempty"`
+	// Changed field for updates, e.g. "title", "due_date" or "sub_task_ids".
+	Field string `protobuf:"bytes,6,opt,name=field,proto3" json:"field,omitempty"`
+	// Values before and after the update. Unset for fields that had no value.
+	OldValue      *string                `protobuf:"bytes,7,opt,name=old_value,json=oldValue,proto3,oneof" json:"old_value,omitempty"`
+	NewValue      *string                `protobuf:"bytes,8,opt,name=new_value,json=newValue,proto3,oneof" json:"new_value,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateTask) Reset() {
-	*x = UpdateTask{}
-	mi := &file_grpc_proto_todo_proto_msgTypes[2]
+func (x *TaskHistoryEntry) Reset() {
+	*x = TaskHistoryEntry{}
+	mi := &file_grpc_proto_todo_proto_msgTypes[32]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateTask) String() string {
+func (x *TaskHistoryEntry) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateTask) ProtoMessage() {}
+func (*TaskHistoryEntry) ProtoMessage() {}
 
-func (x *UpdateTask) ProtoReflect() protoreflect.Message {
-	mi := &file_grpc_proto_todo_proto_msgTypes[2]
+func (x *TaskHistoryEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_grpc_proto_todo_proto_msgTypes[32]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -244,82 +2699,97 @@ func (x *UpdateTask) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateTask.ProtoReflect.Descriptor instead.
-func (*UpdateTask) Descriptor() ([]byte, []int) {
-	return file_grpc_proto_todo_proto_rawDescGZIP(), []int{2}
+// Deprecated: Use TaskHistoryEntry.ProtoReflect.Descriptor instead.
+func (*TaskHistoryEntry) Descriptor() ([]byte, []int) {
+	return file_grpc_proto_todo_proto_rawDescGZIP(), []int{32}
 }
 
-func (x *UpdateTask) GetId() uint64 {
+func (x *TaskHistoryEntry) GetId() uint64 {
 	if x != nil {
 		return x.Id
 	}
 	return 0
 }
 
-func (x *UpdateTask) GetTitle() string {
-	if x != nil && x.Title != nil {
-		return *x.Title
+func (x *TaskHistoryEntry) GetTaskId() uint64 {
+	if x != nil {
+		return x.TaskId
 	}
-	return ""
+	return 0
 }
 
-func (x *UpdateTask) GetNote() string {
-	if x != nil && x.Note != nil {
-		return *x.Note
+func (x *TaskHistoryEntry) GetSubTaskId() uint64 {
+	if x != nil && x.SubTaskId != nil {
+		return *x.SubTaskId
 	}
-	return ""
+	return 0
 }
 
-func (x *UpdateTask) GetCompleted() int32 {
-	if x != nil && x.Completed != nil {
-		return *x.Completed
+func (x *TaskHistoryEntry) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
 	}
 	return 0
 }
 
-func (x *UpdateTask) GetCategoryId() uint64 {
-	if x != nil && x.CategoryId != nil {
-		return *x.CategoryId
+func (x *TaskHistoryEntry) GetAction() TaskHistoryAction {
+	if x != nil {
+		return x.Action
 	}
-	return 0
+	return TaskHistoryAction_TASK_HISTORY_ACTION_UNSPECIFIED
 }
 
-func (x *UpdateTask) GetDueDate() *timestamppb.Timestamp {
+func (x *TaskHistoryEntry) GetField() string {
 	if x != nil {
-		return x.DueDate
+		return x.Field
 	}
-	return nil
+	return ""
 }
 
-func (x *UpdateTask) GetCompletedAt() *timestamppb.Timestamp {
+func (x *TaskHistoryEntry) GetOldValue() string {
+	if x != nil && x.OldValue != nil {
+		return *x.OldValue
+	}
+	return ""
+}
+
+func (x *TaskHistoryEntry) GetNewValue() string {
+	if x != nil && x.NewValue != nil {
+		return *x.NewValue
+	}
+	return ""
+}
+
+func (x *TaskHistoryEntry) GetCreatedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.CompletedAt
+		return x.CreatedAt
 	}
 	return nil
 }
 
-type TaskList struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Tasks         []*Task                `protobuf:"bytes,1,rep,name=tasks,proto3" json:"tasks,omitempty"`
+type TaskHistory struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Most recent first.
+	Entries       []*TaskHistoryEntry `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *TaskList) Reset() {
-	*x = TaskList{}
-	mi := &file_grpc_proto_todo_proto_msgTypes[3]
+func (x *TaskHistory) Reset() {
+	*x = TaskHistory{}
+	mi := &file_grpc_proto_todo_proto_msgTypes[33]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *TaskList) String() string {
+func (x *TaskHistory) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TaskList) ProtoMessage() {}
+func (*TaskHistory) ProtoMessage() {}
 
-func (x *TaskList) ProtoReflect() protoreflect.Message {
-	mi := &file_grpc_proto_todo_proto_msgTypes[3]
+func (x *TaskHistory) ProtoReflect() protoreflect.Message {
+	mi := &file_grpc_proto_todo_proto_msgTypes[33]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -330,48 +2800,43 @@ func (x *TaskList) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TaskList.ProtoReflect.Descriptor instead.
-func (*TaskList) Descriptor() ([]byte, []int) {
-	return file_grpc_proto_todo_proto_rawDescGZIP(), []int{3}
+// Deprecated: Use TaskHistory.ProtoReflect.Descriptor instead.
+func (*TaskHistory) Descriptor() ([]byte, []int) {
+	return file_grpc_proto_todo_proto_rawDescGZIP(), []int{33}
 }
 
-func (x *TaskList) GetTasks() []*Task {
+func (x *TaskHistory) GetEntries() []*TaskHistoryEntry {
 	if x != nil {
-		return x.Tasks
+		return x.Entries
 	}
 	return nil
 }
 
-type SubTask struct {
+// BulkTaskTarget picks the tasks of a bulk operation: the tasks listed in ids, or every
+// task matching filter. Exactly one of them must be set; paging fields of filter are ignored.
+type BulkTaskTarget struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	TaskId        uint64                 `protobuf:"varint,2,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
-	Title         string                 `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
-	Note          string                 `protobuf:"bytes,4,opt,name=note,proto3" json:"note,omitempty"`
-	Completed     int32                  `protobuf:"varint,5,opt,name=completed,proto3" json:"completed,omitempty"`
-	CompletedAt   *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=completed_at,json=completedAt,proto3" json:"completed_at,omitempty"`
-	DueDate       *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=due_date,json=dueDate,proto3" json:"due_date,omitempty"`
-	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	Ids           []uint64               `protobuf:"varint,1,rep,packed,name=ids,proto3" json:"ids,omitempty"`
+	Filter        *GetTasksRequest       `protobuf:"bytes,2,opt,name=filter,proto3" json:"filter,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SubTask) Reset() {
-	*x = SubTask{}
-	mi := &file_grpc_proto_todo_proto_msgTypes[4]
+func (x *BulkTaskTarget) Reset() {
+	*x = BulkTaskTarget{}
+	mi := &file_grpc_proto_todo_proto_msgTypes[34]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SubTask) String() string {
+func (x *BulkTaskTarget) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SubTask) ProtoMessage() {}
+func (*BulkTaskTarget) ProtoMessage() {}
 
-func (x *SubTask) ProtoReflect() protoreflect.Message {
-	mi := &file_grpc_proto_todo_proto_msgTypes[4]
+func (x *BulkTaskTarget) ProtoReflect() protoreflect.Message {
+	mi := &file_grpc_proto_todo_proto_msgTypes[34]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -382,99 +2847,54 @@ func (x *SubTask) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SubTask.ProtoReflect.Descriptor instead.
-func (*SubTask) Descriptor() ([]byte, []int) {
-	return file_grpc_proto_todo_proto_rawDescGZIP(), []int{4}
-}
-
-func (x *SubTask) GetId() uint64 {
-	if x != nil {
-		return x.Id
-	}
-	return 0
-}
-
-func (x *SubTask) GetTaskId() uint64 {
-	if x != nil {
-		return x.TaskId
-	}
-	return 0
-}
-
-func (x *SubTask) GetTitle() string {
-	if x != nil {
-		return x.Title
-	}
-	return ""
-}
-
-func (x *SubTask) GetNote() string {
-	if x != nil {
-		return x.Note
-	}
-	return ""
-}
-
-func (x *SubTask) GetCompleted() int32 {
-	if x != nil {
-		return x.Completed
-	}
-	return 0
-}
-
-func (x *SubTask) GetCompletedAt() *timestamppb.Timestamp {
-	if x != nil {
-		return x.CompletedAt
-	}
-	return nil
-}
-
-func (x *SubTask) GetDueDate() *timestamppb.Timestamp {
-	if x != nil {
-		return x.DueDate
-	}
-	return nil
+// Deprecated: Use BulkTaskTarget.ProtoReflect.Descriptor instead.
+func (*BulkTaskTarget) Descriptor() ([]byte, []int) {
+	return file_grpc_proto_todo_proto_rawDescGZIP(), []int{34}
 }
 
-func (x *SubTask) GetCreatedAt() *timestamppb.Timestamp {
+func (x *BulkTaskTarget) GetIds() []uint64 {
 	if x != nil {
-		return x.CreatedAt
+		return x.Ids
 	}
 	return nil
 }
 
-func (x *SubTask) GetUpdatedAt() *timestamppb.Timestamp {
+func (x *BulkTaskTarget) GetFilter() *GetTasksRequest {
 	if x != nil {
-		return x.UpdatedAt
+		return x.Filter
 	}
 	return nil
 }
 
-type NewSubTask struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	TaskId        uint64                 `protobuf:"varint,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
-	Title         string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
-	Note          string                 `protobuf:"bytes,3,opt,name=note,proto3" json:"note,omitempty"`
-	DueDate       *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=due_date,json=dueDate,proto3" json:"due_date,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type BulkUpdateTasksRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Target    *BulkTaskTarget        `protobuf:"bytes,1,opt,name=target,proto3" json:"target,omitempty"`
+	Completed *int32                 `protobuf:"varint,2,opt,name=completed,proto3,oneof" json:"completed,omitempty"`
+	// Zero removes the category.
+	CategoryId *uint64                `protobuf:"varint,3,opt,name=category_id,json=categoryId,proto3,oneof" json:"category_id,omitempty"`
+	DueDate    *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=due_date,json=dueDate,proto3,oneof" json:"due_date,omitempty"`
+	// Moves each due date by this many days. Tasks without a due date are left as they are.
+	// Cannot be combined with due_date.
+	ShiftDueDateDays *int32 `protobuf:"varint,5,opt,name=shift_due_date_days,json=shiftDueDateDays,proto3,oneof" json:"shift_due_date_days,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
 }
 
-func (x *NewSubTask) Reset() {
-	*x = NewSubTask{}
-	mi := &file_grpc_proto_todo_proto_msgTypes[5]
+func (x *BulkUpdateTasksRequest) Reset() {
+	*x = BulkUpdateTasksRequest{}
+	mi := &file_grpc_proto_todo_proto_msgTypes[35]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *NewSubTask) String() string {
+func (x *BulkUpdateTasksRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*NewSubTask) ProtoMessage() {}
+func (*BulkUpdateTasksRequest) ProtoMessage() {}
 
-func (x *NewSubTask) ProtoReflect() protoreflect.Message {
-	mi := &file_grpc_proto_todo_proto_msgTypes[5]
+func (x *BulkUpdateTasksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_grpc_proto_todo_proto_msgTypes[35]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -485,62 +2905,68 @@ func (x *NewSubTask) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use NewSubTask.ProtoReflect.Descriptor instead.
-func (*NewSubTask) Descriptor() ([]byte, []int) {
-	return file_grpc_proto_todo_proto_rawDescGZIP(), []int{5}
+// Deprecated: Use BulkUpdateTasksRequest.ProtoReflect.Descriptor instead.
+func (*BulkUpdateTasksRequest) Descriptor() ([]byte, []int) {
+	return file_grpc_proto_todo_proto_rawDescGZIP(), []int{35}
 }
 
-func (x *NewSubTask) GetTaskId() uint64 {
+func (x *BulkUpdateTasksRequest) GetTarget() *BulkTaskTarget {
 	if x != nil {
-		return x.TaskId
+		return x.Target
 	}
-	return 0
+	return nil
 }
 
-func (x *NewSubTask) GetTitle() string {
-	if x != nil {
-		return x.Title
+func (x *BulkUpdateTasksRequest) GetCompleted() int32 {
+	if x != nil && x.Completed != nil {
+		return *x.Completed
 	}
-	return ""
+	return 0
 }
 
-func (x *NewSubTask) GetNote() string {
-	if x != nil {
-		return x.Note
+func (x *BulkUpdateTasksRequest) GetCategoryId() uint64 {
+	if x != nil && x.CategoryId != nil {
+		return *x.CategoryId
 	}
-	return ""
+	return 0
 }
 
-func (x *NewSubTask) GetDueDate() *timestamppb.Timestamp {
+func (x *BulkUpdateTasksRequest) GetDueDate() *timestamppb.Timestamp {
 	if x != nil {
 		return x.DueDate
 	}
 	return nil
 }
 
-type ToggleSubTaskRequest struct {
+func (x *BulkUpdateTasksRequest) GetShiftDueDateDays() int32 {
+	if x != nil && x.ShiftDueDateDays != nil {
+		return *x.ShiftDueDateDays
+	}
+	return 0
+}
+
+type BulkDeleteTasksRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Completed     bool                   `protobuf:"varint,2,opt,name=completed,proto3" json:"completed,omitempty"`
+	Target        *BulkTaskTarget        `protobuf:"bytes,1,opt,name=target,proto3" json:"target,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ToggleSubTaskRequest) Reset() {
-	*x = ToggleSubTaskRequest{}
-	mi := &file_grpc_proto_todo_proto_msgTypes[6]
+func (x *BulkDeleteTasksRequest) Reset() {
+	*x = BulkDeleteTasksRequest{}
+	mi := &file_grpc_proto_todo_proto_msgTypes[36]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ToggleSubTaskRequest) String() string {
+func (x *BulkDeleteTasksRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ToggleSubTaskRequest) ProtoMessage() {}
+func (*BulkDeleteTasksRequest) ProtoMessage() {}
 
-func (x *ToggleSubTaskRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_grpc_proto_todo_proto_msgTypes[6]
+func (x *BulkDeleteTasksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_grpc_proto_todo_proto_msgTypes[36]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -551,47 +2977,44 @@ func (x *ToggleSubTaskRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ToggleSubTaskRequest.ProtoReflect.Descriptor instead.
-func (*ToggleSubTaskRequest) Descriptor() ([]byte, []int) {
-	return file_grpc_proto_todo_proto_rawDescGZIP(), []int{6}
-}
-
-func (x *ToggleSubTaskRequest) GetId() uint64 {
-	if x != nil {
-		return x.Id
-	}
-	return 0
+// Deprecated: Use BulkDeleteTasksRequest.ProtoReflect.Descriptor instead.
+func (*BulkDeleteTasksRequest) Descriptor() ([]byte, []int) {
+	return file_grpc_proto_todo_proto_rawDescGZIP(), []int{36}
 }
 
-func (x *ToggleSubTaskRequest) GetCompleted() bool {
+func (x *BulkDeleteTasksRequest) GetTarget() *BulkTaskTarget {
 	if x != nil {
-		return x.Completed
+		return x.Target
 	}
-	return false
+	return nil
 }
 
-type SubTaskList struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	SubTasks      []*SubTask             `protobuf:"bytes,1,rep,name=sub_tasks,json=subTasks,proto3" json:"sub_tasks,omitempty"`
+type BulkTaskResult struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	TaskId uint64                 `protobuf:"varint,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	// Set when the task could not be changed.
+	Error *BulkTaskError `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	// The updated task. Only set by applied bulk updates.
+	Task          *Task `protobuf:"bytes,3,opt,name=task,proto3" json:"task,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SubTaskList) Reset() {
-	*x = SubTaskList{}
-	mi := &file_grpc_proto_todo_proto_msgTypes[7]
+func (x *BulkTaskResult) Reset() {
+	*x = BulkTaskResult{}
+	mi := &file_grpc_proto_todo_proto_msgTypes[37]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SubTaskList) String() string {
+func (x *BulkTaskResult) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SubTaskList) ProtoMessage() {}
+func (*BulkTaskResult) ProtoMessage() {}
 
-func (x *SubTaskList) ProtoReflect() protoreflect.Message {
-	mi := &file_grpc_proto_todo_proto_msgTypes[7]
+func (x *BulkTaskResult) ProtoReflect() protoreflect.Message {
+	mi := &file_grpc_proto_todo_proto_msgTypes[37]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -602,40 +3025,56 @@ func (x *SubTaskList) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SubTaskList.ProtoReflect.Descriptor instead.
-func (*SubTaskList) Descriptor() ([]byte, []int) {
-	return file_grpc_proto_todo_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use BulkTaskResult.ProtoReflect.Descriptor instead.
+func (*BulkTaskResult) Descriptor() ([]byte, []int) {
+	return file_grpc_proto_todo_proto_rawDescGZIP(), []int{37}
 }
 
-func (x *SubTaskList) GetSubTasks() []*SubTask {
+func (x *BulkTaskResult) GetTaskId() uint64 {
 	if x != nil {
-		return x.SubTasks
+		return x.TaskId
+	}
+	return 0
+}
+
+func (x *BulkTaskResult) GetError() *BulkTaskError {
+	if x != nil {
+		return x.Error
 	}
 	return nil
 }
 
-type TaskId struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+func (x *BulkTaskResult) GetTask() *Task {
+	if x != nil {
+		return x.Task
+	}
+	return nil
+}
+
+type BulkTaskError struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// A google.rpc.Code value, e.g. 5 for NOT_FOUND.
+	Code          int32  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Message       string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *TaskId) Reset() {
-	*x = TaskId{}
-	mi := &file_grpc_proto_todo_proto_msgTypes[8]
+func (x *BulkTaskError) Reset() {
+	*x = BulkTaskError{}
+	mi := &file_grpc_proto_todo_proto_msgTypes[38]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *TaskId) String() string {
+func (x *BulkTaskError) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TaskId) ProtoMessage() {}
+func (*BulkTaskError) ProtoMessage() {}
 
-func (x *TaskId) ProtoReflect() protoreflect.Message {
-	mi := &file_grpc_proto_todo_proto_msgTypes[8]
+func (x *BulkTaskError) ProtoReflect() protoreflect.Message {
+	mi := &file_grpc_proto_todo_proto_msgTypes[38]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -646,43 +3085,50 @@ func (x *TaskId) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TaskId.ProtoReflect.Descriptor instead.
-func (*TaskId) Descriptor() ([]byte, []int) {
-	return file_grpc_proto_todo_proto_rawDescGZIP(), []int{8}
+// Deprecated: Use BulkTaskError.ProtoReflect.Descriptor instead.
+func (*BulkTaskError) Descriptor() ([]byte, []int) {
+	return file_grpc_proto_todo_proto_rawDescGZIP(), []int{38}
 }
 
-func (x *TaskId) GetId() uint64 {
+func (x *BulkTaskError) GetCode() int32 {
 	if x != nil {
-		return x.Id
+		return x.Code
 	}
 	return 0
 }
 
-type GetTasksRequest struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	CategoryId     *uint64                `protobuf:"varint,1,opt,name=category_id,json=categoryId,proto3,oneof" json:"category_id,omitempty"`
-	DueDateStart   *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=due_date_start,json=dueDateStart,proto3,oneof" json:"due_date_start,omitempty"`
-	DueDateEnd     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=due_date_end,json=dueDateEnd,proto3,oneof" json:"due_date_end,omitempty"`
-	IncompleteOnly *bool                  `protobuf:"varint,4,opt,name=incomplete_only,json=incompleteOnly,proto3,oneof" json:"incomplete_only,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+func (x *BulkTaskError) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
 }
 
-func (x *GetTasksRequest) Reset() {
-	*x = GetTasksRequest{}
-	mi := &file_grpc_proto_todo_proto_msgTypes[9]
+type BulkTasksResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Bulk operations are all or nothing: applied is false and no task is changed when any
+	// task fails.
+	Applied       bool              `protobuf:"varint,1,opt,name=applied,proto3" json:"applied,omitempty"`
+	Results       []*BulkTaskResult `protobuf:"bytes,2,rep,name=results,proto3" json:"results,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BulkTasksResponse) Reset() {
+	*x = BulkTasksResponse{}
+	mi := &file_grpc_proto_todo_proto_msgTypes[39]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetTasksRequest) String() string {
+func (x *BulkTasksResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetTasksRequest) ProtoMessage() {}
+func (*BulkTasksResponse) ProtoMessage() {}
 
-func (x *GetTasksRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_grpc_proto_todo_proto_msgTypes[9]
+func (x *BulkTasksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_grpc_proto_todo_proto_msgTypes[39]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -693,61 +3139,50 @@ func (x *GetTasksRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetTasksRequest.ProtoReflect.Descriptor instead.
-func (*GetTasksRequest) Descriptor() ([]byte, []int) {
-	return file_grpc_proto_todo_proto_rawDescGZIP(), []int{9}
-}
-
-func (x *GetTasksRequest) GetCategoryId() uint64 {
-	if x != nil && x.CategoryId != nil {
-		return *x.CategoryId
-	}
-	return 0
+// Deprecated: Use BulkTasksResponse.ProtoReflect.Descriptor instead.
+func (*BulkTasksResponse) Descriptor() ([]byte, []int) {
+	return file_grpc_proto_todo_proto_rawDescGZIP(), []int{39}
 }
 
-func (x *GetTasksRequest) GetDueDateStart() *timestamppb.Timestamp {
+func (x *BulkTasksResponse) GetApplied() bool {
 	if x != nil {
-		return x.DueDateStart
+		return x.Applied
 	}
-	return nil
+	return false
 }
 
-func (x *GetTasksRequest) GetDueDateEnd() *timestamppb.Timestamp {
+func (x *BulkTasksResponse) GetResults() []*BulkTaskResult {
 	if x != nil {
-		return x.DueDateEnd
+		return x.Results
 	}
 	return nil
 }
 
-func (x *GetTasksRequest) GetIncompleteOnly() bool {
-	if x != nil && x.IncompleteOnly != nil {
-		return *x.IncompleteOnly
-	}
-	return false
-}
-
-type CreateTaskRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Input         *NewTask               `protobuf:"bytes,1,opt,name=input,proto3" json:"input,omitempty"`
+type GetTaskStatsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Weeks up to and including today covered by the completion figures. Zero means 4; at most 52.
+	Weeks int32 `protobuf:"varint,1,opt,name=weeks,proto3" json:"weeks,omitempty"`
+	// Restricts the statistics to one category. Zero selects the tasks without a category.
+	CategoryId    *uint64 `protobuf:"varint,2,opt,name=category_id,json=categoryId,proto3,oneof" json:"category_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateTaskRequest) Reset() {
-	*x = CreateTaskRequest{}
-	mi := &file_grpc_proto_todo_proto_msgTypes[10]
+func (x *GetTaskStatsRequest) Reset() {
+	*x = GetTaskStatsRequest{}
+	mi := &file_grpc_proto_todo_proto_msgTypes[40]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateTaskRequest) String() string {
+func (x *GetTaskStatsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateTaskRequest) ProtoMessage() {}
+func (*GetTaskStatsRequest) ProtoMessage() {}
 
-func (x *CreateTaskRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_grpc_proto_todo_proto_msgTypes[10]
+func (x *GetTaskStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_grpc_proto_todo_proto_msgTypes[40]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -758,40 +3193,59 @@ func (x *CreateTaskRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateTaskRequest.ProtoReflect.Descriptor instead.
-func (*CreateTaskRequest) Descriptor() ([]byte, []int) {
-	return file_grpc_proto_todo_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use GetTaskStatsRequest.ProtoReflect.Descriptor instead.
+func (*GetTaskStatsRequest) Descriptor() ([]byte, []int) {
+	return file_grpc_proto_todo_proto_rawDescGZIP(), []int{40}
 }
 
-func (x *CreateTaskRequest) GetInput() *NewTask {
+func (x *GetTaskStatsRequest) GetWeeks() int32 {
 	if x != nil {
-		return x.Input
+		return x.Weeks
 	}
-	return nil
+	return 0
 }
 
-type UpdateTaskRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Input         *UpdateTask            `protobuf:"bytes,1,opt,name=input,proto3" json:"input,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *GetTaskStatsRequest) GetCategoryId() uint64 {
+	if x != nil && x.CategoryId != nil {
+		return *x.CategoryId
+	}
+	return 0
 }
 
-func (x *UpdateTaskRequest) Reset() {
-	*x = UpdateTaskRequest{}
-	mi := &file_grpc_proto_todo_proto_msgTypes[11]
+// TaskStats describes open and overdue tasks as they are now, and the tasks completed from
+// `from` to `to`.
+type TaskStats struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	From  *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	To    *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
+	// Categories with open tasks or tasks completed in the range. category_id 0 counts tasks
+	// without a category.
+	Categories []*CategoryTaskCount `protobuf:"bytes,3,rep,name=categories,proto3" json:"categories,omitempty"`
+	// Open tasks whose due date has passed.
+	Overdue int32 `protobuf:"varint,4,opt,name=overdue,proto3" json:"overdue,omitempty"`
+	// One entry per day of the range, oldest first.
+	CompletedPerDay []*DailyCompletionCount `protobuf:"bytes,5,rep,name=completed_per_day,json=completedPerDay,proto3" json:"completed_per_day,omitempty"`
+	// Mean time from creation to completion of the tasks completed in the range. Unset when there are none.
+	AverageCompletionSeconds *float64 `protobuf:"fixed64,6,opt,name=average_completion_seconds,json=averageCompletionSeconds,proto3,oneof" json:"average_completion_seconds,omitempty"`
+	unknownFields            protoimpl.UnknownFields
+	sizeCache                protoimpl.SizeCache
+}
+
+func (x *TaskStats) Reset() {
+	*x = TaskStats{}
+	mi := &file_grpc_proto_todo_proto_msgTypes[41]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateTaskRequest) String() string {
+func (x *TaskStats) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateTaskRequest) ProtoMessage() {}
+func (*TaskStats) ProtoMessage() {}
 
-func (x *UpdateTaskRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_grpc_proto_todo_proto_msgTypes[11]
+func (x *TaskStats) ProtoReflect() protoreflect.Message {
+	mi := &file_grpc_proto_todo_proto_msgTypes[41]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -802,40 +3256,77 @@ func (x *UpdateTaskRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateTaskRequest.ProtoReflect.Descriptor instead.
-func (*UpdateTaskRequest) Descriptor() ([]byte, []int) {
-	return file_grpc_proto_todo_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use TaskStats.ProtoReflect.Descriptor instead.
+func (*TaskStats) Descriptor() ([]byte, []int) {
+	return file_grpc_proto_todo_proto_rawDescGZIP(), []int{41}
 }
 
-func (x *UpdateTaskRequest) GetInput() *UpdateTask {
+func (x *TaskStats) GetFrom() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Input
+		return x.From
 	}
 	return nil
 }
 
-type DeleteTaskResponse struct {
+func (x *TaskStats) GetTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+func (x *TaskStats) GetCategories() []*CategoryTaskCount {
+	if x != nil {
+		return x.Categories
+	}
+	return nil
+}
+
+func (x *TaskStats) GetOverdue() int32 {
+	if x != nil {
+		return x.Overdue
+	}
+	return 0
+}
+
+func (x *TaskStats) GetCompletedPerDay() []*DailyCompletionCount {
+	if x != nil {
+		return x.CompletedPerDay
+	}
+	return nil
+}
+
+func (x *TaskStats) GetAverageCompletionSeconds() float64 {
+	if x != nil && x.AverageCompletionSeconds != nil {
+		return *x.AverageCompletionSeconds
+	}
+	return 0
+}
+
+type CategoryTaskCount struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	CategoryId    uint64                 `protobuf:"varint,1,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
+	Open          int32                  `protobuf:"varint,2,opt,name=open,proto3" json:"open,omitempty"`
+	Completed     int32                  `protobuf:"varint,3,opt,name=completed,proto3" json:"completed,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteTaskResponse) Reset() {
-	*x = DeleteTaskResponse{}
-	mi := &file_grpc_proto_todo_proto_msgTypes[12]
+func (x *CategoryTaskCount) Reset() {
+	*x = CategoryTaskCount{}
+	mi := &file_grpc_proto_todo_proto_msgTypes[42]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteTaskResponse) String() string {
+func (x *CategoryTaskCount) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteTaskResponse) ProtoMessage() {}
+func (*CategoryTaskCount) ProtoMessage() {}
 
-func (x *DeleteTaskResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_grpc_proto_todo_proto_msgTypes[12]
+func (x *CategoryTaskCount) ProtoReflect() protoreflect.Message {
+	mi := &file_grpc_proto_todo_proto_msgTypes[42]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -846,40 +3337,55 @@ func (x *DeleteTaskResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteTaskResponse.ProtoReflect.Descriptor instead.
-func (*DeleteTaskResponse) Descriptor() ([]byte, []int) {
-	return file_grpc_proto_todo_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use CategoryTaskCount.ProtoReflect.Descriptor instead.
+func (*CategoryTaskCount) Descriptor() ([]byte, []int) {
+	return file_grpc_proto_todo_proto_rawDescGZIP(), []int{42}
 }
 
-func (x *DeleteTaskResponse) GetSuccess() bool {
+func (x *CategoryTaskCount) GetCategoryId() uint64 {
 	if x != nil {
-		return x.Success
+		return x.CategoryId
 	}
-	return false
+	return 0
 }
 
-type CreateSubTaskRequest struct {
+func (x *CategoryTaskCount) GetOpen() int32 {
+	if x != nil {
+		return x.Open
+	}
+	return 0
+}
+
+func (x *CategoryTaskCount) GetCompleted() int32 {
+	if x != nil {
+		return x.Completed
+	}
+	return 0
+}
+
+type DailyCompletionCount struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Input         *NewSubTask            `protobuf:"bytes,1,opt,name=input,proto3" json:"input,omitempty"`
+	Date          *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"`
+	Completed     int32                  `protobuf:"varint,2,opt,name=completed,proto3" json:"completed,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateSubTaskRequest) Reset() {
-	*x = CreateSubTaskRequest{}
-	mi := &file_grpc_proto_todo_proto_msgTypes[13]
+func (x *DailyCompletionCount) Reset() {
+	*x = DailyCompletionCount{}
+	mi := &file_grpc_proto_todo_proto_msgTypes[43]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateSubTaskRequest) String() string {
+func (x *DailyCompletionCount) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateSubTaskRequest) ProtoMessage() {}
+func (*DailyCompletionCount) ProtoMessage() {}
 
-func (x *CreateSubTaskRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_grpc_proto_todo_proto_msgTypes[13]
+func (x *DailyCompletionCount) ProtoReflect() protoreflect.Message {
+	mi := &file_grpc_proto_todo_proto_msgTypes[43]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -890,23 +3396,30 @@ func (x *CreateSubTaskRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateSubTaskRequest.ProtoReflect.Descriptor instead.
-func (*CreateSubTaskRequest) Descriptor() ([]byte, []int) {
-	return file_grpc_proto_todo_proto_rawDescGZIP(), []int{13}
+// Deprecated: Use DailyCompletionCount.ProtoReflect.Descriptor instead.
+func (*DailyCompletionCount) Descriptor() ([]byte, []int) {
+	return file_grpc_proto_todo_proto_rawDescGZIP(), []int{43}
 }
 
-func (x *CreateSubTaskRequest) GetInput() *NewSubTask {
+func (x *DailyCompletionCount) GetDate() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Input
+		return x.Date
 	}
 	return nil
 }
 
+func (x *DailyCompletionCount) GetCompleted() int32 {
+	if x != nil {
+		return x.Completed
+	}
+	return 0
+}
+
 var File_grpc_proto_todo_proto protoreflect.FileDescriptor
 
 const file_grpc_proto_todo_proto_rawDesc = "" +
 	"\n" +
-	"\x15grpc/proto/todo.proto\x12\x04task\x1a\x1fgoogle/protobuf/timestamp.proto\"\x97\x03\n" +
+	"\x15grpc/proto/todo.proto\x12\x04task\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xfa\x05\n" +
 	"\x04Task\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x04R\x02id\x12\x14\n" +
 	"\x05title\x18\x02 \x01(\tR\x05title\x12\x12\n" +
@@ -921,13 +3434,42 @@ const file_grpc_proto_todo_proto_rawDesc = "" +
 	"\bdue_date\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\adueDate\x12=\n" +
 	"\fcompleted_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\vcompletedAt\x12*\n" +
 	"\tsub_tasks\x18\n" +
-	" \x03(\v2\r.task.SubTaskR\bsubTasks\"\x8b\x01\n" +
+	" \x03(\v2\r.task.SubTaskR\bsubTasks\x129\n" +
+	"\n" +
+	"deleted_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\tdeletedAt\x120\n" +
+	"\n" +
+	"recurrence\x18\f \x01(\v2\x10.task.RecurrenceR\n" +
+	"recurrence\x12\x17\n" +
+	"\atag_ids\x18\r \x03(\x04R\x06tagIds\x12*\n" +
+	"\bpriority\x18\x0e \x01(\x0e2\x0e.task.PriorityR\bpriority\x12\x18\n" +
+	"\aversion\x18\x0f \x01(\x05R\aversion\x12#\n" +
+	"\rsubtask_total\x18\x10 \x01(\x05R\fsubtaskTotal\x12+\n" +
+	"\x11subtask_completed\x18\x11 \x01(\x05R\x10subtaskCompleted\x12\x1a\n" +
+	"\bprogress\x18\x12 \x01(\x01R\bprogress\x12'\n" +
+	"\x0fsync_completion\x18\x13 \x01(\bR\x0esyncCompletion\"\xbe\x01\n" +
+	"\n" +
+	"Recurrence\x127\n" +
+	"\tfrequency\x18\x01 \x01(\x0e2\x19.task.RecurrenceFrequencyR\tfrequency\x12\x1a\n" +
+	"\binterval\x18\x02 \x01(\x05R\binterval\x12)\n" +
+	"\bweekdays\x18\x03 \x03(\x0e2\r.task.WeekdayR\bweekdays\x120\n" +
+	"\x05until\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\x05until\"\xde\x02\n" +
 	"\aNewTask\x12\x14\n" +
 	"\x05title\x18\x01 \x01(\tR\x05title\x12\x12\n" +
 	"\x04note\x18\x02 \x01(\tR\x04note\x12\x1f\n" +
 	"\vcategory_id\x18\x03 \x01(\x04R\n" +
 	"categoryId\x125\n" +
-	"\bdue_date\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\adueDate\"\xe8\x02\n" +
+	"\bdue_date\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\adueDate\x120\n" +
+	"\n" +
+	"recurrence\x18\x05 \x01(\v2\x10.task.RecurrenceR\n" +
+	"recurrence\x12\x17\n" +
+	"\atag_ids\x18\x06 \x03(\x04R\x06tagIds\x12*\n" +
+	"\bpriority\x18\a \x01(\x0e2\x0e.task.PriorityR\bpriority\x121\n" +
+	"\tsub_tasks\x18\b \x03(\v2\x14.task.NewTaskSubTaskR\bsubTasks\x12'\n" +
+	"\x0fsync_completion\x18\t \x01(\bR\x0esyncCompletion\"q\n" +
+	"\x0eNewTaskSubTask\x12\x14\n" +
+	"\x05title\x18\x01 \x01(\tR\x05title\x12\x12\n" +
+	"\x04note\x18\x02 \x01(\tR\x04note\x125\n" +
+	"\bdue_date\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\adueDate\"\x81\x06\n" +
 	"\n" +
 	"UpdateTask\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x04R\x02id\x12\x19\n" +
@@ -937,17 +3479,37 @@ const file_grpc_proto_todo_proto_rawDesc = "" +
 	"\vcategory_id\x18\x05 \x01(\x04H\x03R\n" +
 	"categoryId\x88\x01\x01\x12:\n" +
 	"\bdue_date\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampH\x04R\adueDate\x88\x01\x01\x12B\n" +
-	"\fcompleted_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampH\x05R\vcompletedAt\x88\x01\x01B\b\n" +
+	"\fcompleted_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampH\x05R\vcompletedAt\x88\x01\x01\x120\n" +
+	"\n" +
+	"recurrence\x18\b \x01(\v2\x10.task.RecurrenceR\n" +
+	"recurrence\x12)\n" +
+	"\x10clear_recurrence\x18\t \x01(\bR\x0fclearRecurrence\x12(\n" +
+	"\atag_ids\x18\n" +
+	" \x01(\v2\x0f.task.TagIdListR\x06tagIds\x12/\n" +
+	"\bpriority\x18\v \x01(\x0e2\x0e.task.PriorityH\x06R\bpriority\x88\x01\x01\x12.\n" +
+	"\x10expected_version\x18\f \x01(\x05H\aR\x0fexpectedVersion\x88\x01\x01\x12$\n" +
+	"\x0eclear_due_date\x18\r \x01(\bR\fclearDueDate\x12%\n" +
+	"\x0eclear_category\x18\x0e \x01(\bR\rclearCategory\x12,\n" +
+	"\x0fsync_completion\x18\x0f \x01(\bH\bR\x0esyncCompletion\x88\x01\x01B\b\n" +
 	"\x06_titleB\a\n" +
 	"\x05_noteB\f\n" +
 	"\n" +
 	"_completedB\x0e\n" +
 	"\f_category_idB\v\n" +
 	"\t_due_dateB\x0f\n" +
-	"\r_completed_at\",\n" +
+	"\r_completed_atB\v\n" +
+	"\t_priorityB\x13\n" +
+	"\x11_expected_versionB\x12\n" +
+	"\x10_sync_completion\"\x1d\n" +
+	"\tTagIdList\x12\x10\n" +
+	"\x03ids\x18\x01 \x03(\x04R\x03ids\"\x8f\x01\n" +
 	"\bTaskList\x12 \n" +
 	"\x05tasks\x18\x01 \x03(\v2\n" +
-	".task.TaskR\x05tasks\"\xe6\x02\n" +
+	".task.TaskR\x05tasks\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\x12\x1f\n" +
+	"\vtotal_count\x18\x03 \x01(\x05R\n" +
+	"totalCount\x12\x18\n" +
+	"\acursors\x18\x04 \x03(\tR\acursors\"\x9c\x03\n" +
 	"\aSubTask\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x04R\x02id\x12\x17\n" +
 	"\atask_id\x18\x02 \x01(\x04R\x06taskId\x12\x14\n" +
@@ -959,31 +3521,69 @@ const file_grpc_proto_todo_proto_rawDesc = "" +
 	"\n" +
 	"created_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
 	"\n" +
-	"updated_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\x86\x01\n" +
+	"updated_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12\x1a\n" +
+	"\bposition\x18\n" +
+	" \x01(\x05R\bposition\x12\x18\n" +
+	"\aversion\x18\v \x01(\x05R\aversion\"\x86\x01\n" +
 	"\n" +
 	"NewSubTask\x12\x17\n" +
 	"\atask_id\x18\x01 \x01(\x04R\x06taskId\x12\x14\n" +
 	"\x05title\x18\x02 \x01(\tR\x05title\x12\x12\n" +
 	"\x04note\x18\x03 \x01(\tR\x04note\x125\n" +
-	"\bdue_date\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\adueDate\"D\n" +
+	"\bdue_date\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\adueDate\"\xf4\x01\n" +
+	"\rUpdateSubTask\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\x12\x19\n" +
+	"\x05title\x18\x02 \x01(\tH\x00R\x05title\x88\x01\x01\x12\x17\n" +
+	"\x04note\x18\x03 \x01(\tH\x01R\x04note\x88\x01\x01\x12:\n" +
+	"\bdue_date\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampH\x02R\adueDate\x88\x01\x01\x12.\n" +
+	"\x10expected_version\x18\x05 \x01(\x05H\x03R\x0fexpectedVersion\x88\x01\x01B\b\n" +
+	"\x06_titleB\a\n" +
+	"\x05_noteB\v\n" +
+	"\t_due_dateB\x13\n" +
+	"\x11_expected_version\"\x89\x01\n" +
 	"\x14ToggleSubTaskRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x04R\x02id\x12\x1c\n" +
-	"\tcompleted\x18\x02 \x01(\bR\tcompleted\"9\n" +
+	"\tcompleted\x18\x02 \x01(\bR\tcompleted\x12.\n" +
+	"\x10expected_version\x18\x03 \x01(\x05H\x00R\x0fexpectedVersion\x88\x01\x01B\x13\n" +
+	"\x11_expected_version\"9\n" +
 	"\vSubTaskList\x12*\n" +
 	"\tsub_tasks\x18\x01 \x03(\v2\r.task.SubTaskR\bsubTasks\"\x18\n" +
 	"\x06TaskId\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\x04R\x02id\"\xb7\x02\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\"\x1b\n" +
+	"\aTaskIds\x12\x10\n" +
+	"\x03ids\x18\x01 \x03(\x04R\x03ids\"N\n" +
+	"\x14BatchGetTasksRequest\x12\x10\n" +
+	"\x03ids\x18\x01 \x03(\x04R\x03ids\x12$\n" +
+	"\x0eskip_sub_tasks\x18\x02 \x01(\bR\fskipSubTasks\"\xa1\x01\n" +
+	"\x0eSubTasksByTask\x12?\n" +
+	"\tsub_tasks\x18\x01 \x03(\v2\".task.SubTasksByTask.SubTasksEntryR\bsubTasks\x1aN\n" +
+	"\rSubTasksEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\x04R\x03key\x12'\n" +
+	"\x05value\x18\x02 \x01(\v2\x11.task.SubTaskListR\x05value:\x028\x01\"\xdf\x04\n" +
 	"\x0fGetTasksRequest\x12$\n" +
 	"\vcategory_id\x18\x01 \x01(\x04H\x00R\n" +
 	"categoryId\x88\x01\x01\x12E\n" +
 	"\x0edue_date_start\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampH\x01R\fdueDateStart\x88\x01\x01\x12A\n" +
 	"\fdue_date_end\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampH\x02R\n" +
 	"dueDateEnd\x88\x01\x01\x12,\n" +
-	"\x0fincomplete_only\x18\x04 \x01(\bH\x03R\x0eincompleteOnly\x88\x01\x01B\x0e\n" +
+	"\x0fincomplete_only\x18\x04 \x01(\bH\x03R\x0eincompleteOnly\x88\x01\x01\x12\x1b\n" +
+	"\tpage_size\x18\x05 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x06 \x01(\tR\tpageToken\x12$\n" +
+	"\x0eskip_sub_tasks\x18\b \x01(\bR\fskipSubTasks\x12\x17\n" +
+	"\atag_ids\x18\t \x03(\x04R\x06tagIds\x12+\n" +
+	"\ttag_match\x18\n" +
+	" \x01(\x0e2\x0e.task.TagMatchR\btagMatch\x12*\n" +
+	"\border_by\x18\v \x03(\v2\x0f.task.TaskOrderR\aorderBy\x126\n" +
+	"\fmin_priority\x18\f \x01(\x0e2\x0e.task.PriorityH\x04R\vminPriority\x88\x01\x01B\x0e\n" +
 	"\f_category_idB\x11\n" +
 	"\x0f_due_date_startB\x0f\n" +
 	"\r_due_date_endB\x12\n" +
-	"\x10_incomplete_only\"8\n" +
+	"\x10_incomplete_onlyB\x0f\n" +
+	"\r_min_priorityJ\x04\b\a\x10\bR\x03all\"j\n" +
+	"\tTaskOrder\x12*\n" +
+	"\x05field\x18\x01 \x01(\x0e2\x14.task.TaskOrderFieldR\x05field\x121\n" +
+	"\tdirection\x18\x02 \x01(\x0e2\x13.task.SortDirectionR\tdirection\"8\n" +
 	"\x11CreateTaskRequest\x12#\n" +
 	"\x05input\x18\x01 \x01(\v2\r.task.NewTaskR\x05input\";\n" +
 	"\x11UpdateTaskRequest\x12&\n" +
@@ -991,9 +3591,169 @@ const file_grpc_proto_todo_proto_rawDesc = "" +
 	"\x12DeleteTaskResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\">\n" +
 	"\x14CreateSubTaskRequest\x12&\n" +
-	"\x05input\x18\x01 \x01(\v2\x10.task.NewSubTaskR\x05input2\x85\x03\n" +
+	"\x05input\x18\x01 \x01(\v2\x10.task.NewSubTaskR\x05input\"A\n" +
+	"\x14UpdateSubTaskRequest\x12)\n" +
+	"\x05input\x18\x01 \x01(\v2\x13.task.UpdateSubTaskR\x05input\"\x1b\n" +
+	"\tSubTaskId\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\"1\n" +
+	"\x15DeleteSubTaskResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"S\n" +
+	"\x16ReorderSubTasksRequest\x12\x17\n" +
+	"\atask_id\x18\x01 \x01(\x04R\x06taskId\x12 \n" +
+	"\fsub_task_ids\x18\x02 \x03(\x04R\n" +
+	"subTaskIds\"\x97\x01\n" +
+	"\tTaskEvent\x12'\n" +
+	"\x04type\x18\x01 \x01(\x0e2\x13.task.TaskEventTypeR\x04type\x12\x17\n" +
+	"\atask_id\x18\x02 \x01(\x04R\x06taskId\x12\x1e\n" +
+	"\x04task\x18\x03 \x01(\v2\n" +
+	".task.TaskR\x04task\x12(\n" +
+	"\bsub_task\x18\x04 \x01(\v2\r.task.SubTaskR\asubTask\">\n" +
+	"\x11WatchTasksRequest\x12)\n" +
+	"\x05types\x18\x01 \x03(\x0e2\x13.task.TaskEventTypeR\x05types\"f\n" +
+	"\x12SearchTasksRequest\x12\x14\n" +
+	"\x05query\x18\x01 \x01(\tR\x05query\x12\x1b\n" +
+	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x03 \x01(\tR\tpageToken\"A\n" +
+	"\x0fSearchHighlight\x12\x14\n" +
+	"\x05field\x18\x01 \x01(\tR\x05field\x12\x18\n" +
+	"\asnippet\x18\x02 \x01(\tR\asnippet\"\x7f\n" +
+	"\x10TaskSearchResult\x12\x1e\n" +
+	"\x04task\x18\x01 \x01(\v2\n" +
+	".task.TaskR\x04task\x12\x14\n" +
+	"\x05score\x18\x02 \x01(\x01R\x05score\x125\n" +
+	"\n" +
+	"highlights\x18\x03 \x03(\v2\x15.task.SearchHighlightR\n" +
+	"highlights\"\xaa\x01\n" +
+	"\x13SearchTasksResponse\x120\n" +
+	"\aresults\x18\x01 \x03(\v2\x16.task.TaskSearchResultR\aresults\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\x12\x1f\n" +
+	"\vtotal_count\x18\x03 \x01(\x05R\n" +
+	"totalCount\x12\x18\n" +
+	"\acursors\x18\x04 \x03(\tR\acursors\"\xeb\x02\n" +
+	"\x10TaskHistoryEntry\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\x12\x17\n" +
+	"\atask_id\x18\x02 \x01(\x04R\x06taskId\x12#\n" +
+	"\vsub_task_id\x18\x03 \x01(\x04H\x00R\tsubTaskId\x88\x01\x01\x12\x17\n" +
+	"\auser_id\x18\x04 \x01(\x04R\x06userId\x12/\n" +
+	"\x06action\x18\x05 \x01(\x0e2\x17.task.TaskHistoryActionR\x06action\x12\x14\n" +
+	"\x05field\x18\x06 \x01(\tR\x05field\x12 \n" +
+	"\told_value\x18\a \x01(\tH\x01R\boldValue\x88\x01\x01\x12 \n" +
+	"\tnew_value\x18\b \x01(\tH\x02R\bnewValue\x88\x01\x01\x129\n" +
+	"\n" +
+	"created_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAtB\x0e\n" +
+	"\f_sub_task_idB\f\n" +
+	"\n" +
+	"_old_valueB\f\n" +
+	"\n" +
+	"_new_value\"?\n" +
+	"\vTaskHistory\x120\n" +
+	"\aentries\x18\x01 \x03(\v2\x16.task.TaskHistoryEntryR\aentries\"Q\n" +
+	"\x0eBulkTaskTarget\x12\x10\n" +
+	"\x03ids\x18\x01 \x03(\x04R\x03ids\x12-\n" +
+	"\x06filter\x18\x02 \x01(\v2\x15.task.GetTasksRequestR\x06filter\"\xc2\x02\n" +
+	"\x16BulkUpdateTasksRequest\x12,\n" +
+	"\x06target\x18\x01 \x01(\v2\x14.task.BulkTaskTargetR\x06target\x12!\n" +
+	"\tcompleted\x18\x02 \x01(\x05H\x00R\tcompleted\x88\x01\x01\x12$\n" +
+	"\vcategory_id\x18\x03 \x01(\x04H\x01R\n" +
+	"categoryId\x88\x01\x01\x12:\n" +
+	"\bdue_date\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampH\x02R\adueDate\x88\x01\x01\x122\n" +
+	"\x13shift_due_date_days\x18\x05 \x01(\x05H\x03R\x10shiftDueDateDays\x88\x01\x01B\f\n" +
+	"\n" +
+	"_completedB\x0e\n" +
+	"\f_category_idB\v\n" +
+	"\t_due_dateB\x16\n" +
+	"\x14_shift_due_date_days\"F\n" +
+	"\x16BulkDeleteTasksRequest\x12,\n" +
+	"\x06target\x18\x01 \x01(\v2\x14.task.BulkTaskTargetR\x06target\"t\n" +
+	"\x0eBulkTaskResult\x12\x17\n" +
+	"\atask_id\x18\x01 \x01(\x04R\x06taskId\x12)\n" +
+	"\x05error\x18\x02 \x01(\v2\x13.task.BulkTaskErrorR\x05error\x12\x1e\n" +
+	"\x04task\x18\x03 \x01(\v2\n" +
+	".task.TaskR\x04task\"=\n" +
+	"\rBulkTaskError\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\x05R\x04code\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"]\n" +
+	"\x11BulkTasksResponse\x12\x18\n" +
+	"\aapplied\x18\x01 \x01(\bR\aapplied\x12.\n" +
+	"\aresults\x18\x02 \x03(\v2\x14.task.BulkTaskResultR\aresults\"a\n" +
+	"\x13GetTaskStatsRequest\x12\x14\n" +
+	"\x05weeks\x18\x01 \x01(\x05R\x05weeks\x12$\n" +
+	"\vcategory_id\x18\x02 \x01(\x04H\x00R\n" +
+	"categoryId\x88\x01\x01B\x0e\n" +
+	"\f_category_id\"\xe4\x02\n" +
+	"\tTaskStats\x12.\n" +
+	"\x04from\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\x04from\x12*\n" +
+	"\x02to\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x02to\x127\n" +
+	"\n" +
+	"categories\x18\x03 \x03(\v2\x17.task.CategoryTaskCountR\n" +
+	"categories\x12\x18\n" +
+	"\aoverdue\x18\x04 \x01(\x05R\aoverdue\x12F\n" +
+	"\x11completed_per_day\x18\x05 \x03(\v2\x1a.task.DailyCompletionCountR\x0fcompletedPerDay\x12A\n" +
+	"\x1aaverage_completion_seconds\x18\x06 \x01(\x01H\x00R\x18averageCompletionSeconds\x88\x01\x01B\x1d\n" +
+	"\x1b_average_completion_seconds\"f\n" +
+	"\x11CategoryTaskCount\x12\x1f\n" +
+	"\vcategory_id\x18\x01 \x01(\x04R\n" +
+	"categoryId\x12\x12\n" +
+	"\x04open\x18\x02 \x01(\x05R\x04open\x12\x1c\n" +
+	"\tcompleted\x18\x03 \x01(\x05R\tcompleted\"d\n" +
+	"\x14DailyCompletionCount\x12.\n" +
+	"\x04date\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\x04date\x12\x1c\n" +
+	"\tcompleted\x18\x02 \x01(\x05R\tcompleted*l\n" +
+	"\bPriority\x12\x11\n" +
+	"\rPRIORITY_NONE\x10\x00\x12\x10\n" +
+	"\fPRIORITY_LOW\x10\x01\x12\x13\n" +
+	"\x0fPRIORITY_MEDIUM\x10\x02\x12\x11\n" +
+	"\rPRIORITY_HIGH\x10\x03\x12\x13\n" +
+	"\x0fPRIORITY_URGENT\x10\x04*\xbf\x01\n" +
+	"\x13RecurrenceFrequency\x12$\n" +
+	" RECURRENCE_FREQUENCY_UNSPECIFIED\x10\x00\x12\x1e\n" +
+	"\x1aRECURRENCE_FREQUENCY_DAILY\x10\x01\x12\x1f\n" +
+	"\x1bRECURRENCE_FREQUENCY_WEEKLY\x10\x02\x12 \n" +
+	"\x1cRECURRENCE_FREQUENCY_MONTHLY\x10\x03\x12\x1f\n" +
+	"\x1bRECURRENCE_FREQUENCY_YEARLY\x10\x04*\xb6\x01\n" +
+	"\aWeekday\x12\x17\n" +
+	"\x13WEEKDAY_UNSPECIFIED\x10\x00\x12\x12\n" +
+	"\x0eWEEKDAY_MONDAY\x10\x01\x12\x13\n" +
+	"\x0fWEEKDAY_TUESDAY\x10\x02\x12\x15\n" +
+	"\x11WEEKDAY_WEDNESDAY\x10\x03\x12\x14\n" +
+	"\x10WEEKDAY_THURSDAY\x10\x04\x12\x12\n" +
+	"\x0eWEEKDAY_FRIDAY\x10\x05\x12\x14\n" +
+	"\x10WEEKDAY_SATURDAY\x10\x06\x12\x12\n" +
+	"\x0eWEEKDAY_SUNDAY\x10\a*0\n" +
+	"\bTagMatch\x12\x11\n" +
+	"\rTAG_MATCH_ANY\x10\x00\x12\x11\n" +
+	"\rTAG_MATCH_ALL\x10\x01*\xf1\x01\n" +
+	"\x0eTaskOrderField\x12 \n" +
+	"\x1cTASK_ORDER_FIELD_UNSPECIFIED\x10\x00\x12\x1d\n" +
+	"\x19TASK_ORDER_FIELD_DUE_DATE\x10\x01\x12\x1f\n" +
+	"\x1bTASK_ORDER_FIELD_CREATED_AT\x10\x02\x12\x1f\n" +
+	"\x1bTASK_ORDER_FIELD_UPDATED_AT\x10\x03\x12\x1a\n" +
+	"\x16TASK_ORDER_FIELD_TITLE\x10\x04\x12!\n" +
+	"\x1dTASK_ORDER_FIELD_COMPLETED_AT\x10\x05\x12\x1d\n" +
+	"\x19TASK_ORDER_FIELD_PRIORITY\x10\x06*@\n" +
+	"\rSortDirection\x12\x16\n" +
+	"\x12SORT_DIRECTION_ASC\x10\x00\x12\x17\n" +
+	"\x13SORT_DIRECTION_DESC\x10\x01*\xad\x01\n" +
+	"\rTaskEventType\x12\x1f\n" +
+	"\x1bTASK_EVENT_TYPE_UNSPECIFIED\x10\x00\x12\x1b\n" +
+	"\x17TASK_EVENT_TYPE_CREATED\x10\x01\x12\x1b\n" +
+	"\x17TASK_EVENT_TYPE_UPDATED\x10\x02\x12\x1b\n" +
+	"\x17TASK_EVENT_TYPE_DELETED\x10\x03\x12$\n" +
+	" TASK_EVENT_TYPE_SUB_TASK_TOGGLED\x10\x04*\xdd\x01\n" +
+	"\x11TaskHistoryAction\x12#\n" +
+	"\x1fTASK_HISTORY_ACTION_UNSPECIFIED\x10\x00\x12\x1f\n" +
+	"\x1bTASK_HISTORY_ACTION_CREATED\x10\x01\x12\x1f\n" +
+	"\x1bTASK_HISTORY_ACTION_UPDATED\x10\x02\x12\x1f\n" +
+	"\x1bTASK_HISTORY_ACTION_DELETED\x10\x03\x12 \n" +
+	"\x1cTASK_HISTORY_ACTION_RESTORED\x10\x04\x12\x1e\n" +
+	"\x1aTASK_HISTORY_ACTION_PURGED\x10\x052\xef\n" +
+	"\n" +
 	"\vTaskService\x121\n" +
-	"\bGetTasks\x12\x15.task.GetTasksRequest\x1a\x0e.task.TaskList\x121\n" +
+	"\bGetTasks\x12\x15.task.GetTasksRequest\x1a\x0e.task.TaskList\x12#\n" +
+	"\aGetTask\x12\f.task.TaskId\x1a\n" +
+	".task.Task\x12;\n" +
+	"\rBatchGetTasks\x12\x1a.task.BatchGetTasksRequest\x1a\x0e.task.TaskList\x121\n" +
 	"\n" +
 	"CreateTask\x12\x17.task.CreateTaskRequest\x1a\n" +
 	".task.Task\x121\n" +
@@ -1001,10 +3761,28 @@ const file_grpc_proto_todo_proto_rawDesc = "" +
 	"UpdateTask\x12\x17.task.UpdateTaskRequest\x1a\n" +
 	".task.Task\x124\n" +
 	"\n" +
-	"DeleteTask\x12\f.task.TaskId\x1a\x18.task.DeleteTaskResponse\x12:\n" +
+	"DeleteTask\x12\f.task.TaskId\x1a\x18.task.DeleteTaskResponse\x12H\n" +
+	"\x0fBulkUpdateTasks\x12\x1c.task.BulkUpdateTasksRequest\x1a\x17.task.BulkTasksResponse\x12H\n" +
+	"\x0fBulkDeleteTasks\x12\x1c.task.BulkDeleteTasksRequest\x1a\x17.task.BulkTasksResponse\x12:\n" +
+	"\x10ListDeletedTasks\x12\x16.google.protobuf.Empty\x1a\x0e.task.TaskList\x12C\n" +
+	"\x19ListTasksNeedingAttention\x12\x16.google.protobuf.Empty\x1a\x0e.task.TaskList\x12'\n" +
+	"\vRestoreTask\x12\f.task.TaskId\x1a\n" +
+	".task.Task\x123\n" +
+	"\tPurgeTask\x12\f.task.TaskId\x1a\x18.task.DeleteTaskResponse\x12,\n" +
+	"\n" +
+	"GetSubTask\x12\x0f.task.SubTaskId\x1a\r.task.SubTask\x12:\n" +
 	"\rCreateSubTask\x12\x1a.task.CreateSubTaskRequest\x1a\r.task.SubTask\x12:\n" +
-	"\rToggleSubTask\x12\x1a.task.ToggleSubTaskRequest\x1a\r.task.SubTask\x12/\n" +
-	"\fListSubTasks\x12\f.task.TaskId\x1a\x11.task.SubTaskListB\x05Z\x03/pbb\x06proto3"
+	"\rUpdateSubTask\x12\x1a.task.UpdateSubTaskRequest\x1a\r.task.SubTask\x12:\n" +
+	"\rToggleSubTask\x12\x1a.task.ToggleSubTaskRequest\x1a\r.task.SubTask\x12=\n" +
+	"\rDeleteSubTask\x12\x0f.task.SubTaskId\x1a\x1b.task.DeleteSubTaskResponse\x12B\n" +
+	"\x0fReorderSubTasks\x12\x1c.task.ReorderSubTasksRequest\x1a\x11.task.SubTaskList\x12/\n" +
+	"\fListSubTasks\x12\f.task.TaskId\x1a\x11.task.SubTaskList\x128\n" +
+	"\x11BatchListSubTasks\x12\r.task.TaskIds\x1a\x14.task.SubTasksByTask\x128\n" +
+	"\n" +
+	"WatchTasks\x12\x17.task.WatchTasksRequest\x1a\x0f.task.TaskEvent0\x01\x12B\n" +
+	"\vSearchTasks\x12\x18.task.SearchTasksRequest\x1a\x19.task.SearchTasksResponse\x122\n" +
+	"\x0fListTaskHistory\x12\f.task.TaskId\x1a\x11.task.TaskHistory\x12:\n" +
+	"\fGetTaskStats\x12\x19.task.GetTaskStatsRequest\x1a\x0f.task.TaskStatsB\x05Z\x03/pbb\x06proto3"
 
 var (
 	file_grpc_proto_todo_proto_rawDescOnce sync.Once
@@ -1018,64 +3796,183 @@ func file_grpc_proto_todo_proto_rawDescGZIP() []byte {
 	return file_grpc_proto_todo_proto_rawDescData
 }
 
-var file_grpc_proto_todo_proto_msgTypes = make([]protoimpl.MessageInfo, 14)
+var file_grpc_proto_todo_proto_enumTypes = make([]protoimpl.EnumInfo, 8)
+var file_grpc_proto_todo_proto_msgTypes = make([]protoimpl.MessageInfo, 45)
 var file_grpc_proto_todo_proto_goTypes = []any{
-	(*Task)(nil),                  // 0: task.Task
-	(*NewTask)(nil),               // 1: task.NewTask
-	(*UpdateTask)(nil),            // 2: task.UpdateTask
-	(*TaskList)(nil),              // 3: task.TaskList
-	(*SubTask)(nil),               // 4: task.SubTask
-	(*NewSubTask)(nil),            // 5: task.NewSubTask
-	(*ToggleSubTaskRequest)(nil),  // 6: task.ToggleSubTaskRequest
-	(*SubTaskList)(nil),           // 7: task.SubTaskList
-	(*TaskId)(nil),                // 8: task.TaskId
-	(*GetTasksRequest)(nil),       // 9: task.GetTasksRequest
-	(*CreateTaskRequest)(nil),     // 10: task.CreateTaskRequest
-	(*UpdateTaskRequest)(nil),     // 11: task.UpdateTaskRequest
-	(*DeleteTaskResponse)(nil),    // 12: task.DeleteTaskResponse
-	(*CreateSubTaskRequest)(nil),  // 13: task.CreateSubTaskRequest
-	(*timestamppb.Timestamp)(nil), // 14: google.protobuf.Timestamp
+	(Priority)(0),                  // 0: task.Priority
+	(RecurrenceFrequency)(0),       // 1: task.RecurrenceFrequency
+	(Weekday)(0),                   // 2: task.Weekday
+	(TagMatch)(0),                  // 3: task.TagMatch
+	(TaskOrderField)(0),            // 4: task.TaskOrderField
+	(SortDirection)(0),             // 5: task.SortDirection
+	(TaskEventType)(0),             // 6: task.TaskEventType
+	(TaskHistoryAction)(0),         // 7: task.TaskHistoryAction
+	(*Task)(nil),                   // 8: task.Task
+	(*Recurrence)(nil),             // 9: task.Recurrence
+	(*NewTask)(nil),                // 10: task.NewTask
+	(*NewTaskSubTask)(nil),         // 11: task.NewTaskSubTask
+	(*UpdateTask)(nil),             // 12: task.UpdateTask
+	(*TagIdList)(nil),              // 13: task.TagIdList
+	(*TaskList)(nil),               // 14: task.TaskList
+	(*SubTask)(nil),                // 15: task.SubTask
+	(*NewSubTask)(nil),             // 16: task.NewSubTask
+	(*UpdateSubTask)(nil),          // 17: task.UpdateSubTask
+	(*ToggleSubTaskRequest)(nil),   // 18: task.ToggleSubTaskRequest
+	(*SubTaskList)(nil),            // 19: task.SubTaskList
+	(*TaskId)(nil),                 // 20: task.TaskId
+	(*TaskIds)(nil),                // 21: task.TaskIds
+	(*BatchGetTasksRequest)(nil),   // 22: task.BatchGetTasksRequest
+	(*SubTasksByTask)(nil),         // 23: task.SubTasksByTask
+	(*GetTasksRequest)(nil),        // 24: task.GetTasksRequest
+	(*TaskOrder)(nil),              // 25: task.TaskOrder
+	(*CreateTaskRequest)(nil),      // 26: task.CreateTaskRequest
+	(*UpdateTaskRequest)(nil),      // 27: task.UpdateTaskRequest
+	(*DeleteTaskResponse)(nil),     // 28: task.DeleteTaskResponse
+	(*CreateSubTaskRequest)(nil),   // 29: task.CreateSubTaskRequest
+	(*UpdateSubTaskRequest)(nil),   // 30: task.UpdateSubTaskRequest
+	(*SubTaskId)(nil),              // 31: task.SubTaskId
+	(*DeleteSubTaskResponse)(nil),  // 32: task.DeleteSubTaskResponse
+	(*ReorderSubTasksRequest)(nil), // 33: task.ReorderSubTasksRequest
+	(*TaskEvent)(nil),              // 34: task.TaskEvent
+	(*WatchTasksRequest)(nil),      // 35: task.WatchTasksRequest
+	(*SearchTasksRequest)(nil),     // 36: task.SearchTasksRequest
+	(*SearchHighlight)(nil),        // 37: task.SearchHighlight
+	(*TaskSearchResult)(nil),       // 38: task.TaskSearchResult
+	(*SearchTasksResponse)(nil),    // 39: task.SearchTasksResponse
+	(*TaskHistoryEntry)(nil),       // 40: task.TaskHistoryEntry
+	(*TaskHistory)(nil),            // 41: task.TaskHistory
+	(*BulkTaskTarget)(nil),         // 42: task.BulkTaskTarget
+	(*BulkUpdateTasksRequest)(nil), // 43: task.BulkUpdateTasksRequest
+	(*BulkDeleteTasksRequest)(nil), // 44: task.BulkDeleteTasksRequest
+	(*BulkTaskResult)(nil),         // 45: task.BulkTaskResult
+	(*BulkTaskError)(nil),          // 46: task.BulkTaskError
+	(*BulkTasksResponse)(nil),      // 47: task.BulkTasksResponse
+	(*GetTaskStatsRequest)(nil),    // 48: task.GetTaskStatsRequest
+	(*TaskStats)(nil),              // 49: task.TaskStats
+	(*CategoryTaskCount)(nil),      // 50: task.CategoryTaskCount
+	(*DailyCompletionCount)(nil),   // 51: task.DailyCompletionCount
+	nil,                            // 52: task.SubTasksByTask.SubTasksEntry
+	(*timestamppb.Timestamp)(nil),  // 53: google.protobuf.Timestamp
+	(*emptypb.Empty)(nil),          // 54: google.protobuf.Empty
 }
 var file_grpc_proto_todo_proto_depIdxs = []int32{
-	14, // 0: task.Task.created_at:type_name -> google.protobuf.Timestamp
-	14, // 1: task.Task.updated_at:type_name -> google.protobuf.Timestamp
-	14, // 2: task.Task.due_date:type_name -> google.protobuf.Timestamp
-	14, // 3: task.Task.completed_at:type_name -> google.protobuf.Timestamp
-	4,  // 4: task.Task.sub_tasks:type_name -> task.SubTask
-	14, // 5: task.NewTask.due_date:type_name -> google.protobuf.Timestamp
-	14, // 6: task.UpdateTask.due_date:type_name -> google.protobuf.Timestamp
-	14, // 7: task.UpdateTask.completed_at:type_name -> google.protobuf.Timestamp
-	0,  // 8: task.TaskList.tasks:type_name -> task.Task
-	14, // 9: task.SubTask.completed_at:type_name -> google.protobuf.Timestamp
-	14, // 10: task.SubTask.due_date:type_name -> google.protobuf.Timestamp
-	14, // 11: task.SubTask.created_at:type_name -> google.protobuf.Timestamp
-	14, // 12: task.SubTask.updated_at:type_name -> google.protobuf.Timestamp
-	14, // 13: task.NewSubTask.due_date:type_name -> google.protobuf.Timestamp
-	4,  // 14: task.SubTaskList.sub_tasks:type_name -> task.SubTask
-	14, // 15: task.GetTasksRequest.due_date_start:type_name -> google.protobuf.Timestamp
-	14, // 16: task.GetTasksRequest.due_date_end:type_name -> google.protobuf.Timestamp
-	1,  // 17: task.CreateTaskRequest.input:type_name -> task.NewTask
-	2,  // 18: task.UpdateTaskRequest.input:type_name -> task.UpdateTask
-	5,  // 19: task.CreateSubTaskRequest.input:type_name -> task.NewSubTask
-	9,  // 20: task.TaskService.GetTasks:input_type -> task.GetTasksRequest
-	10, // 21: task.TaskService.CreateTask:input_type -> task.CreateTaskRequest
-	11, // 22: task.TaskService.UpdateTask:input_type -> task.UpdateTaskRequest
-	8,  // 23: task.TaskService.DeleteTask:input_type -> task.TaskId
-	13, // 24: task.TaskService.CreateSubTask:input_type -> task.CreateSubTaskRequest
-	6,  // 25: task.TaskService.ToggleSubTask:input_type -> task.ToggleSubTaskRequest
-	8,  // 26: task.TaskService.ListSubTasks:input_type -> task.TaskId
-	3,  // 27: task.TaskService.GetTasks:output_type -> task.TaskList
-	0,  // 28: task.TaskService.CreateTask:output_type -> task.Task
-	0,  // 29: task.TaskService.UpdateTask:output_type -> task.Task
-	12, // 30: task.TaskService.DeleteTask:output_type -> task.DeleteTaskResponse
-	4,  // 31: task.TaskService.CreateSubTask:output_type -> task.SubTask
-	4,  // 32: task.TaskService.ToggleSubTask:output_type -> task.SubTask
-	7,  // 33: task.TaskService.ListSubTasks:output_type -> task.SubTaskList
-	27, // [27:34] is the sub-list for method output_type
-	20, // [20:27] is the sub-list for method input_type
-	20, // [20:20] is the sub-list for extension type_name
-	20, // [20:20] is the sub-list for extension extendee
-	0,  // [0:20] is the sub-list for field type_name
+	53, // 0: task.Task.created_at:type_name -> google.protobuf.Timestamp
+	53, // 1: task.Task.updated_at:type_name -> google.protobuf.Timestamp
+	53, // 2: task.Task.due_date:type_name -> google.protobuf.Timestamp
+	53, // 3: task.Task.completed_at:type_name -> google.protobuf.Timestamp
+	15, // 4: task.Task.sub_tasks:type_name -> task.SubTask
+	53, // 5: task.Task.deleted_at:type_name -> google.protobuf.Timestamp
+	9,  // 6: task.Task.recurrence:type_name -> task.Recurrence
+	0,  // 7: task.Task.priority:type_name -> task.Priority
+	1,  // 8: task.Recurrence.frequency:type_name -> task.RecurrenceFrequency
+	2,  // 9: task.Recurrence.weekdays:type_name -> task.Weekday
+	53, // 10: task.Recurrence.until:type_name -> google.protobuf.Timestamp
+	53, // 11: task.NewTask.due_date:type_name -> google.protobuf.Timestamp
+	9,  // 12: task.NewTask.recurrence:type_name -> task.Recurrence
+	0,  // 13: task.NewTask.priority:type_name -> task.Priority
+	11, // 14: task.NewTask.sub_tasks:type_name -> task.NewTaskSubTask
+	53, // 15: task.NewTaskSubTask.due_date:type_name -> google.protobuf.Timestamp
+	53, // 16: task.UpdateTask.due_date:type_name -> google.protobuf.Timestamp
+	53, // 17: task.UpdateTask.completed_at:type_name -> google.protobuf.Timestamp
+	9,  // 18: task.UpdateTask.recurrence:type_name -> task.Recurrence
+	13, // 19: task.UpdateTask.tag_ids:type_name -> task.TagIdList
+	0,  // 20: task.UpdateTask.priority:type_name -> task.Priority
+	8,  // 21: task.TaskList.tasks:type_name -> task.Task
+	53, // 22: task.SubTask.completed_at:type_name -> google.protobuf.Timestamp
+	53, // 23: task.SubTask.due_date:type_name -> google.protobuf.Timestamp
+	53, // 24: task.SubTask.created_at:type_name -> google.protobuf.Timestamp
+	53, // 25: task.SubTask.updated_at:type_name -> google.protobuf.Timestamp
+	53, // 26: task.NewSubTask.due_date:type_name -> google.protobuf.Timestamp
+	53, // 27: task.UpdateSubTask.due_date:type_name -> google.protobuf.Timestamp
+	15, // 28: task.SubTaskList.sub_tasks:type_name -> task.SubTask
+	52, // 29: task.SubTasksByTask.sub_tasks:type_name -> task.SubTasksByTask.SubTasksEntry
+	53, // 30: task.GetTasksRequest.due_date_start:type_name -> google.protobuf.Timestamp
+	53, // 31: task.GetTasksRequest.due_date_end:type_name -> google.protobuf.Timestamp
+	3,  // 32: task.GetTasksRequest.tag_match:type_name -> task.TagMatch
+	25, // 33: task.GetTasksRequest.order_by:type_name -> task.TaskOrder
+	0,  // 34: task.GetTasksRequest.min_priority:type_name -> task.Priority
+	4,  // 35: task.TaskOrder.field:type_name -> task.TaskOrderField
+	5,  // 36: task.TaskOrder.direction:type_name -> task.SortDirection
+	10, // 37: task.CreateTaskRequest.input:type_name -> task.NewTask
+	12, // 38: task.UpdateTaskRequest.input:type_name -> task.UpdateTask
+	16, // 39: task.CreateSubTaskRequest.input:type_name -> task.NewSubTask
+	17, // 40: task.UpdateSubTaskRequest.input:type_name -> task.UpdateSubTask
+	6,  // 41: task.TaskEvent.type:type_name -> task.TaskEventType
+	8,  // 42: task.TaskEvent.task:type_name -> task.Task
+	15, // 43: task.TaskEvent.sub_task:type_name -> task.SubTask
+	6,  // 44: task.WatchTasksRequest.types:type_name -> task.TaskEventType
+	8,  // 45: task.TaskSearchResult.task:type_name -> task.Task
+	37, // 46: task.TaskSearchResult.highlights:type_name -> task.SearchHighlight
+	38, // 47: task.SearchTasksResponse.results:type_name -> task.TaskSearchResult
+	7,  // 48: task.TaskHistoryEntry.action:type_name -> task.TaskHistoryAction
+	53, // 49: task.TaskHistoryEntry.created_at:type_name -> google.protobuf.Timestamp
+	40, // 50: task.TaskHistory.entries:type_name -> task.TaskHistoryEntry
+	24, // 51: task.BulkTaskTarget.filter:type_name -> task.GetTasksRequest
+	42, // 52: task.BulkUpdateTasksRequest.target:type_name -> task.BulkTaskTarget
+	53, // 53: task.BulkUpdateTasksRequest.due_date:type_name -> google.protobuf.Timestamp
+	42, // 54: task.BulkDeleteTasksRequest.target:type_name -> task.BulkTaskTarget
+	46, // 55: task.BulkTaskResult.error:type_name -> task.BulkTaskError
+	8,  // 56: task.BulkTaskResult.task:type_name -> task.Task
+	45, // 57: task.BulkTasksResponse.results:type_name -> task.BulkTaskResult
+	53, // 58: task.TaskStats.from:type_name -> google.protobuf.Timestamp
+	53, // 59: task.TaskStats.to:type_name -> google.protobuf.Timestamp
+	50, // 60: task.TaskStats.categories:type_name -> task.CategoryTaskCount
+	51, // 61: task.TaskStats.completed_per_day:type_name -> task.DailyCompletionCount
+	53, // 62: task.DailyCompletionCount.date:type_name -> google.protobuf.Timestamp
+	19, // 63: task.SubTasksByTask.SubTasksEntry.value:type_name -> task.SubTaskList
+	24, // 64: task.TaskService.GetTasks:input_type -> task.GetTasksRequest
+	20, // 65: task.TaskService.GetTask:input_type -> task.TaskId
+	22, // 66: task.TaskService.BatchGetTasks:input_type -> task.BatchGetTasksRequest
+	26, // 67: task.TaskService.CreateTask:input_type -> task.CreateTaskRequest
+	27, // 68: task.TaskService.UpdateTask:input_type -> task.UpdateTaskRequest
+	20, // 69: task.TaskService.DeleteTask:input_type -> task.TaskId
+	43, // 70: task.TaskService.BulkUpdateTasks:input_type -> task.BulkUpdateTasksRequest
+	44, // 71: task.TaskService.BulkDeleteTasks:input_type -> task.BulkDeleteTasksRequest
+	54, // 72: task.TaskService.ListDeletedTasks:input_type -> google.protobuf.Empty
+	54, // 73: task.TaskService.ListTasksNeedingAttention:input_type -> google.protobuf.Empty
+	20, // 74: task.TaskService.RestoreTask:input_type -> task.TaskId
+	20, // 75: task.TaskService.PurgeTask:input_type -> task.TaskId
+	31, // 76: task.TaskService.GetSubTask:input_type -> task.SubTaskId
+	29, // 77: task.TaskService.CreateSubTask:input_type -> task.CreateSubTaskRequest
+	30, // 78: task.TaskService.UpdateSubTask:input_type -> task.UpdateSubTaskRequest
+	18, // 79: task.TaskService.ToggleSubTask:input_type -> task.ToggleSubTaskRequest
+	31, // 80: task.TaskService.DeleteSubTask:input_type -> task.SubTaskId
+	33, // 81: task.TaskService.ReorderSubTasks:input_type -> task.ReorderSubTasksRequest
+	20, // 82: task.TaskService.ListSubTasks:input_type -> task.TaskId
+	21, // 83: task.TaskService.BatchListSubTasks:input_type -> task.TaskIds
+	35, // 84: task.TaskService.WatchTasks:input_type -> task.WatchTasksRequest
+	36, // 85: task.TaskService.SearchTasks:input_type -> task.SearchTasksRequest
+	20, // 86: task.TaskService.ListTaskHistory:input_type -> task.TaskId
+	48, // 87: task.TaskService.GetTaskStats:input_type -> task.GetTaskStatsRequest
+	14, // 88: task.TaskService.GetTasks:output_type -> task.TaskList
+	8,  // 89: task.TaskService.GetTask:output_type -> task.Task
+	14, // 90: task.TaskService.BatchGetTasks:output_type -> task.TaskList
+	8,  // 91: task.TaskService.CreateTask:output_type -> task.Task
+	8,  // 92: task.TaskService.UpdateTask:output_type -> task.Task
+	28, // 93: task.TaskService.DeleteTask:output_type -> task.DeleteTaskResponse
+	47, // 94: task.TaskService.BulkUpdateTasks:output_type -> task.BulkTasksResponse
+	47, // 95: task.TaskService.BulkDeleteTasks:output_type -> task.BulkTasksResponse
+	14, // 96: task.TaskService.ListDeletedTasks:output_type -> task.TaskList
+	14, // 97: task.TaskService.ListTasksNeedingAttention:output_type -> task.TaskList
+	8,  // 98: task.TaskService.RestoreTask:output_type -> task.Task
+	28, // 99: task.TaskService.PurgeTask:output_type -> task.DeleteTaskResponse
+	15, // 100: task.TaskService.GetSubTask:output_type -> task.SubTask
+	15, // 101: task.TaskService.CreateSubTask:output_type -> task.SubTask
+	15, // 102: task.TaskService.UpdateSubTask:output_type -> task.SubTask
+	15, // 103: task.TaskService.ToggleSubTask:output_type -> task.SubTask
+	32, // 104: task.TaskService.DeleteSubTask:output_type -> task.DeleteSubTaskResponse
+	19, // 105: task.TaskService.ReorderSubTasks:output_type -> task.SubTaskList
+	19, // 106: task.TaskService.ListSubTasks:output_type -> task.SubTaskList
+	23, // 107: task.TaskService.BatchListSubTasks:output_type -> task.SubTasksByTask
+	34, // 108: task.TaskService.WatchTasks:output_type -> task.TaskEvent
+	39, // 109: task.TaskService.SearchTasks:output_type -> task.SearchTasksResponse
+	41, // 110: task.TaskService.ListTaskHistory:output_type -> task.TaskHistory
+	49, // 111: task.TaskService.GetTaskStats:output_type -> task.TaskStats
+	88, // [88:112] is the sub-list for method output_type
+	64, // [64:88] is the sub-list for method input_type
+	64, // [64:64] is the sub-list for extension type_name
+	64, // [64:64] is the sub-list for extension extendee
+	0,  // [0:64] is the sub-list for field type_name
 }
 
 func init() { file_grpc_proto_todo_proto_init() }
@@ -1083,20 +3980,27 @@ func file_grpc_proto_todo_proto_init() {
 	if File_grpc_proto_todo_proto != nil {
 		return
 	}
-	file_grpc_proto_todo_proto_msgTypes[2].OneofWrappers = []any{}
+	file_grpc_proto_todo_proto_msgTypes[4].OneofWrappers = []any{}
 	file_grpc_proto_todo_proto_msgTypes[9].OneofWrappers = []any{}
+	file_grpc_proto_todo_proto_msgTypes[10].OneofWrappers = []any{}
+	file_grpc_proto_todo_proto_msgTypes[16].OneofWrappers = []any{}
+	file_grpc_proto_todo_proto_msgTypes[32].OneofWrappers = []any{}
+	file_grpc_proto_todo_proto_msgTypes[35].OneofWrappers = []any{}
+	file_grpc_proto_todo_proto_msgTypes[40].OneofWrappers = []any{}
+	file_grpc_proto_todo_proto_msgTypes[41].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_grpc_proto_todo_proto_rawDesc), len(file_grpc_proto_todo_proto_rawDesc)),
-			NumEnums:      0,
-			NumMessages:   14,
+			NumEnums:      8,
+			NumMessages:   45,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_grpc_proto_todo_proto_goTypes,
 		DependencyIndexes: file_grpc_proto_todo_proto_depIdxs,
+		EnumInfos:         file_grpc_proto_todo_proto_enumTypes,
 		MessageInfos:      file_grpc_proto_todo_proto_msgTypes,
 	}.Build()
 	File_grpc_proto_todo_proto = out.File
@@ -0,0 +1,182 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"backend/domain/apperr"
+	"backend/domain/model"
+	"backend/domain/repository"
+)
+
+// maxBulkTasks caps how many tasks a single bulk operation may change.
+const maxBulkTasks = 500
+
+// errBulkRejected rolls a bulk operation back once one of its tasks has failed.
+var errBulkRejected = errors.New("bulk operation rejected")
+
+// BulkTaskTarget picks the tasks of a bulk operation: the tasks listed in IDs, or every task
+// matching Filter. Exactly one of them must be set.
+type BulkTaskTarget struct {
+	IDs    []uint64
+	Filter *repository.TaskFilter
+}
+
+// BulkUpdateTasks applies change to every targeted task in one transaction.
+func (uc *taskUseCase) BulkUpdateTasks(ctx context.Context, target BulkTaskTarget, change model.BulkTaskChange) (*model.BulkTaskResult, error) {
+	target.IDs = uniqueIDs(target.IDs)
+
+	var v violations
+	v.checkBulkTarget(target)
+	if change.Completed == nil && change.CategoryID == nil && change.DueDate == nil && change.ShiftDueDateDays == nil {
+		v.add("change", "must set at least one field")
+	}
+	if change.DueDate != nil && change.ShiftDueDateDays != nil {
+		v.add("shift_due_date_days", "must not be combined with due_date")
+	}
+	v.checkDueDate("due_date", change.DueDate)
+	if change.CategoryID != nil {
+		if err := v.checkCategory(ctx, uc.categoryRepo, "category_id", *change.CategoryID); err != nil {
+			return nil, err
+		}
+	}
+	if err := v.err("invalid bulk update"); err != nil {
+		return nil, err
+	}
+
+	var saved []*savedTaskUpdate
+	res, err := uc.runBulk(ctx, target, func(tx repository.Repositories, id uint64) (*model.Task, error) {
+		task, err := tx.Tasks.FindByID(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+
+		in := model.UpdateTaskRequest{ID: id, Completed: change.Completed, CategoryID: change.CategoryID, DueDate: change.DueDate}
+		if change.ShiftDueDateDays != nil && task.DueDate != nil {
+			due := task.DueDate.AddDate(0, 0, int(*change.ShiftDueDateDays))
+			var v violations
+			v.checkDueDate("due_date", &due)
+			if err := v.err(fmt.Sprintf("cannot reschedule task %d", id)); err != nil {
+				return nil, err
+			}
+			in.DueDate = &due
+		}
+
+		update, err := saveTaskUpdate(ctx, tx, task, in)
+		if err != nil {
+			return nil, err
+		}
+		saved = append(saved, update)
+		return update.task, nil
+	})
+	if err != nil || !res.Applied {
+		return res, err
+	}
+
+	for _, update := range saved {
+		update.publish(ctx, uc.feed)
+	}
+	return res, nil
+}
+
+// BulkDeleteTasks moves every targeted task to the trash in one transaction.
+func (uc *taskUseCase) BulkDeleteTasks(ctx context.Context, target BulkTaskTarget) (*model.BulkTaskResult, error) {
+	target.IDs = uniqueIDs(target.IDs)
+
+	var v violations
+	v.checkBulkTarget(target)
+	if err := v.err("invalid bulk delete"); err != nil {
+		return nil, err
+	}
+
+	res, err := uc.runBulk(ctx, target, func(tx repository.Repositories, id uint64) (*model.Task, error) {
+		if err := tx.Tasks.Delete(ctx, id); err != nil {
+			return nil, err
+		}
+		return nil, appendHistory(ctx, tx.TaskHistory, historyAction(model.TaskHistoryDeleted, id, nil))
+	})
+	if err != nil || !res.Applied {
+		return res, err
+	}
+
+	for _, o := range res.Outcomes {
+		uc.feed.Publish(ctx, model.TaskEvent{Type: model.TaskDeleted, TaskID: o.TaskID})
+	}
+	return res, nil
+}
+
+// runBulk calls apply for every targeted task within one unit of work. Application errors
+// are recorded against their task and roll the whole operation back; any other error aborts
+// it. Outcomes only carry tasks when the operation was applied.
+func (uc *taskUseCase) runBulk(ctx context.Context, target BulkTaskTarget, apply func(tx repository.Repositories, id uint64) (*model.Task, error)) (*model.BulkTaskResult, error) {
+	res := &model.BulkTaskResult{}
+	err := uc.uow.Do(ctx, func(tx repository.Repositories) error {
+		ids, err := bulkTaskIDs(ctx, tx.Tasks, target)
+		if err != nil {
+			return err
+		}
+
+		res.Outcomes = make([]model.BulkTaskOutcome, 0, len(ids))
+		failed := false
+		for _, id := range ids {
+			task, err := apply(tx, id)
+			if err != nil && !isTaskError(err) {
+				return err
+			}
+			failed = failed || err != nil
+			res.Outcomes = append(res.Outcomes, model.BulkTaskOutcome{TaskID: id, Task: task, Err: err})
+		}
+		if failed {
+			return errBulkRejected
+		}
+		return nil
+	})
+	if errors.Is(err, errBulkRejected) {
+		for i := range res.Outcomes {
+			res.Outcomes[i].Task = nil
+		}
+		return res, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	res.Applied = true
+	return res, nil
+}
+
+// isTaskError reports whether err is about the task itself rather than the operation as a whole.
+func isTaskError(err error) bool {
+	switch apperr.CodeOf(err) {
+	case apperr.CodeNotFound, apperr.CodeInvalidArgument, apperr.CodeFailedPrecondition, apperr.CodeAborted:
+		return true
+	default:
+		return false
+	}
+}
+
+// bulkTaskIDs resolves the tasks a bulk operation applies to.
+func bulkTaskIDs(ctx context.Context, repo repository.TaskRepository, target BulkTaskTarget) ([]uint64, error) {
+	if target.Filter == nil {
+		return target.IDs, nil
+	}
+
+	filter := *target.Filter
+	filter.TagIDs = uniqueIDs(filter.TagIDs)
+	tasks, err := repo.FindAll(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+	if len(tasks) > maxBulkTasks {
+		return nil, apperr.InvalidArgument("too many tasks", apperr.FieldViolation{
+			Field:       "filter",
+			Description: fmt.Sprintf("must match at most %d tasks", maxBulkTasks),
+		})
+	}
+	ids := make([]uint64, 0, len(tasks))
+	for _, task := range tasks {
+		ids = append(ids, task.ID)
+	}
+	return ids, nil
+}
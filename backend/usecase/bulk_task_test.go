@@ -0,0 +1,205 @@
+package usecase
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	"backend/domain/apperr"
+	"backend/domain/auth"
+	"backend/domain/model"
+	"backend/domain/repository"
+	mockrepository "backend/domain/repository/mock"
+
+	"github.com/golang/mock/gomock"
+)
+
+func TestTaskUseCase_BulkUpdateTasks(t *testing.T) {
+	t.Parallel()
+
+	due := time.Date(2025, time.March, 1, 0, 0, 0, 0, time.UTC)
+	shifted := due.AddDate(0, 0, 7)
+	week := int32(7)
+	tasks := map[uint64]*model.Task{
+		1: {ID: 1, Title: "write report", DueDate: &due},
+		2: {ID: 2, Title: "call plumber"},
+	}
+
+	tests := []struct {
+		name        string
+		ids         []uint64
+		wantApplied bool
+		wantDue     map[uint64]*time.Time
+		wantErrIDs  []uint64
+		// wantPublished are the tasks announced as updated once the change is committed
+		wantPublished []uint64
+	}{
+		{
+			name:          "shifts due dates",
+			ids:           []uint64{1, 2, 1},
+			wantApplied:   true,
+			wantDue:       map[uint64]*time.Time{1: &shifted, 2: nil},
+			wantPublished: []uint64{1, 2},
+		},
+		{
+			name:       "missing task rejects every task",
+			ids:        []uint64{1, 99},
+			wantErrIDs: []uint64{99},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			ctx := auth.WithUserID(context.Background(), 1)
+			mockRepo := mockrepository.NewMockTaskRepository(ctrl)
+			mockRepo.EXPECT().FindByID(ctx, gomock.Any()).DoAndReturn(func(_ context.Context, id uint64) (*model.Task, error) {
+				task, ok := tasks[id]
+				if !ok {
+					return nil, apperr.NotFound("task", id)
+				}
+				copied := *task
+				return &copied, nil
+			}).AnyTimes()
+			mockRepo.EXPECT().Update(ctx, gomock.Any()).DoAndReturn(func(_ context.Context, in model.Task) (*model.Task, error) {
+				return &in, nil
+			}).AnyTimes()
+			mockHistoryRepo := mockrepository.NewMockTaskHistoryRepository(ctrl)
+			mockHistoryRepo.EXPECT().Append(ctx, gomock.Any()).Return(nil).AnyTimes()
+			uow := inlineUnitOfWork(ctrl, repository.Repositories{Tasks: mockRepo, TaskHistory: mockHistoryRepo})
+
+			feed := NewTaskFeed()
+			events, cancel := feed.Subscribe(1)
+			defer cancel()
+
+			uc := NewTaskUseCase(mockRepo, mockrepository.NewMockCategoryRepository(ctrl), mockrepository.NewMockSubTaskRepository(ctrl), mockHistoryRepo, uow, feed)
+
+			res, err := uc.BulkUpdateTasks(ctx, BulkTaskTarget{IDs: tt.ids}, model.BulkTaskChange{ShiftDueDateDays: &week})
+			if err != nil {
+				t.Fatalf("BulkUpdateTasks returned error: %v", err)
+			}
+
+			if res.Applied != tt.wantApplied {
+				t.Fatalf("Applied = %v, want %v", res.Applied, tt.wantApplied)
+			}
+			var errIDs []uint64
+			for _, o := range res.Outcomes {
+				if o.Err != nil {
+					errIDs = append(errIDs, o.TaskID)
+				}
+				if !res.Applied {
+					if o.Task != nil {
+						t.Fatalf("task %d is reported although nothing was applied", o.TaskID)
+					}
+					continue
+				}
+				if want := tt.wantDue[o.TaskID]; !reflect.DeepEqual(o.Task.DueDate, want) {
+					t.Fatalf("task %d due date = %v, want %v", o.TaskID, o.Task.DueDate, want)
+				}
+			}
+			if !reflect.DeepEqual(errIDs, tt.wantErrIDs) {
+				t.Fatalf("failed tasks = %v, want %v", errIDs, tt.wantErrIDs)
+			}
+			var published []uint64
+			for _, event := range publishedEvents(events) {
+				published = append(published, event.TaskID)
+			}
+			if !reflect.DeepEqual(published, tt.wantPublished) {
+				t.Fatalf("published tasks = %v, want %v", published, tt.wantPublished)
+			}
+		})
+	}
+}
+
+func TestTaskUseCase_BulkUpdateTasks_Invalid(t *testing.T) {
+	t.Parallel()
+
+	due := time.Date(2025, time.March, 1, 0, 0, 0, 0, time.UTC)
+	days, completed := int32(1), int32(1)
+
+	tests := []struct {
+		name   string
+		target BulkTaskTarget
+		change model.BulkTaskChange
+		want   []string
+	}{
+		{
+			name:   "no target",
+			change: model.BulkTaskChange{Completed: &completed},
+			want:   []string{"ids"},
+		},
+		{
+			name:   "ids and filter",
+			target: BulkTaskTarget{IDs: []uint64{1}, Filter: &repository.TaskFilter{}},
+			change: model.BulkTaskChange{Completed: &completed},
+			want:   []string{"filter"},
+		},
+		{
+			name:   "no change",
+			target: BulkTaskTarget{IDs: []uint64{1}},
+			want:   []string{"change"},
+		},
+		{
+			name:   "absolute and shifted due date",
+			target: BulkTaskTarget{IDs: []uint64{1}},
+			change: model.BulkTaskChange{DueDate: &due, ShiftDueDateDays: &days},
+			want:   []string{"shift_due_date_days"},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			uc := NewTaskUseCase(mockrepository.NewMockTaskRepository(ctrl), mockrepository.NewMockCategoryRepository(ctrl), mockrepository.NewMockSubTaskRepository(ctrl), mockrepository.NewMockTaskHistoryRepository(ctrl), mockrepository.NewMockUnitOfWork(ctrl), NewTaskFeed())
+
+			_, err := uc.BulkUpdateTasks(context.Background(), tt.target, tt.change)
+
+			if got := violatedFields(t, err); !reflect.DeepEqual(got, tt.want) {
+				t.Fatalf("violated fields = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTaskUseCase_BulkDeleteTasks_Filter(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ctx := context.Background()
+	categoryID := uint64(3)
+	filter := repository.TaskFilter{CategoryID: &categoryID, TagIDs: []uint64{7, 7}, TagMatch: repository.TagMatchAll}
+	deduped := repository.TaskFilter{CategoryID: &categoryID, TagIDs: []uint64{7}, TagMatch: repository.TagMatchAll}
+
+	mockRepo := mockrepository.NewMockTaskRepository(ctrl)
+	mockRepo.EXPECT().FindAll(ctx, deduped).Return([]model.Task{{ID: 4}, {ID: 5}}, nil)
+	mockRepo.EXPECT().Delete(ctx, uint64(4)).Return(nil)
+	mockRepo.EXPECT().Delete(ctx, uint64(5)).Return(nil)
+	mockHistoryRepo := mockrepository.NewMockTaskHistoryRepository(ctrl)
+	mockHistoryRepo.EXPECT().Append(ctx, gomock.Any()).Return(nil).Times(2)
+	uow := inlineUnitOfWork(ctrl, repository.Repositories{Tasks: mockRepo, TaskHistory: mockHistoryRepo})
+
+	uc := NewTaskUseCase(mockRepo, mockrepository.NewMockCategoryRepository(ctrl), mockrepository.NewMockSubTaskRepository(ctrl), mockHistoryRepo, uow, NewTaskFeed())
+
+	res, err := uc.BulkDeleteTasks(ctx, BulkTaskTarget{Filter: &filter})
+	if err != nil {
+		t.Fatalf("BulkDeleteTasks returned error: %v", err)
+	}
+
+	want := &model.BulkTaskResult{Applied: true, Outcomes: []model.BulkTaskOutcome{{TaskID: 4}, {TaskID: 5}}}
+	if !reflect.DeepEqual(res, want) {
+		t.Fatalf("BulkDeleteTasks = %#v, want %#v", res, want)
+	}
+}
@@ -2,26 +2,128 @@ package usecase
 
 import (
 	"context"
+	"strings"
 
+	"backend/domain/apperr"
 	"backend/domain/model"
 	"backend/domain/repository"
+
+	"github.com/labstack/gommon/log"
+)
+
+var (
+	// ErrEmptyCategoryName is returned when a category name is blank.
+	ErrEmptyCategoryName = apperr.InvalidArgument("category name must not be empty", apperr.FieldViolation{
+		Field:       "name",
+		Description: "must not be empty",
+	})
+	// ErrInvalidReassignTarget is returned when the reassign policy lacks a usable target category.
+	ErrInvalidReassignTarget = apperr.InvalidArgument("reassign_to must reference another category", apperr.FieldViolation{
+		Field:       "reassign_to",
+		Description: "must reference another existing category",
+	})
 )
 
 // CategoryUseCase defines category-specific business logic.
 type CategoryUseCase interface {
 	ListCategories(ctx context.Context) ([]model.Category, error)
+	CreateCategory(ctx context.Context, name string) (*model.Category, error)
+	RenameCategory(ctx context.Context, id uint64, name string) (*model.Category, error)
+	DeleteCategory(ctx context.Context, in model.DeleteCategoryRequest) error
 }
 
 type categoryUseCase struct {
-	repo repository.CategoryRepository
+	repo     repository.CategoryRepository
+	taskRepo repository.TaskRepository
+	uow      repository.UnitOfWork
+	feed     *TaskFeed
 }
 
-// NewCategoryUseCase constructs a CategoryUseCase.
-func NewCategoryUseCase(repo repository.CategoryRepository) CategoryUseCase {
-	return &categoryUseCase{repo: repo}
+// NewCategoryUseCase constructs a CategoryUseCase. Tasks moved out of a deleted category are
+// announced on feed.
+func NewCategoryUseCase(repo repository.CategoryRepository, taskRepo repository.TaskRepository, uow repository.UnitOfWork, feed *TaskFeed) CategoryUseCase {
+	return &categoryUseCase{repo: repo, taskRepo: taskRepo, uow: uow, feed: feed}
 }
 
 // ListCategories returns every category.
 func (uc *categoryUseCase) ListCategories(ctx context.Context) ([]model.Category, error) {
 	return uc.repo.ListCategories(ctx)
 }
+
+// CreateCategory creates a category with the given name.
+func (uc *categoryUseCase) CreateCategory(ctx context.Context, name string) (*model.Category, error) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return nil, ErrEmptyCategoryName
+	}
+
+	return uc.repo.CreateCategory(ctx, model.Category{Name: name})
+}
+
+// RenameCategory changes the name of an existing category.
+func (uc *categoryUseCase) RenameCategory(ctx context.Context, id uint64, name string) (*model.Category, error) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return nil, ErrEmptyCategoryName
+	}
+
+	category, err := uc.repo.FindCategoryByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	category.Name = name
+
+	return uc.repo.UpdateCategory(ctx, *category)
+}
+
+// DeleteCategory removes a category according to the requested policy.
+func (uc *categoryUseCase) DeleteCategory(ctx context.Context, in model.DeleteCategoryRequest) error {
+	reassign := in.Policy == model.DeleteCategoryReassign
+	if reassign && (in.ReassignTo == nil || *in.ReassignTo == in.ID) {
+		return ErrInvalidReassignTarget
+	}
+
+	// 移動先の確認、タスクの付け替え・削除と履歴の記録を 1 つのトランザクションで行う
+	var moved []uint64
+	err := uc.uow.Do(ctx, func(tx repository.Repositories) error {
+		var reassignTo uint64
+		if reassign {
+			if _, err := tx.Categories.FindCategoryByID(ctx, *in.ReassignTo); err != nil {
+				if apperr.IsNotFound(err) {
+					return ErrInvalidReassignTarget
+				}
+				return err
+			}
+			reassignTo = *in.ReassignTo
+		}
+		var err error
+		if moved, err = tx.Categories.DeleteCategory(ctx, in); err != nil {
+			return err
+		}
+		return appendHistory(ctx, tx.TaskHistory, categoryMoves(moved, in.ID, reassignTo))
+	})
+	if err != nil {
+		return err
+	}
+
+	uc.publishTasksUpdated(ctx, moved)
+	return nil
+}
+
+// publishTasksUpdated notifies watchers of the tasks moved out of a deleted category. The change
+// has already been committed, so a failure to load the tasks only skips the notification.
+// Trashed tasks are not loaded and, as they are not watched, not announced either.
+func (uc *categoryUseCase) publishTasksUpdated(ctx context.Context, ids []uint64) {
+	if len(ids) == 0 {
+		return
+	}
+	tasks, err := uc.taskRepo.FindAll(ctx, repository.TaskFilter{IDs: ids})
+	if err != nil {
+		log.Errorf("failed to load tasks %v for change notification: %v", ids, err)
+		return
+	}
+
+	for i := range tasks {
+		uc.feed.Publish(ctx, model.TaskEvent{Type: model.TaskUpdated, TaskID: tasks[i].ID, Task: &tasks[i]})
+	}
+}
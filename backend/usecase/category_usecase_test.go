@@ -6,7 +6,10 @@ import (
 	"reflect"
 	"testing"
 
+	"backend/domain/apperr"
+	"backend/domain/auth"
 	"backend/domain/model"
+	"backend/domain/repository"
 	mockrepository "backend/domain/repository/mock"
 
 	"github.com/golang/mock/gomock"
@@ -49,7 +52,7 @@ func TestCategoryUseCase_ListCategories(t *testing.T) {
 			mockRepo := mockrepository.NewMockCategoryRepository(ctrl)
 			mockRepo.EXPECT().ListCategories(ctx).Return(tt.repoResult, tt.repoErr)
 
-			uc := NewCategoryUseCase(mockRepo)
+			uc := NewCategoryUseCase(mockRepo, mockrepository.NewMockTaskRepository(ctrl), mockrepository.NewMockUnitOfWork(ctrl), NewTaskFeed())
 
 			got, err := uc.ListCategories(ctx)
 
@@ -70,3 +73,236 @@ func TestCategoryUseCase_ListCategories(t *testing.T) {
 		})
 	}
 }
+
+func TestCategoryUseCase_CreateCategory(t *testing.T) {
+	t.Parallel()
+
+	errRepository := errors.New("db unavailable")
+
+	tests := []struct {
+		name       string
+		input      string
+		expectRepo bool
+		repoErr    error
+		wantName   string
+		wantErr    error
+	}{
+		{
+			name:       "success trims name",
+			input:      "  Errands ",
+			expectRepo: true,
+			wantName:   "Errands",
+		},
+		{
+			name:    "empty name",
+			input:   "   ",
+			wantErr: ErrEmptyCategoryName,
+		},
+		{
+			name:       "repository error",
+			input:      "Errands",
+			expectRepo: true,
+			repoErr:    errRepository,
+			wantErr:    errRepository,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			ctx := context.Background()
+			mockRepo := mockrepository.NewMockCategoryRepository(ctrl)
+			if tt.expectRepo {
+				mockRepo.EXPECT().
+					CreateCategory(ctx, model.Category{Name: "Errands"}).
+					DoAndReturn(func(_ context.Context, in model.Category) (*model.Category, error) {
+						if tt.repoErr != nil {
+							return nil, tt.repoErr
+						}
+						in.ID = 4
+						return &in, nil
+					})
+			}
+
+			uc := NewCategoryUseCase(mockRepo, mockrepository.NewMockTaskRepository(ctrl), mockrepository.NewMockUnitOfWork(ctrl), NewTaskFeed())
+
+			got, err := uc.CreateCategory(ctx, tt.input)
+
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("CreateCategory error = %v, want %v", err, tt.wantErr)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("CreateCategory returned error: %v", err)
+			}
+
+			if got.Name != tt.wantName {
+				t.Fatalf("CreateCategory name = %q, want %q", got.Name, tt.wantName)
+			}
+		})
+	}
+}
+
+func TestCategoryUseCase_RenameCategory(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ctx := context.Background()
+	mockRepo := mockrepository.NewMockCategoryRepository(ctrl)
+	mockRepo.EXPECT().FindCategoryByID(ctx, uint64(1)).Return(&model.Category{ID: 1, Name: "Work"}, nil)
+	mockRepo.EXPECT().
+		UpdateCategory(ctx, model.Category{ID: 1, Name: "Office"}).
+		Return(&model.Category{ID: 1, Name: "Office"}, nil)
+
+	uc := NewCategoryUseCase(mockRepo, mockrepository.NewMockTaskRepository(ctrl), mockrepository.NewMockUnitOfWork(ctrl), NewTaskFeed())
+
+	got, err := uc.RenameCategory(ctx, 1, "Office")
+	if err != nil {
+		t.Fatalf("RenameCategory returned error: %v", err)
+	}
+
+	if got.Name != "Office" {
+		t.Fatalf("RenameCategory name = %q, want %q", got.Name, "Office")
+	}
+}
+
+func TestCategoryUseCase_DeleteCategory(t *testing.T) {
+	t.Parallel()
+
+	sameID := uint64(1)
+	otherID := uint64(2)
+	errRepository := errors.New("db unavailable")
+	fromID, toID := "1", "2"
+
+	tests := []struct {
+		name        string
+		req         model.DeleteCategoryRequest
+		lookupErr   error
+		expectFind  bool
+		expectDel   bool
+		moved       []uint64
+		wantHistory []model.TaskHistoryEntry
+		wantErr     error
+	}{
+		{
+			name:      "reject policy delegates to repository",
+			req:       model.DeleteCategoryRequest{ID: 1, Policy: model.DeleteCategoryReject},
+			expectDel: true,
+		},
+		{
+			name:      "nullify policy records the cleared categories",
+			req:       model.DeleteCategoryRequest{ID: 1, Policy: model.DeleteCategoryNullify},
+			expectDel: true,
+			moved:     []uint64{3, 4},
+			wantHistory: []model.TaskHistoryEntry{
+				{TaskID: 3, Action: model.TaskHistoryUpdated, Field: "category_id", OldValue: &fromID},
+				{TaskID: 4, Action: model.TaskHistoryUpdated, Field: "category_id", OldValue: &fromID},
+			},
+		},
+		{
+			name:       "reassign to existing category records the moves",
+			req:        model.DeleteCategoryRequest{ID: 1, Policy: model.DeleteCategoryReassign, ReassignTo: &otherID},
+			expectFind: true,
+			expectDel:  true,
+			moved:      []uint64{3},
+			wantHistory: []model.TaskHistoryEntry{
+				{TaskID: 3, Action: model.TaskHistoryUpdated, Field: "category_id", OldValue: &fromID, NewValue: &toID},
+			},
+		},
+		{
+			name:       "reassign without referencing tasks",
+			req:        model.DeleteCategoryRequest{ID: 1, Policy: model.DeleteCategoryReassign, ReassignTo: &otherID},
+			expectFind: true,
+			expectDel:  true,
+		},
+		{
+			name:    "reassign without target",
+			req:     model.DeleteCategoryRequest{ID: 1, Policy: model.DeleteCategoryReassign},
+			wantErr: ErrInvalidReassignTarget,
+		},
+		{
+			name:    "reassign to itself",
+			req:     model.DeleteCategoryRequest{ID: 1, Policy: model.DeleteCategoryReassign, ReassignTo: &sameID},
+			wantErr: ErrInvalidReassignTarget,
+		},
+		{
+			name:       "reassign to missing category",
+			req:        model.DeleteCategoryRequest{ID: 1, Policy: model.DeleteCategoryReassign, ReassignTo: &otherID},
+			expectFind: true,
+			lookupErr:  apperr.NotFound("category", otherID),
+			wantErr:    ErrInvalidReassignTarget,
+		},
+		{
+			name:       "reassign target lookup error",
+			req:        model.DeleteCategoryRequest{ID: 1, Policy: model.DeleteCategoryReassign, ReassignTo: &otherID},
+			expectFind: true,
+			lookupErr:  errRepository,
+			wantErr:    errRepository,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			ctx := auth.WithUserID(context.Background(), 1)
+			mockRepo := mockrepository.NewMockCategoryRepository(ctrl)
+			if tt.expectFind {
+				mockRepo.EXPECT().FindCategoryByID(ctx, otherID).Return(&model.Category{ID: otherID}, tt.lookupErr)
+			}
+			if tt.expectDel {
+				mockRepo.EXPECT().DeleteCategory(ctx, tt.req).Return(tt.moved, nil)
+			}
+			mockHistoryRepo := mockrepository.NewMockTaskHistoryRepository(ctrl)
+			if tt.wantHistory != nil {
+				mockHistoryRepo.EXPECT().Append(ctx, tt.wantHistory).Return(nil)
+			}
+
+			// the moved tasks are announced once the deletion has been committed
+			mockTaskRepo := mockrepository.NewMockTaskRepository(ctrl)
+			if len(tt.moved) > 0 {
+				tasks := make([]model.Task, len(tt.moved))
+				for i, id := range tt.moved {
+					tasks[i] = model.Task{ID: id}
+				}
+				mockTaskRepo.EXPECT().FindAll(ctx, repository.TaskFilter{IDs: tt.moved}).Return(tasks, nil)
+			}
+
+			uow := inlineUnitOfWork(ctrl, repository.Repositories{Categories: mockRepo, TaskHistory: mockHistoryRepo})
+			feed := NewTaskFeed()
+			events, cancel := feed.Subscribe(1)
+			defer cancel()
+
+			uc := NewCategoryUseCase(mockRepo, mockTaskRepo, uow, feed)
+
+			err := uc.DeleteCategory(ctx, tt.req)
+
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("DeleteCategory error = %v, want %v", err, tt.wantErr)
+			}
+			var updated []uint64
+			for _, event := range publishedEvents(events) {
+				if event.Type == model.TaskUpdated {
+					updated = append(updated, event.TaskID)
+				}
+			}
+			if !reflect.DeepEqual(updated, tt.moved) {
+				t.Fatalf("updated tasks published = %v, want %v", updated, tt.moved)
+			}
+		})
+	}
+}
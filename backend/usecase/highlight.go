@@ -0,0 +1,94 @@
+package usecase
+
+import (
+	"html"
+	"strings"
+	"unicode"
+)
+
+// snippetRadius is how many characters of context a snippet keeps on each side of the first match.
+const snippetRadius = 40
+
+// searchTerms splits a search query into lower-cased, distinct terms.
+func searchTerms(query string) [][]rune {
+	seen := make(map[string]bool)
+	var terms [][]rune
+	for _, field := range strings.Fields(query) {
+		term := lowerRunes(field)
+		if !seen[string(term)] {
+			seen[string(term)] = true
+			terms = append(terms, term)
+		}
+	}
+	return terms
+}
+
+// highlight excerpts text around the first occurrence of any term and wraps every
+// occurrence inside the excerpt in <mark></mark>. The rest of the excerpt is HTML-escaped.
+// It reports false when no term occurs in text.
+func highlight(text string, terms [][]rune) (string, bool) {
+	runes := []rune(text)
+	lower := lowerRunes(text)
+
+	// matchLen[i] is the length of the longest term starting at i.
+	matchLen := make([]int, len(runes))
+	first := -1
+	for i := range lower {
+		for _, term := range terms {
+			if len(term) > matchLen[i] && hasPrefixAt(lower, term, i) {
+				matchLen[i] = len(term)
+			}
+		}
+		if matchLen[i] > 0 && first < 0 {
+			first = i
+		}
+	}
+	if first < 0 {
+		return "", false
+	}
+
+	start := max(first-snippetRadius, 0)
+	end := min(first+matchLen[first]+snippetRadius, len(runes))
+
+	var b strings.Builder
+	if start > 0 {
+		b.WriteString("…")
+	}
+	for i := start; i < end; {
+		if n := matchLen[i]; n > 0 {
+			stop := min(i+n, end)
+			b.WriteString("<mark>")
+			b.WriteString(html.EscapeString(string(runes[i:stop])))
+			b.WriteString("</mark>")
+			i = stop
+			continue
+		}
+		b.WriteString(html.EscapeString(string(runes[i])))
+		i++
+	}
+	if end < len(runes) {
+		b.WriteString("…")
+	}
+	return b.String(), true
+}
+
+// lowerRunes lower-cases s rune by rune, so that indexes match those of []rune(s).
+func lowerRunes(s string) []rune {
+	runes := []rune(s)
+	for i, r := range runes {
+		runes[i] = unicode.ToLower(r)
+	}
+	return runes
+}
+
+func hasPrefixAt(s, prefix []rune, at int) bool {
+	if at+len(prefix) > len(s) {
+		return false
+	}
+	for i, r := range prefix {
+		if s[at+i] != r {
+			return false
+		}
+	}
+	return true
+}
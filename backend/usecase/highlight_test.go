@@ -0,0 +1,71 @@
+package usecase
+
+import "testing"
+
+func TestHighlight(t *testing.T) {
+	t.Parallel()
+
+	long := "aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa report bbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb"
+
+	tests := []struct {
+		name   string
+		text   string
+		query  string
+		want   string
+		wantOK bool
+	}{
+		{
+			name:   "case-insensitive match",
+			text:   "Write the Report",
+			query:  "report",
+			want:   "Write the <mark>Report</mark>",
+			wantOK: true,
+		},
+		{
+			name:   "every term is marked",
+			text:   "review the report before the review",
+			query:  "review report",
+			want:   "<mark>review</mark> the <mark>report</mark> before the <mark>review</mark>",
+			wantOK: true,
+		},
+		{
+			name:   "japanese text",
+			text:   "月次レポートを提出する",
+			query:  "レポート",
+			want:   "月次<mark>レポート</mark>を提出する",
+			wantOK: true,
+		},
+		{
+			name:   "html is escaped",
+			text:   "<b>fix</b> & deploy",
+			query:  "deploy",
+			want:   "&lt;b&gt;fix&lt;/b&gt; &amp; <mark>deploy</mark>",
+			wantOK: true,
+		},
+		{
+			name:   "long text is cut around the first match",
+			text:   long,
+			query:  "report",
+			want:   "…aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa <mark>report</mark> bbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb…",
+			wantOK: true,
+		},
+		{
+			name:  "no match",
+			text:  "buy milk",
+			query: "report",
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, ok := highlight(tt.text, searchTerms(tt.query))
+
+			if ok != tt.wantOK || got != tt.want {
+				t.Fatalf("highlight = %q, %v, want %q, %v", got, ok, tt.want, tt.wantOK)
+			}
+		})
+	}
+}
@@ -2,48 +2,311 @@ package usecase
 
 import (
 	"context"
+	"strings"
 	"time"
 
+	"backend/domain/apperr"
 	"backend/domain/model"
 	"backend/domain/repository"
+
+	"github.com/labstack/gommon/log"
 )
 
+// ErrInvalidSubTaskOrder is returned when a reorder request does not list every subtask of the task exactly once.
+var ErrInvalidSubTaskOrder = apperr.InvalidArgument("invalid sub task order", apperr.FieldViolation{
+	Field:       "sub_task_ids",
+	Description: "must list every subtask of the task exactly once",
+})
+
 type SubTaskUseCase interface {
+	Get(ctx context.Context, id uint64) (*model.SubTask, error)
 	ListByTaskID(ctx context.Context, taskID uint64) ([]model.SubTask, error)
+	ListByTaskIDs(ctx context.Context, taskIDs []uint64) (map[uint64][]model.SubTask, error)
 	Create(ctx context.Context, in model.SubTask) (*model.SubTask, error)
-	ToggleCompletion(ctx context.Context, id uint64, completed bool) (*model.SubTask, error)
+	Update(ctx context.Context, in model.UpdateSubTaskRequest) (*model.SubTask, error)
+	ToggleCompletion(ctx context.Context, id uint64, completed bool, expectedVersion *int32) (*model.SubTask, error)
+	Delete(ctx context.Context, id uint64) error
+	Reorder(ctx context.Context, taskID uint64, ids []uint64) ([]model.SubTask, error)
 }
 
 type subTaskUseCase struct {
-	repo repository.SubTaskRepository
+	repo     repository.SubTaskRepository
+	taskRepo repository.TaskRepository
+	uow      repository.UnitOfWork
+	feed     *TaskFeed
 }
 
-func NewSubTaskUseCase(repo repository.SubTaskRepository) SubTaskUseCase {
-	return &subTaskUseCase{repo: repo}
+func NewSubTaskUseCase(repo repository.SubTaskRepository, taskRepo repository.TaskRepository, uow repository.UnitOfWork, feed *TaskFeed) SubTaskUseCase {
+	return &subTaskUseCase{repo: repo, taskRepo: taskRepo, uow: uow, feed: feed}
+}
+
+func (uc *subTaskUseCase) Get(ctx context.Context, id uint64) (*model.SubTask, error) {
+	return uc.repo.FindByID(ctx, id)
 }
 
 func (uc *subTaskUseCase) ListByTaskID(ctx context.Context, taskID uint64) ([]model.SubTask, error) {
 	return uc.repo.ListByTaskID(ctx, taskID)
 }
 
+func (uc *subTaskUseCase) ListByTaskIDs(ctx context.Context, taskIDs []uint64) (map[uint64][]model.SubTask, error) {
+	return uc.repo.ListByTaskIDs(ctx, taskIDs)
+}
+
 func (uc *subTaskUseCase) Create(ctx context.Context, in model.SubTask) (*model.SubTask, error) {
-	return uc.repo.Create(ctx, in)
+	var v violations
+	v.checkTitle("title", in.Title)
+	v.checkNote("note", in.Note)
+	v.checkDueDate("due_date", in.DueDate)
+	if err := v.checkTask(ctx, uc.taskRepo, "task_id", in.TaskID); err != nil {
+		return nil, err
+	}
+	if err := v.err("invalid sub task"); err != nil {
+		return nil, err
+	}
+
+	in.Title = strings.TrimSpace(in.Title)
+	var subTask *model.SubTask
+	var next *model.Task
+	err := uc.uow.Do(ctx, func(tx repository.Repositories) error {
+		var err error
+		if subTask, err = tx.SubTasks.Create(ctx, in); err != nil {
+			return err
+		}
+		if err := appendHistory(ctx, tx.TaskHistory, historyAction(model.TaskHistoryCreated, subTask.TaskID, &subTask.ID)); err != nil {
+			return err
+		}
+		// 完了済みの親タスクに未完了のサブタスクが増えたら再開する
+		_, next, err = syncParentCompletion(ctx, tx, subTask.TaskID)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	uc.publishTaskUpdated(ctx, subTask.TaskID)
+	uc.publishNextOccurrence(ctx, next)
+	return subTask, nil
+}
+
+func (uc *subTaskUseCase) Update(ctx context.Context, in model.UpdateSubTaskRequest) (*model.SubTask, error) {
+	var v violations
+	if in.Title != nil {
+		v.checkTitle("title", *in.Title)
+	}
+	if in.Note != nil {
+		v.checkNote("note", *in.Note)
+	}
+	v.checkDueDate("due_date", in.DueDate)
+	if err := v.err("invalid sub task"); err != nil {
+		return nil, err
+	}
+
+	res, err := uc.update(ctx, in.ID, in.ExpectedVersion, func(subTask *model.SubTask) {
+		if in.Title != nil {
+			subTask.Title = strings.TrimSpace(*in.Title)
+		}
+		if in.Note != nil {
+			subTask.Note = *in.Note
+		}
+		if in.DueDate != nil {
+			subTask.DueDate = in.DueDate
+		}
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	uc.publishTaskUpdated(ctx, res.TaskID)
+	return res, nil
+}
+
+// ToggleCompletion completes or reopens a subtask. When the parent task has SyncCompletion,
+// it is completed once every subtask is done and reopened when one is unchecked.
+func (uc *subTaskUseCase) ToggleCompletion(ctx context.Context, id uint64, completed bool, expectedVersion *int32) (*model.SubTask, error) {
+	var res *model.SubTask
+	var parent, next *model.Task
+	err := uc.uow.Do(ctx, func(tx repository.Repositories) error {
+		var err error
+		res, err = saveSubTaskUpdate(ctx, tx, id, expectedVersion, func(subTask *model.SubTask) {
+			if completed {
+				now := time.Now()
+				subTask.Completed = 1
+				subTask.CompletedAt = &now
+			} else {
+				subTask.Completed = 0
+				subTask.CompletedAt = nil
+			}
+		})
+		if err != nil {
+			return err
+		}
+		parent, next, err = syncParentCompletion(ctx, tx, res.TaskID)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	uc.feed.Publish(ctx, model.TaskEvent{Type: model.SubTaskToggled, TaskID: res.TaskID, SubTask: res})
+	if parent != nil {
+		uc.feed.Publish(ctx, model.TaskEvent{Type: model.TaskUpdated, TaskID: parent.ID, Task: parent})
+	}
+	if next != nil {
+		uc.feed.Publish(ctx, model.TaskEvent{Type: model.TaskCreated, TaskID: next.ID, Task: next})
+	}
+	return res, nil
+}
+
+// update loads a subtask, applies change and saves it together with the resulting history entries.
+// A non-nil expectedVersion must match the version of the stored subtask.
+func (uc *subTaskUseCase) update(ctx context.Context, id uint64, expectedVersion *int32, change func(subTask *model.SubTask)) (*model.SubTask, error) {
+	var res *model.SubTask
+	err := uc.uow.Do(ctx, func(tx repository.Repositories) error {
+		var err error
+		res, err = saveSubTaskUpdate(ctx, tx, id, expectedVersion, change)
+		return err
+	})
+	return res, err
 }
 
-func (uc *subTaskUseCase) ToggleCompletion(ctx context.Context, id uint64, completed bool) (*model.SubTask, error) {
-	subTask, err := uc.repo.FindByID(ctx, id)
+// saveSubTaskUpdate is the body of update, run within tx.
+func saveSubTaskUpdate(ctx context.Context, tx repository.Repositories, id uint64, expectedVersion *int32, change func(subTask *model.SubTask)) (*model.SubTask, error) {
+	subTask, err := tx.SubTasks.FindByID(ctx, id)
 	if err != nil {
 		return nil, err
 	}
+	if err := checkSubTaskVersion(subTask, expectedVersion); err != nil {
+		return nil, err
+	}
+	before := *subTask
+	change(subTask)
+
+	res, err := tx.SubTasks.Update(ctx, *subTask)
+	if err != nil {
+		return nil, err
+	}
+	if err := appendHistory(ctx, tx.TaskHistory, subTaskChanges(before, *subTask)); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// syncParentCompletion completes the task once all of its subtasks are done and reopens it
+// while one is open, if the task has SyncCompletion. It returns the updated task, or nil when
+// nothing changed, and the next occurrence when completing a recurring task created one.
+func syncParentCompletion(ctx context.Context, tx repository.Repositories, taskID uint64) (res, next *model.Task, err error) {
+	task, err := tx.Tasks.FindByID(ctx, taskID)
+	if err != nil {
+		return nil, nil, err
+	}
+	// a task without subtasks has nothing to follow, e.g. after its last subtask was deleted
+	if !task.SyncCompletion || task.SubTaskTotal == 0 {
+		return nil, nil, nil
+	}
+
+	done := task.SubTaskCompleted == task.SubTaskTotal
+	var completed int32
+	switch {
+	case done && task.Completed == 0:
+		completed = 1
+	case !done && task.Completed != 0:
+		completed = 0
+	default:
+		return nil, nil, nil
+	}
+	saved, err := saveTaskUpdate(ctx, tx, task, model.UpdateTaskRequest{ID: task.ID, Completed: &completed})
+	if err != nil {
+		return nil, nil, err
+	}
+	return saved.task, saved.next, nil
+}
 
-	if completed {
-		now := time.Now()
-		subTask.Completed = 1
-		subTask.CompletedAt = &now
-	} else {
-		subTask.Completed = 0
-		subTask.CompletedAt = nil
+func (uc *subTaskUseCase) Delete(ctx context.Context, id uint64) error {
+	// 通知先の親タスクを特定するため削除前に取得する
+	var subTask *model.SubTask
+	var next *model.Task
+	err := uc.uow.Do(ctx, func(tx repository.Repositories) error {
+		var err error
+		if subTask, err = tx.SubTasks.FindByID(ctx, id); err != nil {
+			return err
+		}
+		if err := tx.SubTasks.Delete(ctx, id); err != nil {
+			return err
+		}
+		if err := appendHistory(ctx, tx.TaskHistory, historyAction(model.TaskHistoryDeleted, subTask.TaskID, &id)); err != nil {
+			return err
+		}
+		// 最後の未完了サブタスクを削除したら親タスクを完了する
+		_, next, err = syncParentCompletion(ctx, tx, subTask.TaskID)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	uc.publishTaskUpdated(ctx, subTask.TaskID)
+	uc.publishNextOccurrence(ctx, next)
+	return nil
+}
+
+func (uc *subTaskUseCase) Reorder(ctx context.Context, taskID uint64, ids []uint64) ([]model.SubTask, error) {
+	var reordered []model.SubTask
+	err := uc.uow.Do(ctx, func(tx repository.Repositories) error {
+		current, err := tx.SubTasks.ListByTaskID(ctx, taskID)
+		if err != nil {
+			return err
+		}
+
+		// 並び替え対象は既存サブタスクと過不足なく一致している必要がある
+		if len(ids) != len(current) {
+			return ErrInvalidSubTaskOrder
+		}
+		remaining := make(map[uint64]struct{}, len(current))
+		currentIDs := make([]uint64, 0, len(current))
+		for _, st := range current {
+			remaining[st.ID] = struct{}{}
+			currentIDs = append(currentIDs, st.ID)
+		}
+		for _, id := range ids {
+			if _, ok := remaining[id]; !ok {
+				return ErrInvalidSubTaskOrder
+			}
+			delete(remaining, id)
+		}
+
+		if err := tx.SubTasks.Reorder(ctx, taskID, ids); err != nil {
+			return err
+		}
+		if err := appendHistory(ctx, tx.TaskHistory, subTaskOrderChange(taskID, currentIDs, ids)); err != nil {
+			return err
+		}
+
+		reordered, err = tx.SubTasks.ListByTaskID(ctx, taskID)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	uc.publishTaskUpdated(ctx, taskID)
+	return reordered, nil
+}
+
+// publishNextOccurrence announces the next occurrence of a recurring task, if one was created.
+func (uc *subTaskUseCase) publishNextOccurrence(ctx context.Context, next *model.Task) {
+	if next != nil {
+		uc.feed.Publish(ctx, model.TaskEvent{Type: model.TaskCreated, TaskID: next.ID, Task: next})
+	}
+}
+
+// publishTaskUpdated notifies watchers that a subtask of the task changed. The change
+// itself has already been committed, so a failure to load the task only skips the notification.
+func (uc *subTaskUseCase) publishTaskUpdated(ctx context.Context, taskID uint64) {
+	task, err := uc.taskRepo.FindByID(ctx, taskID)
+	if err != nil {
+		log.Errorf("failed to load task %d for change notification: %v", taskID, err)
+		return
 	}
 
-	return uc.repo.Update(ctx, *subTask)
+	uc.feed.Publish(ctx, model.TaskEvent{Type: model.TaskUpdated, TaskID: taskID, Task: task})
 }
@@ -0,0 +1,371 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+
+	"backend/domain/apperr"
+	"backend/domain/model"
+	"backend/domain/repository"
+	mockrepository "backend/domain/repository/mock"
+
+	"github.com/golang/mock/gomock"
+)
+
+func TestSubTaskUseCase_Reorder(t *testing.T) {
+	t.Parallel()
+
+	const taskID = uint64(1)
+	current := []model.SubTask{
+		{ID: 10, TaskID: taskID, Position: 1},
+		{ID: 11, TaskID: taskID, Position: 2},
+		{ID: 12, TaskID: taskID, Position: 3},
+	}
+
+	tests := []struct {
+		name        string
+		ids         []uint64
+		wantReorder bool
+		wantErr     error
+	}{
+		{
+			name:        "success",
+			ids:         []uint64{12, 10, 11},
+			wantReorder: true,
+		},
+		{
+			name:    "missing subtask",
+			ids:     []uint64{12, 10},
+			wantErr: ErrInvalidSubTaskOrder,
+		},
+		{
+			name:    "duplicated subtask",
+			ids:     []uint64{12, 12, 10},
+			wantErr: ErrInvalidSubTaskOrder,
+		},
+		{
+			name:    "foreign subtask",
+			ids:     []uint64{12, 10, 99},
+			wantErr: ErrInvalidSubTaskOrder,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			ctx := context.Background()
+			reordered := []model.SubTask{current[2], current[0], current[1]}
+			mockRepo := mockrepository.NewMockSubTaskRepository(ctrl)
+			mockTaskRepo := mockrepository.NewMockTaskRepository(ctrl)
+			first := mockRepo.EXPECT().ListByTaskID(ctx, taskID).Return(current, nil)
+			if tt.wantReorder {
+				reorder := mockRepo.EXPECT().Reorder(ctx, taskID, tt.ids).Return(nil).After(first)
+				mockRepo.EXPECT().ListByTaskID(ctx, taskID).Return(reordered, nil).After(reorder)
+				mockTaskRepo.EXPECT().FindByID(ctx, taskID).Return(&model.Task{ID: taskID}, nil)
+			}
+			mockHistoryRepo := mockrepository.NewMockTaskHistoryRepository(ctrl)
+			if tt.wantReorder {
+				mockHistoryRepo.EXPECT().Append(ctx, []model.TaskHistoryEntry{{
+					TaskID:   taskID,
+					Action:   model.TaskHistoryUpdated,
+					Field:    "sub_task_ids",
+					OldValue: strPtr("10,11,12"),
+					NewValue: strPtr("12,10,11"),
+				}}).Return(nil)
+			}
+			uow := inlineUnitOfWork(ctrl, repository.Repositories{SubTasks: mockRepo, TaskHistory: mockHistoryRepo})
+
+			uc := NewSubTaskUseCase(mockRepo, mockTaskRepo, uow, NewTaskFeed())
+
+			got, err := uc.Reorder(ctx, taskID, tt.ids)
+
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("Reorder error = %v, want %v", err, tt.wantErr)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("Reorder returned error: %v", err)
+			}
+
+			if !reflect.DeepEqual(got, reordered) {
+				t.Fatalf("Reorder = %#v, want %#v", got, reordered)
+			}
+		})
+	}
+}
+
+func TestSubTaskUseCase_Create(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		in         model.SubTask
+		taskExists bool
+		wantFields []string
+	}{
+		{
+			name:       "valid sub task",
+			in:         model.SubTask{TaskID: 1, Title: "draft outline"},
+			taskExists: true,
+		},
+		{
+			name:       "missing parent task",
+			in:         model.SubTask{TaskID: 99, Title: "draft outline"},
+			wantFields: []string{"task_id"},
+		},
+		{
+			name:       "empty title",
+			in:         model.SubTask{TaskID: 1, Title: ""},
+			taskExists: true,
+			wantFields: []string{"title"},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			ctx := context.Background()
+			mockRepo := mockrepository.NewMockSubTaskRepository(ctrl)
+			mockTaskRepo := mockrepository.NewMockTaskRepository(ctrl)
+			if tt.taskExists {
+				mockTaskRepo.EXPECT().FindByID(ctx, tt.in.TaskID).Return(&model.Task{ID: tt.in.TaskID}, nil)
+			} else {
+				mockTaskRepo.EXPECT().FindByID(ctx, tt.in.TaskID).Return(nil, apperr.NotFound("task", tt.in.TaskID))
+			}
+			mockHistoryRepo := mockrepository.NewMockTaskHistoryRepository(ctrl)
+			if len(tt.wantFields) == 0 {
+				created := tt.in
+				created.ID = 10
+				mockRepo.EXPECT().Create(ctx, tt.in).Return(&created, nil)
+				mockHistoryRepo.EXPECT().Append(ctx, []model.TaskHistoryEntry{{TaskID: tt.in.TaskID, SubTaskID: &created.ID, Action: model.TaskHistoryCreated}}).Return(nil)
+				// the parent is loaded again to sync its completion and to notify watchers
+				mockTaskRepo.EXPECT().FindByID(ctx, tt.in.TaskID).Return(&model.Task{ID: tt.in.TaskID}, nil).Times(2)
+			}
+			uow := inlineUnitOfWork(ctrl, repository.Repositories{Tasks: mockTaskRepo, SubTasks: mockRepo, TaskHistory: mockHistoryRepo})
+
+			uc := NewSubTaskUseCase(mockRepo, mockTaskRepo, uow, NewTaskFeed())
+
+			_, err := uc.Create(ctx, tt.in)
+
+			if len(tt.wantFields) == 0 {
+				if err != nil {
+					t.Fatalf("Create returned error: %v", err)
+				}
+				return
+			}
+			if got := violatedFields(t, err); !reflect.DeepEqual(got, tt.wantFields) {
+				t.Fatalf("violated fields = %v, want %v", got, tt.wantFields)
+			}
+		})
+	}
+}
+
+func TestSubTaskUseCase_ToggleCompletion_StaleVersion(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ctx := context.Background()
+	subTask := &model.SubTask{ID: 5, TaskID: 1, Title: "draft outline", Version: 4}
+
+	mockRepo := mockrepository.NewMockSubTaskRepository(ctrl)
+	mockRepo.EXPECT().FindByID(ctx, subTask.ID).Return(subTask, nil)
+	uow := inlineUnitOfWork(ctrl, repository.Repositories{SubTasks: mockRepo, TaskHistory: mockrepository.NewMockTaskHistoryRepository(ctrl)})
+
+	uc := NewSubTaskUseCase(mockRepo, mockrepository.NewMockTaskRepository(ctrl), uow, NewTaskFeed())
+
+	stale := int32(3)
+	_, err := uc.ToggleCompletion(ctx, subTask.ID, true, &stale)
+
+	var appErr *apperr.Error
+	if !errors.As(err, &appErr) || appErr.Code != apperr.CodeAborted {
+		t.Fatalf("ToggleCompletion error = %v, want Aborted", err)
+	}
+	if got, ok := appErr.Current.(*model.SubTask); !ok || got.Completed != 0 || got.Version != 4 {
+		t.Fatalf("Current = %#v, want the unchanged sub task at version 4", appErr.Current)
+	}
+}
+
+func TestSubTaskUseCase_ToggleCompletion_SyncCompletion(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name          string
+		completed     bool
+		parent        model.Task
+		wantCompleted *int32
+	}{
+		{
+			name:          "last subtask done completes the task",
+			completed:     true,
+			parent:        model.Task{ID: 1, SyncCompletion: true, SubTaskTotal: 2, SubTaskCompleted: 2},
+			wantCompleted: int32Ptr(1),
+		},
+		{
+			name:      "open subtasks remain",
+			completed: true,
+			parent:    model.Task{ID: 1, SyncCompletion: true, SubTaskTotal: 2, SubTaskCompleted: 1},
+		},
+		{
+			name:          "unchecking reopens the task",
+			completed:     false,
+			parent:        model.Task{ID: 1, SyncCompletion: true, Completed: 1, SubTaskTotal: 2, SubTaskCompleted: 1},
+			wantCompleted: int32Ptr(0),
+		},
+		{
+			name:      "task without sync is left alone",
+			completed: true,
+			parent:    model.Task{ID: 1, SubTaskTotal: 2, SubTaskCompleted: 2},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			ctx := context.Background()
+			subTask := &model.SubTask{ID: 5, TaskID: 1, Title: "draft outline"}
+			if !tt.completed {
+				subTask.Completed = 1
+			}
+
+			mockRepo := mockrepository.NewMockSubTaskRepository(ctrl)
+			mockRepo.EXPECT().FindByID(ctx, subTask.ID).Return(subTask, nil)
+			mockRepo.EXPECT().Update(ctx, gomock.Any()).DoAndReturn(func(_ context.Context, in model.SubTask) (*model.SubTask, error) {
+				return &in, nil
+			})
+			mockHistoryRepo := mockrepository.NewMockTaskHistoryRepository(ctrl)
+			mockHistoryRepo.EXPECT().Append(ctx, gomock.Any()).Return(nil).AnyTimes()
+			mockTaskRepo := mockrepository.NewMockTaskRepository(ctrl)
+			parent := tt.parent
+			mockTaskRepo.EXPECT().FindByID(ctx, parent.ID).Return(&parent, nil)
+			if tt.wantCompleted != nil && *tt.wantCompleted == 1 {
+				// completing the task looks for subtasks left open, of which there are none
+				mockRepo.EXPECT().ListByTaskID(ctx, parent.ID).Return([]model.SubTask{{ID: 5, TaskID: 1, Completed: 1}}, nil)
+			}
+			if tt.wantCompleted != nil {
+				mockTaskRepo.EXPECT().Update(ctx, gomock.Any()).DoAndReturn(func(_ context.Context, in model.Task) (*model.Task, error) {
+					if in.Completed != *tt.wantCompleted {
+						t.Fatalf("parent saved with completed = %d, want %d", in.Completed, *tt.wantCompleted)
+					}
+					return &in, nil
+				})
+			}
+			uow := inlineUnitOfWork(ctrl, repository.Repositories{Tasks: mockTaskRepo, SubTasks: mockRepo, TaskHistory: mockHistoryRepo})
+
+			uc := NewSubTaskUseCase(mockRepo, mockTaskRepo, uow, NewTaskFeed())
+
+			if _, err := uc.ToggleCompletion(ctx, subTask.ID, tt.completed, nil); err != nil {
+				t.Fatalf("ToggleCompletion returned error: %v", err)
+			}
+		})
+	}
+}
+
+func TestSubTaskUseCase_CreateDelete_SyncCompletion(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name   string
+		delete bool
+		// parent is the task as it is after the subtask was created or deleted
+		parent        model.Task
+		wantCompleted *int32
+	}{
+		{
+			name:          "new open subtask reopens the task",
+			parent:        model.Task{ID: 1, SyncCompletion: true, Completed: 1, SubTaskTotal: 2, SubTaskCompleted: 1},
+			wantCompleted: int32Ptr(0),
+		},
+		{
+			name:          "deleting the last open subtask completes the task",
+			delete:        true,
+			parent:        model.Task{ID: 1, SyncCompletion: true, SubTaskTotal: 1, SubTaskCompleted: 1},
+			wantCompleted: int32Ptr(1),
+		},
+		{
+			name:   "deleting the only subtask leaves the task open",
+			delete: true,
+			parent: model.Task{ID: 1, SyncCompletion: true},
+		},
+		{
+			name:   "deleting from a task without sync leaves it alone",
+			delete: true,
+			parent: model.Task{ID: 1, SubTaskTotal: 1, SubTaskCompleted: 1},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			ctx := context.Background()
+			subTask := &model.SubTask{ID: 5, TaskID: 1, Title: "draft outline"}
+			parent := tt.parent
+
+			mockRepo := mockrepository.NewMockSubTaskRepository(ctrl)
+			mockTaskRepo := mockrepository.NewMockTaskRepository(ctrl)
+			if tt.delete {
+				mockRepo.EXPECT().FindByID(ctx, subTask.ID).Return(subTask, nil)
+				mockRepo.EXPECT().Delete(ctx, subTask.ID).Return(nil)
+			} else {
+				// the parent is checked to exist before the subtask is created
+				mockTaskRepo.EXPECT().FindByID(ctx, parent.ID).Return(&model.Task{ID: parent.ID}, nil)
+				mockRepo.EXPECT().Create(ctx, model.SubTask{TaskID: 1, Title: "draft outline"}).Return(subTask, nil)
+			}
+			mockHistoryRepo := mockrepository.NewMockTaskHistoryRepository(ctrl)
+			mockHistoryRepo.EXPECT().Append(ctx, gomock.Any()).Return(nil).AnyTimes()
+			// once to sync its completion, once to notify watchers
+			mockTaskRepo.EXPECT().FindByID(ctx, parent.ID).Return(&parent, nil).Times(2)
+			if tt.wantCompleted != nil && *tt.wantCompleted == 1 {
+				// completing the task looks for subtasks left open, of which there are none
+				mockRepo.EXPECT().ListByTaskID(ctx, parent.ID).Return([]model.SubTask{{ID: 6, TaskID: 1, Completed: 1}}, nil)
+			}
+			if tt.wantCompleted != nil {
+				mockTaskRepo.EXPECT().Update(ctx, gomock.Any()).DoAndReturn(func(_ context.Context, in model.Task) (*model.Task, error) {
+					if in.Completed != *tt.wantCompleted {
+						t.Fatalf("parent saved with completed = %d, want %d", in.Completed, *tt.wantCompleted)
+					}
+					return &in, nil
+				})
+			}
+			uow := inlineUnitOfWork(ctrl, repository.Repositories{Tasks: mockTaskRepo, SubTasks: mockRepo, TaskHistory: mockHistoryRepo})
+
+			uc := NewSubTaskUseCase(mockRepo, mockTaskRepo, uow, NewTaskFeed())
+
+			var err error
+			if tt.delete {
+				err = uc.Delete(ctx, subTask.ID)
+			} else {
+				_, err = uc.Create(ctx, model.SubTask{TaskID: 1, Title: "draft outline"})
+			}
+			if err != nil {
+				t.Fatalf("returned error: %v", err)
+			}
+		})
+	}
+}
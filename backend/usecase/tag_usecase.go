@@ -0,0 +1,78 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	"backend/domain/model"
+	"backend/domain/repository"
+)
+
+// maxTagNameLength mirrors the VARCHAR(64) name column of tags.
+const maxTagNameLength = 64
+
+// TagUseCase defines tag-specific business logic.
+type TagUseCase interface {
+	ListTags(ctx context.Context) ([]model.Tag, error)
+	CreateTag(ctx context.Context, name string) (*model.Tag, error)
+	RenameTag(ctx context.Context, id uint64, name string) (*model.Tag, error)
+	DeleteTag(ctx context.Context, id uint64) error
+}
+
+type tagUseCase struct {
+	repo repository.TagRepository
+}
+
+// NewTagUseCase constructs a TagUseCase.
+func NewTagUseCase(repo repository.TagRepository) TagUseCase {
+	return &tagUseCase{repo: repo}
+}
+
+// ListTags returns every tag of the calling user.
+func (uc *tagUseCase) ListTags(ctx context.Context) ([]model.Tag, error) {
+	return uc.repo.ListTags(ctx)
+}
+
+// CreateTag creates a tag with the given name.
+func (uc *tagUseCase) CreateTag(ctx context.Context, name string) (*model.Tag, error) {
+	name = strings.TrimSpace(name)
+	if err := validateTagName(name); err != nil {
+		return nil, err
+	}
+
+	return uc.repo.CreateTag(ctx, model.Tag{Name: name})
+}
+
+// RenameTag changes the name of an existing tag.
+func (uc *tagUseCase) RenameTag(ctx context.Context, id uint64, name string) (*model.Tag, error) {
+	name = strings.TrimSpace(name)
+	if err := validateTagName(name); err != nil {
+		return nil, err
+	}
+
+	tag, err := uc.repo.FindTagByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	tag.Name = name
+
+	return uc.repo.UpdateTag(ctx, *tag)
+}
+
+// DeleteTag removes a tag from every task and deletes it.
+func (uc *tagUseCase) DeleteTag(ctx context.Context, id uint64) error {
+	return uc.repo.DeleteTag(ctx, id)
+}
+
+func validateTagName(name string) error {
+	var v violations
+	switch {
+	case name == "":
+		v.add("name", "must not be empty")
+	case utf8.RuneCountInString(name) > maxTagNameLength:
+		v.add("name", fmt.Sprintf("must be at most %d characters", maxTagNameLength))
+	}
+	return v.err("invalid tag")
+}
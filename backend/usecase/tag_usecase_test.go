@@ -0,0 +1,103 @@
+package usecase
+
+import (
+	"context"
+	"reflect"
+	"strings"
+	"testing"
+
+	"backend/domain/model"
+	mockrepository "backend/domain/repository/mock"
+
+	"github.com/golang/mock/gomock"
+)
+
+func TestTagUseCase_CreateTag(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		input      string
+		wantName   string
+		wantFields []string
+	}{
+		{
+			name:     "success trims name",
+			input:    "  blocked ",
+			wantName: "blocked",
+		},
+		{
+			name:       "empty name",
+			input:      "   ",
+			wantFields: []string{"name"},
+		},
+		{
+			name:       "name too long",
+			input:      strings.Repeat("タ", maxTagNameLength+1),
+			wantFields: []string{"name"},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			ctx := context.Background()
+			mockRepo := mockrepository.NewMockTagRepository(ctrl)
+			if len(tt.wantFields) == 0 {
+				mockRepo.EXPECT().
+					CreateTag(ctx, model.Tag{Name: tt.wantName}).
+					DoAndReturn(func(_ context.Context, in model.Tag) (*model.Tag, error) {
+						in.ID = 3
+						return &in, nil
+					})
+			}
+
+			uc := NewTagUseCase(mockRepo)
+
+			got, err := uc.CreateTag(ctx, tt.input)
+
+			if len(tt.wantFields) > 0 {
+				if fields := violatedFields(t, err); !reflect.DeepEqual(fields, tt.wantFields) {
+					t.Fatalf("violated fields = %v, want %v", fields, tt.wantFields)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("CreateTag returned error: %v", err)
+			}
+			if got.Name != tt.wantName {
+				t.Fatalf("CreateTag name = %q, want %q", got.Name, tt.wantName)
+			}
+		})
+	}
+}
+
+func TestTagUseCase_RenameTag(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ctx := context.Background()
+	mockRepo := mockrepository.NewMockTagRepository(ctrl)
+	mockRepo.EXPECT().FindTagByID(ctx, uint64(1)).Return(&model.Tag{ID: 1, Name: "review"}, nil)
+	mockRepo.EXPECT().
+		UpdateTag(ctx, model.Tag{ID: 1, Name: "in review"}).
+		Return(&model.Tag{ID: 1, Name: "in review"}, nil)
+
+	uc := NewTagUseCase(mockRepo)
+
+	got, err := uc.RenameTag(ctx, 1, "in review")
+	if err != nil {
+		t.Fatalf("RenameTag returned error: %v", err)
+	}
+
+	if got.Name != "in review" {
+		t.Fatalf("RenameTag name = %q, want %q", got.Name, "in review")
+	}
+}
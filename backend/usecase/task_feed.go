@@ -0,0 +1,81 @@
+package usecase
+
+import (
+	"context"
+	"sync"
+
+	"backend/domain/apperr"
+	"backend/domain/auth"
+	"backend/domain/model"
+)
+
+// taskFeedBuffer is the number of events a subscriber may lag behind before it is dropped.
+const taskFeedBuffer = 64
+
+// ErrTaskWatchLagged is returned to a watcher that fell too far behind the feed.
+// The watcher should reload its data and subscribe again.
+var ErrTaskWatchLagged = apperr.Unavailable("task watch fell behind; subscribe again", nil)
+
+// TaskFeed is an in-process publish/subscribe hub for task changes. Use cases
+// publish every mutation to it and WatchTasks streams them to subscribers of
+// the same user.
+type TaskFeed struct {
+	mu          sync.Mutex
+	subscribers map[*taskSubscriber]struct{}
+}
+
+type taskSubscriber struct {
+	userID uint64
+	events chan model.TaskEvent
+}
+
+// NewTaskFeed creates an empty TaskFeed.
+func NewTaskFeed() *TaskFeed {
+	return &TaskFeed{subscribers: make(map[*taskSubscriber]struct{})}
+}
+
+// Subscribe registers a subscriber for the events of userID. The returned
+// channel is closed when cancel is called or when the subscriber falls so far
+// behind that events would be lost.
+func (f *TaskFeed) Subscribe(userID uint64) (events <-chan model.TaskEvent, cancel func()) {
+	sub := &taskSubscriber{userID: userID, events: make(chan model.TaskEvent, taskFeedBuffer)}
+
+	f.mu.Lock()
+	f.subscribers[sub] = struct{}{}
+	f.mu.Unlock()
+
+	return sub.events, func() { f.remove(sub) }
+}
+
+// Publish delivers the event to every subscriber of the calling user without blocking.
+func (f *TaskFeed) Publish(ctx context.Context, event model.TaskEvent) {
+	userID, ok := auth.UserIDFromContext(ctx)
+	if !ok {
+		return
+	}
+	event.UserID = userID
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	for sub := range f.subscribers {
+		if sub.userID != userID {
+			continue
+		}
+		select {
+		case sub.events <- event:
+		default:
+			// 取りこぼしを黙って許容せず、購読を終了して再購読させる
+			delete(f.subscribers, sub)
+			close(sub.events)
+		}
+	}
+}
+
+func (f *TaskFeed) remove(sub *taskSubscriber) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if _, ok := f.subscribers[sub]; ok {
+		delete(f.subscribers, sub)
+		close(sub.events)
+	}
+}
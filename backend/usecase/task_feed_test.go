@@ -0,0 +1,70 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+
+	"backend/domain/auth"
+	"backend/domain/model"
+)
+
+func TestTaskFeed_PublishesToSubscribersOfTheSameUser(t *testing.T) {
+	t.Parallel()
+
+	feed := NewTaskFeed()
+	alice, cancelAlice := feed.Subscribe(1)
+	defer cancelAlice()
+	bob, cancelBob := feed.Subscribe(2)
+	defer cancelBob()
+
+	feed.Publish(auth.WithUserID(context.Background(), 1), model.TaskEvent{Type: model.TaskDeleted, TaskID: 10})
+
+	select {
+	case event := <-alice:
+		if event.Type != model.TaskDeleted || event.TaskID != 10 || event.UserID != 1 {
+			t.Fatalf("event = %#v, want TaskDeleted of task 10 for user 1", event)
+		}
+	default:
+		t.Fatal("subscriber of the same user received nothing")
+	}
+	select {
+	case event := <-bob:
+		t.Fatalf("subscriber of another user received %#v", event)
+	default:
+	}
+}
+
+func TestTaskFeed_DropsLaggingSubscriber(t *testing.T) {
+	t.Parallel()
+
+	feed := NewTaskFeed()
+	events, cancel := feed.Subscribe(1)
+	defer cancel()
+
+	ctx := auth.WithUserID(context.Background(), 1)
+	for i := 0; i <= taskFeedBuffer; i++ {
+		feed.Publish(ctx, model.TaskEvent{Type: model.TaskUpdated, TaskID: uint64(i)})
+	}
+
+	for i := 0; i < taskFeedBuffer; i++ {
+		if _, ok := <-events; !ok {
+			t.Fatalf("channel closed after %d buffered events, want %d", i, taskFeedBuffer)
+		}
+	}
+	if _, ok := <-events; ok {
+		t.Fatal("lagging subscriber is still subscribed")
+	}
+}
+
+// publishedEvents returns the events already delivered to a subscription, without waiting for more.
+func publishedEvents(events <-chan model.TaskEvent) []model.TaskEvent {
+	var res []model.TaskEvent
+	for {
+		select {
+		case event := <-events:
+			res = append(res, event)
+		default:
+			return res
+		}
+	}
+}
@@ -0,0 +1,140 @@
+package usecase
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"time"
+
+	"backend/domain/model"
+	"backend/domain/repository"
+)
+
+// historyChanges accumulates the fields that differ between two versions of a task or subtask.
+type historyChanges struct {
+	taskID    uint64
+	subTaskID *uint64
+	entries   []model.TaskHistoryEntry
+}
+
+// compare records field as changed when old and new differ.
+func (c *historyChanges) compare(field string, old, new *string) {
+	if old == nil && new == nil || old != nil && new != nil && *old == *new {
+		return
+	}
+	c.entries = append(c.entries, model.TaskHistoryEntry{
+		TaskID:    c.taskID,
+		SubTaskID: c.subTaskID,
+		Action:    model.TaskHistoryUpdated,
+		Field:     field,
+		OldValue:  old,
+		NewValue:  new,
+	})
+}
+
+// taskChanges lists the fields changed between two versions of a task. Completion
+// timestamps follow the completed flag and are not recorded separately.
+func taskChanges(before, after model.Task) []model.TaskHistoryEntry {
+	c := historyChanges{taskID: after.ID}
+	c.compare("title", &before.Title, &after.Title)
+	c.compare("note", &before.Note, &after.Note)
+	c.compare("completed", historyInt(int64(before.Completed)), historyInt(int64(after.Completed)))
+	c.compare("category_id", historyID(before.CategoryID), historyID(after.CategoryID))
+	c.compare("due_date", historyDate(before.DueDate), historyDate(after.DueDate))
+	c.compare("recurrence", historyRecurrence(before.Recurrence), historyRecurrence(after.Recurrence))
+	c.compare("tag_ids", historyIDs(before.TagIDs), historyIDs(after.TagIDs))
+	c.compare("priority", historyInt(int64(before.Priority)), historyInt(int64(after.Priority)))
+	c.compare("sync_completion", historyBool(before.SyncCompletion), historyBool(after.SyncCompletion))
+	return c.entries
+}
+
+// subTaskChanges lists the fields changed between two versions of a subtask.
+func subTaskChanges(before, after model.SubTask) []model.TaskHistoryEntry {
+	subTaskID := after.ID
+	c := historyChanges{taskID: after.TaskID, subTaskID: &subTaskID}
+	c.compare("title", &before.Title, &after.Title)
+	c.compare("note", &before.Note, &after.Note)
+	c.compare("completed", historyInt(int64(before.Completed)), historyInt(int64(after.Completed)))
+	c.compare("due_date", historyDate(before.DueDate), historyDate(after.DueDate))
+	return c.entries
+}
+
+// subTaskOrderChange records a new order of the subtasks of a task, or returns nil when it is unchanged.
+func subTaskOrderChange(taskID uint64, before, after []uint64) []model.TaskHistoryEntry {
+	c := historyChanges{taskID: taskID}
+	c.compare("sub_task_ids", historyIDs(before), historyIDs(after))
+	return c.entries
+}
+
+// categoryMoves records that the tasks left category from for category to. A zero
+// to means the tasks no longer have a category.
+func categoryMoves(taskIDs []uint64, from, to uint64) []model.TaskHistoryEntry {
+	var entries []model.TaskHistoryEntry
+	for _, id := range taskIDs {
+		c := historyChanges{taskID: id}
+		c.compare("category_id", historyID(from), historyID(to))
+		entries = append(entries, c.entries...)
+	}
+	return entries
+}
+
+// historyAction builds an entry for a change other than a field update.
+func historyAction(action model.TaskHistoryAction, taskID uint64, subTaskID *uint64) []model.TaskHistoryEntry {
+	return []model.TaskHistoryEntry{{TaskID: taskID, SubTaskID: subTaskID, Action: action}}
+}
+
+func historyInt(n int64) *string {
+	s := strconv.FormatInt(n, 10)
+	return &s
+}
+
+func historyBool(b bool) *string {
+	s := strconv.FormatBool(b)
+	return &s
+}
+
+// historyID maps the zero id used for "none" to an unset value.
+func historyID(id uint64) *string {
+	if id == 0 {
+		return nil
+	}
+	s := strconv.FormatUint(id, 10)
+	return &s
+}
+
+// historyIDs renders ids as a comma separated list. An empty list is unset.
+func historyIDs(ids []uint64) *string {
+	if len(ids) == 0 {
+		return nil
+	}
+	parts := make([]string, 0, len(ids))
+	for _, id := range ids {
+		parts = append(parts, strconv.FormatUint(id, 10))
+	}
+	s := strings.Join(parts, ",")
+	return &s
+}
+
+func historyDate(t *time.Time) *string {
+	if t == nil {
+		return nil
+	}
+	s := t.Format("2006-01-02")
+	return &s
+}
+
+func historyRecurrence(r *model.Recurrence) *string {
+	if r == nil {
+		return nil
+	}
+	s := r.RRule()
+	return &s
+}
+
+// appendHistory is a no-op for empty entries so that callers need not check for changes first.
+func appendHistory(ctx context.Context, repo repository.TaskHistoryRepository, entries []model.TaskHistoryEntry) error {
+	if len(entries) == 0 {
+		return nil
+	}
+	return repo.Append(ctx, entries)
+}
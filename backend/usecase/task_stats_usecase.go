@@ -0,0 +1,106 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"backend/domain/model"
+	"backend/domain/repository"
+)
+
+const (
+	defaultStatsWeeks = 4
+	maxStatsWeeks     = 52
+)
+
+// TaskStatsUseCase computes statistics over the tasks of the calling user.
+type TaskStatsUseCase interface {
+	// GetTaskStats covers the last weeks weeks up to and including today. Zero weeks uses the
+	// default range. A non-nil categoryID restricts the statistics to one category, where zero
+	// selects the tasks without a category.
+	GetTaskStats(ctx context.Context, weeks int32, categoryID *uint64) (*model.TaskStats, error)
+}
+
+type taskStatsUseCase struct {
+	categoryRepo repository.CategoryRepository
+	uow          repository.UnitOfWork
+}
+
+// NewTaskStatsUseCase constructs a TaskStatsUseCase. The statistics are read through uow so
+// that they all describe the same state of the tasks.
+func NewTaskStatsUseCase(categoryRepo repository.CategoryRepository, uow repository.UnitOfWork) TaskStatsUseCase {
+	return &taskStatsUseCase{categoryRepo: categoryRepo, uow: uow}
+}
+
+func (uc *taskStatsUseCase) GetTaskStats(ctx context.Context, weeks int32, categoryID *uint64) (*model.TaskStats, error) {
+	if weeks == 0 {
+		weeks = defaultStatsWeeks
+	}
+
+	var v violations
+	if weeks < 1 || weeks > maxStatsWeeks {
+		v.add("weeks", fmt.Sprintf("must be between 1 and %d", maxStatsWeeks))
+	}
+	if categoryID != nil {
+		if err := v.checkCategory(ctx, uc.categoryRepo, "category_id", *categoryID); err != nil {
+			return nil, err
+		}
+	}
+	if err := v.err("invalid task stats request"); err != nil {
+		return nil, err
+	}
+
+	// days are counted in UTC, as the database does
+	now := time.Now().UTC()
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+	from := today.AddDate(0, 0, -int(weeks)*7+1)
+	filter := repository.TaskStatsFilter{CategoryID: categoryID, CompletedSince: from}
+
+	// 集計を 1 つのトランザクションで読み、途中の変更で数字が食い違わないようにする
+	var categories []model.CategoryTaskCount
+	var overdue int32
+	var perDay []model.DailyCompletionCount
+	var avg *time.Duration
+	err := uc.uow.Do(ctx, func(tx repository.Repositories) error {
+		var err error
+		if categories, err = tx.TaskStats.CountByCategory(ctx, filter); err != nil {
+			return err
+		}
+		if overdue, err = tx.TaskStats.CountOverdue(ctx, filter, today); err != nil {
+			return err
+		}
+		if perDay, err = tx.TaskStats.CompletedPerDay(ctx, filter); err != nil {
+			return err
+		}
+		avg, err = tx.TaskStats.AverageCompletionTime(ctx, filter)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &model.TaskStats{
+		From:                  from,
+		To:                    today,
+		Categories:            categories,
+		Overdue:               overdue,
+		CompletedPerDay:       fillCompletionDays(from, today, perDay),
+		AverageCompletionTime: avg,
+	}, nil
+}
+
+// fillCompletionDays returns one entry per day from from to to, taking the counts from days
+// and zero for the days it leaves out.
+func fillCompletionDays(from, to time.Time, days []model.DailyCompletionCount) []model.DailyCompletionCount {
+	byDate := make(map[string]int32, len(days))
+	for _, d := range days {
+		byDate[d.Date.Format("2006-01-02")] = d.Completed
+	}
+
+	var filled []model.DailyCompletionCount
+	for day := from; !day.After(to); day = day.AddDate(0, 0, 1) {
+		filled = append(filled, model.DailyCompletionCount{Date: day, Completed: byDate[day.Format("2006-01-02")]})
+	}
+	return filled
+}
@@ -0,0 +1,107 @@
+package usecase
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	"backend/domain/apperr"
+	"backend/domain/model"
+	"backend/domain/repository"
+	mockrepository "backend/domain/repository/mock"
+
+	"github.com/golang/mock/gomock"
+)
+
+func TestTaskStatsUseCase_GetTaskStats(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ctx := context.Background()
+	now := time.Now().UTC()
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+	from := today.AddDate(0, 0, -defaultStatsWeeks*7+1)
+	categoryID := uint64(3)
+	filter := repository.TaskStatsFilter{CategoryID: &categoryID, CompletedSince: from}
+	avg := 36 * time.Hour
+
+	mockCategoryRepo := mockrepository.NewMockCategoryRepository(ctrl)
+	mockCategoryRepo.EXPECT().FindCategoryByID(ctx, categoryID).Return(&model.Category{ID: categoryID}, nil)
+	mockRepo := mockrepository.NewMockTaskStatsRepository(ctrl)
+	mockRepo.EXPECT().CountByCategory(ctx, filter).Return([]model.CategoryTaskCount{{CategoryID: categoryID, Open: 2, Completed: 5}}, nil)
+	mockRepo.EXPECT().CountOverdue(ctx, filter, today).Return(int32(1), nil)
+	mockRepo.EXPECT().CompletedPerDay(ctx, filter).Return([]model.DailyCompletionCount{
+		{Date: from, Completed: 2},
+		{Date: today, Completed: 3},
+	}, nil)
+	mockRepo.EXPECT().AverageCompletionTime(ctx, filter).Return(&avg, nil)
+
+	uow := inlineUnitOfWork(ctrl, repository.Repositories{TaskStats: mockRepo})
+
+	uc := NewTaskStatsUseCase(mockCategoryRepo, uow)
+
+	stats, err := uc.GetTaskStats(ctx, 0, &categoryID)
+	if err != nil {
+		t.Fatalf("GetTaskStats returned error: %v", err)
+	}
+
+	if !stats.From.Equal(from) || !stats.To.Equal(today) {
+		t.Fatalf("range = %v to %v, want %v to %v", stats.From, stats.To, from, today)
+	}
+	if len(stats.CompletedPerDay) != defaultStatsWeeks*7 {
+		t.Fatalf("got %d days, want %d", len(stats.CompletedPerDay), defaultStatsWeeks*7)
+	}
+	var total int32
+	for _, d := range stats.CompletedPerDay {
+		total += d.Completed
+	}
+	first, last := stats.CompletedPerDay[0], stats.CompletedPerDay[len(stats.CompletedPerDay)-1]
+	if first.Completed != 2 || last.Completed != 3 || total != 5 {
+		t.Fatalf("completed per day = %v, want 2 on the first day, 3 today and none in between", stats.CompletedPerDay)
+	}
+	if stats.Overdue != 1 || len(stats.Categories) != 1 || *stats.AverageCompletionTime != avg {
+		t.Fatalf("stats = %+v", stats)
+	}
+}
+
+func TestTaskStatsUseCase_GetTaskStats_Invalid(t *testing.T) {
+	t.Parallel()
+
+	missing := uint64(99)
+	tests := []struct {
+		name       string
+		weeks      int32
+		categoryID *uint64
+		wantFields []string
+	}{
+		{name: "negative weeks", weeks: -1, wantFields: []string{"weeks"}},
+		{name: "too many weeks", weeks: maxStatsWeeks + 1, wantFields: []string{"weeks"}},
+		{name: "missing category", weeks: 1, categoryID: &missing, wantFields: []string{"category_id"}},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			ctx := context.Background()
+			mockCategoryRepo := mockrepository.NewMockCategoryRepository(ctrl)
+			if tt.categoryID != nil {
+				mockCategoryRepo.EXPECT().FindCategoryByID(ctx, *tt.categoryID).Return(nil, apperr.NotFound("category", *tt.categoryID))
+			}
+
+			uc := NewTaskStatsUseCase(mockCategoryRepo, mockrepository.NewMockUnitOfWork(ctrl))
+
+			_, err := uc.GetTaskStats(ctx, tt.weeks, tt.categoryID)
+			if got := violatedFields(t, err); !reflect.DeepEqual(got, tt.wantFields) {
+				t.Fatalf("violated fields = %v, want %v", got, tt.wantFields)
+			}
+		})
+	}
+}
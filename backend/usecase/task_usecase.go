@@ -2,50 +2,456 @@ package usecase
 
 import (
 	"context"
+	"fmt"
+	"strings"
 	"time"
+	"unicode/utf8"
 
+	"backend/domain/apperr"
+	"backend/domain/auth"
 	"backend/domain/model"
 	"backend/domain/repository"
 )
 
+const (
+	defaultTaskPageSize = 50
+	maxTaskPageSize     = 100
+
+	// maxNewSubTasks caps how many subtasks CreateTask accepts with a new task.
+	maxNewSubTasks = 100
+
+	// minSearchQueryLength mirrors the ngram_token_size of the FULLTEXT parser;
+	// shorter queries can never match.
+	minSearchQueryLength = 2
+	maxSearchQueryLength = 255
+)
+
+// ErrInvalidPageSize is returned when a negative page size is requested.
+var ErrInvalidPageSize = apperr.InvalidArgument("page size must not be negative", apperr.FieldViolation{
+	Field:       "page_size",
+	Description: "must not be negative",
+})
+
 // TaskUseCase defines the business logic contract for tasks.
 type TaskUseCase interface {
-	ListTasks(ctx context.Context, filter repository.TaskFilter) ([]model.Task, error)
+	ListTasksPage(ctx context.Context, filter repository.TaskFilter, page repository.PageRequest) (*repository.TaskPage, error)
+	ListTasksNeedingAttention(ctx context.Context) ([]model.Task, error)
+	GetTask(ctx context.Context, id uint64) (*model.Task, error)
+	// BatchGetTasks returns the listed tasks in the order given. Unknown ids are left out.
+	BatchGetTasks(ctx context.Context, ids []uint64) ([]model.Task, error)
+	SearchTasks(ctx context.Context, query string, page repository.PageRequest) (*repository.TaskSearchPage, error)
 	CreateTask(ctx context.Context, in model.Task) (*model.Task, error)
 	UpdateTask(ctx context.Context, in model.UpdateTaskRequest) (*model.Task, error)
 	DeleteTask(ctx context.Context, id uint64) error
+	// BulkUpdateTasks and BulkDeleteTasks change many tasks at once. They are all or nothing and
+	// report the outcome for every task.
+	BulkUpdateTasks(ctx context.Context, target BulkTaskTarget, change model.BulkTaskChange) (*model.BulkTaskResult, error)
+	BulkDeleteTasks(ctx context.Context, target BulkTaskTarget) (*model.BulkTaskResult, error)
+	ListDeletedTasks(ctx context.Context) ([]model.Task, error)
+	RestoreTask(ctx context.Context, id uint64) (*model.Task, error)
+	PurgeTask(ctx context.Context, id uint64) error
+	PurgeExpiredTasks(ctx context.Context, retention time.Duration) (int64, error)
+	WatchTasks(ctx context.Context) (<-chan model.TaskEvent, func(), error)
+	ListTaskHistory(ctx context.Context, taskID uint64) ([]model.TaskHistoryEntry, error)
 }
 
 type taskUseCase struct {
-	repo repository.TaskRepository
+	repo         repository.TaskRepository
+	categoryRepo repository.CategoryRepository
+	subTaskRepo  repository.SubTaskRepository
+	historyRepo  repository.TaskHistoryRepository
+	uow          repository.UnitOfWork
+	feed         *TaskFeed
+}
+
+// NewTaskUseCase constructs a TaskUseCase implementation publishing its changes to feed.
+// Changes are written through uow together with their history entries.
+func NewTaskUseCase(repo repository.TaskRepository, categoryRepo repository.CategoryRepository, subTaskRepo repository.SubTaskRepository, historyRepo repository.TaskHistoryRepository, uow repository.UnitOfWork, feed *TaskFeed) TaskUseCase {
+	return &taskUseCase{repo: repo, categoryRepo: categoryRepo, subTaskRepo: subTaskRepo, historyRepo: historyRepo, uow: uow, feed: feed}
+}
+
+// GetTask returns a single task.
+func (uc *taskUseCase) GetTask(ctx context.Context, id uint64) (*model.Task, error) {
+	return uc.repo.FindByID(ctx, id)
+}
+
+// BatchGetTasks returns the listed tasks in the order given. Unknown ids are left out.
+func (uc *taskUseCase) BatchGetTasks(ctx context.Context, ids []uint64) ([]model.Task, error) {
+	ids = uniqueIDs(ids)
+	if len(ids) > maxTaskPageSize {
+		return nil, apperr.InvalidArgument("too many task ids", apperr.FieldViolation{
+			Field:       "ids",
+			Description: fmt.Sprintf("must list at most %d tasks", maxTaskPageSize),
+		})
+	}
+	if len(ids) == 0 {
+		return []model.Task{}, nil
+	}
+
+	found, err := uc.repo.FindAll(ctx, repository.TaskFilter{IDs: ids})
+	if err != nil {
+		return nil, err
+	}
+	byID := make(map[uint64]model.Task, len(found))
+	for _, task := range found {
+		byID[task.ID] = task
+	}
+	tasks := make([]model.Task, 0, len(found))
+	for _, id := range ids {
+		if task, ok := byID[id]; ok {
+			tasks = append(tasks, task)
+		}
+	}
+	return tasks, nil
+}
+
+// ListTasksPage returns a single page of tasks, clamping the page size to the allowed range.
+func (uc *taskUseCase) ListTasksPage(ctx context.Context, filter repository.TaskFilter, page repository.PageRequest) (*repository.TaskPage, error) {
+	// a repeated tag would never be matched by every task under TagMatchAll
+	filter.TagIDs = uniqueIDs(filter.TagIDs)
+
+	var v violations
+	v.checkListing(filter)
+	if err := v.err("invalid task listing"); err != nil {
+		return nil, err
+	}
+	page, err := clampPageSize(page)
+	if err != nil {
+		return nil, err
+	}
+
+	return uc.repo.FindPage(ctx, filter, page)
+}
+
+// ListTasksNeedingAttention returns the open tasks of high priority or above that are
+// past their due date, most important first and then the longest overdue.
+func (uc *taskUseCase) ListTasksNeedingAttention(ctx context.Context) ([]model.Task, error) {
+	now := time.Now()
+	yesterday := time.Date(now.Year(), now.Month(), now.Day()-1, 0, 0, 0, 0, now.Location())
+	incompleteOnly := true
+	minPriority := model.PriorityHigh
+
+	return uc.repo.FindAll(ctx, repository.TaskFilter{
+		DueDateTo:      &yesterday,
+		IncompleteOnly: &incompleteOnly,
+		MinPriority:    &minPriority,
+		OrderBy: []repository.TaskOrder{
+			{Field: repository.TaskOrderPriority, Desc: true},
+			{Field: repository.TaskOrderDueDate},
+		},
+	})
+}
+
+// SearchTasks runs a full-text search and highlights where each result matched.
+// The subtasks of every result are loaded, since they take part in the match.
+func (uc *taskUseCase) SearchTasks(ctx context.Context, query string, page repository.PageRequest) (*repository.TaskSearchPage, error) {
+	query = strings.TrimSpace(query)
+
+	var v violations
+	switch n := utf8.RuneCountInString(query); {
+	case n < minSearchQueryLength:
+		v.add("query", fmt.Sprintf("must be at least %d characters", minSearchQueryLength))
+	case n > maxSearchQueryLength:
+		v.add("query", fmt.Sprintf("must be at most %d characters", maxSearchQueryLength))
+	}
+	if err := v.err("invalid search query"); err != nil {
+		return nil, err
+	}
+	page, err := clampPageSize(page)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := uc.repo.Search(ctx, query, page)
+	if err != nil {
+		return nil, err
+	}
+	if len(res.Results) == 0 {
+		return res, nil
+	}
+
+	ids := make([]uint64, 0, len(res.Results))
+	for _, r := range res.Results {
+		ids = append(ids, r.Task.ID)
+	}
+	subTasks, err := uc.subTaskRepo.ListByTaskIDs(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	terms := searchTerms(query)
+	for i := range res.Results {
+		result := &res.Results[i]
+		result.Task.SubTasks = subTasks[result.Task.ID]
+		result.Highlights = taskHighlights(result.Task, terms)
+	}
+	return res, nil
 }
 
-// NewTaskUseCase constructs a TaskUseCase implementation.
-func NewTaskUseCase(repo repository.TaskRepository) TaskUseCase {
-	return &taskUseCase{repo: repo}
+// taskHighlights collects a snippet for every field of task containing one of terms.
+func taskHighlights(task model.Task, terms [][]rune) []model.SearchHighlight {
+	var res []model.SearchHighlight
+	if snippet, ok := highlight(task.Title, terms); ok {
+		res = append(res, model.SearchHighlight{Field: "title", Snippet: snippet})
+	}
+	if snippet, ok := highlight(task.Note, terms); ok {
+		res = append(res, model.SearchHighlight{Field: "note", Snippet: snippet})
+	}
+	for _, st := range task.SubTasks {
+		if snippet, ok := highlight(st.Title, terms); ok {
+			res = append(res, model.SearchHighlight{Field: "sub_tasks.title", Snippet: snippet})
+		}
+	}
+	return res
 }
 
-// ListTasks returns all tasks.
-func (uc *taskUseCase) ListTasks(ctx context.Context, filter repository.TaskFilter) ([]model.Task, error) {
-	return uc.repo.FindAll(ctx, filter)
+// clampPageSize applies the default page size and caps it to the allowed maximum.
+func clampPageSize(page repository.PageRequest) (repository.PageRequest, error) {
+	switch {
+	case page.Size < 0:
+		return page, ErrInvalidPageSize
+	case page.Size == 0:
+		page.Size = defaultTaskPageSize
+	case page.Size > maxTaskPageSize:
+		page.Size = maxTaskPageSize
+	}
+	return page, nil
 }
 
-// CreateTask creates and persists a new task.
+// CreateTask creates and persists a new task. Subtasks given in in.SubTasks are
+// created in the same transaction, in the order listed.
 func (uc *taskUseCase) CreateTask(ctx context.Context, in model.Task) (*model.Task, error) {
-	return uc.repo.Create(ctx, in)
+	in.TagIDs = uniqueIDs(in.TagIDs)
+
+	var v violations
+	v.checkTitle("title", in.Title)
+	v.checkNote("note", in.Note)
+	v.checkDueDate("due_date", in.DueDate)
+	v.checkRecurrence("recurrence", in.Recurrence, in.DueDate)
+	v.checkPriority("priority", in.Priority)
+	v.checkNewSubTasks("sub_tasks", in.SubTasks)
+	if err := v.checkCategory(ctx, uc.categoryRepo, "category_id", in.CategoryID); err != nil {
+		return nil, err
+	}
+	if err := v.err("invalid task"); err != nil {
+		return nil, err
+	}
+
+	in.Title = strings.TrimSpace(in.Title)
+	if len(in.SubTasks) > 0 {
+		subTasks := make([]model.SubTask, len(in.SubTasks))
+		for i, st := range in.SubTasks {
+			subTasks[i] = model.SubTask{
+				Position: int32(i + 1),
+				Title:    strings.TrimSpace(st.Title),
+				Note:     st.Note,
+				DueDate:  st.DueDate,
+			}
+		}
+		in.SubTasks = subTasks
+	}
+
+	var task *model.Task
+	err := uc.uow.Do(ctx, func(tx repository.Repositories) error {
+		if err := checkTaskTags(ctx, tx.Tags, in.TagIDs); err != nil {
+			return err
+		}
+
+		var err error
+		if len(in.SubTasks) > 0 {
+			task, err = tx.Tasks.CreateWithSubTasks(ctx, in)
+		} else {
+			task, err = tx.Tasks.Create(ctx, in)
+		}
+		if err != nil {
+			return err
+		}
+		entries := historyAction(model.TaskHistoryCreated, task.ID, nil)
+		for i := range task.SubTasks {
+			entries = append(entries, historyAction(model.TaskHistoryCreated, task.ID, &task.SubTasks[i].ID)...)
+		}
+		return appendHistory(ctx, tx.TaskHistory, entries)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	uc.feed.Publish(ctx, model.TaskEvent{Type: model.TaskCreated, TaskID: task.ID, Task: task})
+	return task, nil
 }
 
 // UpdateTask updates an existing task.
 func (uc *taskUseCase) UpdateTask(ctx context.Context, in model.UpdateTaskRequest) (*model.Task, error) {
-	// 1. 既存データを取得
-	task, err := uc.repo.FindByID(ctx, in.ID)
+	// 1. 指定された項目のみ検証
+	in.TagIDs = uniqueIDs(in.TagIDs)
+
+	var v violations
+	if in.Title != nil {
+		v.checkTitle("title", *in.Title)
+	}
+	if in.Note != nil {
+		v.checkNote("note", *in.Note)
+	}
+	if in.ClearDueDate {
+		in.DueDate = nil
+	}
+	v.checkDueDate("due_date", in.DueDate)
+	if !in.ClearRecurrence {
+		v.checkRecurrence("recurrence", in.Recurrence, in.DueDate)
+	}
+	if in.Priority != nil {
+		v.checkPriority("priority", *in.Priority)
+	}
+	if in.ClearCategory {
+		in.CategoryID = nil
+	}
+	if in.CategoryID != nil {
+		if err := v.checkCategory(ctx, uc.categoryRepo, "category_id", *in.CategoryID); err != nil {
+			return nil, err
+		}
+	}
+	if err := v.err("invalid task"); err != nil {
+		return nil, err
+	}
+
+	// 2〜4 は変更履歴と合わせて 1 つのトランザクションで行う
+	var saved *savedTaskUpdate
+	err := uc.uow.Do(ctx, func(tx repository.Repositories) error {
+		if err := checkTaskTags(ctx, tx.Tags, in.TagIDs); err != nil {
+			return err
+		}
+
+		// 2. 既存データを取得
+		task, err := tx.Tasks.FindByID(ctx, in.ID)
+		if err != nil {
+			return err
+		}
+		if err := checkTaskVersion(task, in.ExpectedVersion); err != nil {
+			return err
+		}
+
+		// 3〜4. nil でない項目のみ更新して保存
+		saved, err = saveTaskUpdate(ctx, tx, task, in)
+		return err
+	})
 	if err != nil {
 		return nil, err
 	}
 
-	// 2. nil でない項目のみ更新
+	saved.publish(ctx, uc.feed)
+	return saved.task, nil
+}
+
+// checkTaskTags rejects tagIDs unless they all reference the user's tags. It runs inside the
+// unit of work that links the tags, so that a tag deleted meanwhile is not linked to the task.
+func checkTaskTags(ctx context.Context, tags repository.TagRepository, tagIDs []uint64) error {
+	var v violations
+	if err := v.checkTags(ctx, tags, "tag_ids", tagIDs); err != nil {
+		return err
+	}
+	return v.err("invalid task")
+}
+
+// savedTaskUpdate is what saveTaskUpdate wrote: the updated task, the next occurrence created
+// when a recurring task was completed, and the subtasks completed together with the task.
+type savedTaskUpdate struct {
+	task     *model.Task
+	next     *model.Task
+	subTasks []model.SubTask
+}
+
+// publish announces the saved changes. It is called once they have been committed.
+func (s *savedTaskUpdate) publish(ctx context.Context, feed *TaskFeed) {
+	for i := range s.subTasks {
+		feed.Publish(ctx, model.TaskEvent{Type: model.SubTaskToggled, TaskID: s.task.ID, SubTask: &s.subTasks[i]})
+	}
+	feed.Publish(ctx, model.TaskEvent{Type: model.TaskUpdated, TaskID: s.task.ID, Task: s.task})
+	if s.next != nil {
+		feed.Publish(ctx, model.TaskEvent{Type: model.TaskCreated, TaskID: s.next.ID, Task: s.next})
+	}
+}
+
+// saveTaskUpdate applies in to task, which was loaded through tx, and saves it together with its
+// history. When this completes a recurring task, the next occurrence is created as well.
+// Completing a task with SyncCompletion also completes its open subtasks.
+func saveTaskUpdate(ctx context.Context, tx repository.Repositories, task *model.Task, in model.UpdateTaskRequest) (*savedTaskUpdate, error) {
+	before := *task
+	applyTaskUpdate(task, in)
+
+	saved := &savedTaskUpdate{}
+	if before.Completed == 0 && task.Completed != 0 && task.SyncCompletion {
+		subTasks, err := completeOpenSubTasks(ctx, tx, task.ID)
+		if err != nil {
+			return nil, err
+		}
+		saved.subTasks = subTasks
+	}
+
+	// 繰り返しタスクが完了したら次の回を作成する。
+	// スケジュールは次の回に引き継ぎ、完了したタスクからは外す
+	var occurrence *model.Task
+	if before.Completed == 0 && task.Completed != 0 && task.Recurrence != nil {
+		var err error
+		if occurrence, err = nextOccurrence(ctx, tx.SubTasks, *task); err != nil {
+			return nil, err
+		}
+		task.Recurrence = nil
+	}
+
+	res, err := tx.Tasks.Update(ctx, *task)
+	if err != nil {
+		return nil, err
+	}
+	saved.task = res
+	if err := appendHistory(ctx, tx.TaskHistory, taskChanges(before, *task)); err != nil {
+		return nil, err
+	}
+
+	if occurrence != nil {
+		if saved.next, err = tx.Tasks.CreateWithSubTasks(ctx, *occurrence); err != nil {
+			return nil, err
+		}
+		if err := appendHistory(ctx, tx.TaskHistory, historyAction(model.TaskHistoryCreated, saved.next.ID, nil)); err != nil {
+			return nil, err
+		}
+	}
+	return saved, nil
+}
+
+// completeOpenSubTasks marks every open subtask of the task as completed and returns them.
+func completeOpenSubTasks(ctx context.Context, tx repository.Repositories, taskID uint64) ([]model.SubTask, error) {
+	subTasks, err := tx.SubTasks.ListByTaskID(ctx, taskID)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	var completed []model.SubTask
+	var entries []model.TaskHistoryEntry
+	for _, st := range subTasks {
+		if st.Completed != 0 {
+			continue
+		}
+		before := st
+		st.Completed = 1
+		st.CompletedAt = &now
+		res, err := tx.SubTasks.Update(ctx, st)
+		if err != nil {
+			return nil, err
+		}
+		completed = append(completed, *res)
+		entries = append(entries, subTaskChanges(before, st)...)
+	}
+	if err := appendHistory(ctx, tx.TaskHistory, entries); err != nil {
+		return nil, err
+	}
+	return completed, nil
+}
+
+// applyTaskUpdate copies the fields set in in onto task.
+func applyTaskUpdate(task *model.Task, in model.UpdateTaskRequest) {
 	if in.Title != nil {
-		task.Title = *in.Title
+		task.Title = strings.TrimSpace(*in.Title)
 	}
 	if in.Note != nil {
 		task.Note = *in.Note
@@ -62,23 +468,192 @@ func (uc *taskUseCase) UpdateTask(ctx context.Context, in model.UpdateTaskReques
 	if in.CompletedAt != nil {
 		task.CompletedAt = in.CompletedAt
 	}
-	if in.CategoryID != nil {
+	if in.ClearCategory {
+		task.CategoryID = 0
+	} else if in.CategoryID != nil {
 		task.CategoryID = *in.CategoryID
 	}
-	if in.DueDate != nil {
+	dueBefore := task.DueDate
+	if in.ClearDueDate {
+		task.DueDate = nil
+	} else if in.DueDate != nil {
 		task.DueDate = in.DueDate
 	}
+	// Sending the current schedule back keeps the date it is pinned to. Moving the due
+	// date starts the schedule afresh from the new one.
+	if in.ClearRecurrence {
+		task.Recurrence = nil
+	} else if in.Recurrence != nil && (task.Recurrence == nil || in.Recurrence.RRule() != task.Recurrence.Unanchored().RRule()) {
+		task.Recurrence = in.Recurrence
+	}
+	if task.Recurrence != nil && !sameDate(dueBefore, task.DueDate) {
+		rule := task.Recurrence.Unanchored()
+		task.Recurrence = &rule
+	}
+	if in.TagIDs != nil {
+		task.TagIDs = in.TagIDs
+	}
+	if in.Priority != nil {
+		task.Priority = *in.Priority
+	}
+	if in.SyncCompletion != nil {
+		task.SyncCompletion = *in.SyncCompletion
+	}
+}
+
+// sameDate reports whether a and b are both unset or fall on the same day.
+func sameDate(a, b *time.Time) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return a.Format("2006-01-02") == b.Format("2006-01-02")
+}
+
+// nextOccurrence builds the task that follows the completed recurring task, or returns nil
+// when its schedule has ended. Subtasks are copied as open and their due dates move by the
+// same number of days as the task. Tasks without a due date recur from today. The schedule
+// is pinned to the date of the first occurrence, so that later ones keep its day of month.
+func nextOccurrence(ctx context.Context, subTaskRepo repository.SubTaskRepository, task model.Task) (*model.Task, error) {
+	from := task.DueDate
+	if from == nil {
+		now := time.Now()
+		today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+		from = &today
+	}
+	rule := task.Recurrence.AnchoredAt(*from)
+	due, ok := rule.Next(*from)
+	if !ok {
+		return nil, nil
+	}
+	shift := int(due.Sub(*from).Hours()+12) / 24
 
-	// 3. リポジトリ層に保存
-	res, err := uc.repo.Update(ctx, *task)
+	subTasks, err := subTaskRepo.ListByTaskID(ctx, task.ID)
 	if err != nil {
 		return nil, err
 	}
 
-	return res, nil
+	next := &model.Task{
+		Title:          task.Title,
+		Note:           task.Note,
+		CategoryID:     task.CategoryID,
+		DueDate:        &due,
+		Recurrence:     &rule,
+		TagIDs:         task.TagIDs,
+		Priority:       task.Priority,
+		SubTasks:       make([]model.SubTask, 0, len(subTasks)),
+		SyncCompletion: task.SyncCompletion,
+	}
+	for _, st := range subTasks {
+		copied := model.SubTask{
+			Position: st.Position,
+			Title:    st.Title,
+			Note:     st.Note,
+		}
+		if st.DueDate != nil {
+			d := st.DueDate.AddDate(0, 0, shift)
+			copied.DueDate = &d
+		}
+		next.SubTasks = append(next.SubTasks, copied)
+	}
+	return next, nil
 }
 
-// DeleteTask removes a task by id.
+// DeleteTask moves a task to the trash.
 func (uc *taskUseCase) DeleteTask(ctx context.Context, id uint64) error {
-	return uc.repo.Delete(ctx, id)
+	err := uc.uow.Do(ctx, func(tx repository.Repositories) error {
+		if err := tx.Tasks.Delete(ctx, id); err != nil {
+			return err
+		}
+		return appendHistory(ctx, tx.TaskHistory, historyAction(model.TaskHistoryDeleted, id, nil))
+	})
+	if err != nil {
+		return err
+	}
+
+	uc.feed.Publish(ctx, model.TaskEvent{Type: model.TaskDeleted, TaskID: id})
+	return nil
+}
+
+// ListDeletedTasks returns the tasks currently in the trash.
+func (uc *taskUseCase) ListDeletedTasks(ctx context.Context) ([]model.Task, error) {
+	return uc.repo.FindDeleted(ctx)
+}
+
+// RestoreTask takes a task out of the trash.
+func (uc *taskUseCase) RestoreTask(ctx context.Context, id uint64) (*model.Task, error) {
+	var task *model.Task
+	err := uc.uow.Do(ctx, func(tx repository.Repositories) error {
+		var err error
+		if task, err = tx.Tasks.Restore(ctx, id); err != nil {
+			return err
+		}
+		return appendHistory(ctx, tx.TaskHistory, historyAction(model.TaskHistoryRestored, id, nil))
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// 購読側から見るとゴミ箱から戻ったタスクは新規作成と同じ扱いになる
+	uc.feed.Publish(ctx, model.TaskEvent{Type: model.TaskCreated, TaskID: task.ID, Task: task})
+	return task, nil
+}
+
+// PurgeTask permanently removes a trashed task. Its history is kept and records the purge.
+func (uc *taskUseCase) PurgeTask(ctx context.Context, id uint64) error {
+	return uc.uow.Do(ctx, func(tx repository.Repositories) error {
+		if err := tx.Tasks.Purge(ctx, id); err != nil {
+			return err
+		}
+		return appendHistory(ctx, tx.TaskHistory, historyAction(model.TaskHistoryPurged, id, nil))
+	})
+}
+
+// PurgeExpiredTasks permanently removes tasks that have been in the trash longer than retention.
+// As with PurgeTask, their history is kept and records the purge, on behalf of each task's owner.
+func (uc *taskUseCase) PurgeExpiredTasks(ctx context.Context, retention time.Duration) (int64, error) {
+	cutoff := time.Now().Add(-retention)
+
+	var purged int64
+	err := uc.uow.Do(ctx, func(tx repository.Repositories) error {
+		expired, err := tx.Tasks.FindDeletedBefore(ctx, cutoff)
+		if err != nil {
+			return err
+		}
+		for _, task := range expired {
+			ownerCtx := auth.WithUserID(ctx, task.UserID)
+			if err := tx.Tasks.Purge(ownerCtx, task.ID); err != nil {
+				return err
+			}
+			if err := appendHistory(ownerCtx, tx.TaskHistory, historyAction(model.TaskHistoryPurged, task.ID, nil)); err != nil {
+				return err
+			}
+		}
+		purged = int64(len(expired))
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+	return purged, nil
+}
+
+// WatchTasks subscribes the calling user to the changes of their tasks.
+// The returned cancel function must be called once the caller stops reading.
+func (uc *taskUseCase) WatchTasks(ctx context.Context) (<-chan model.TaskEvent, func(), error) {
+	userID, ok := auth.UserIDFromContext(ctx)
+	if !ok {
+		return nil, nil, apperr.Unauthenticated("no user in request context")
+	}
+
+	events, cancel := uc.feed.Subscribe(userID)
+	return events, cancel, nil
+}
+
+// ListTaskHistory returns the changes made to a task and its subtasks, most recent first.
+func (uc *taskUseCase) ListTaskHistory(ctx context.Context, taskID uint64) ([]model.TaskHistoryEntry, error) {
+	if _, err := uc.repo.FindByID(ctx, taskID); err != nil {
+		return nil, err
+	}
+
+	return uc.historyRepo.ListByTaskID(ctx, taskID)
 }
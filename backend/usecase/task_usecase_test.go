@@ -0,0 +1,1136 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"backend/domain/apperr"
+	"backend/domain/auth"
+	"backend/domain/model"
+	"backend/domain/repository"
+	mockrepository "backend/domain/repository/mock"
+
+	"github.com/golang/mock/gomock"
+)
+
+func TestTaskUseCase_ListTasksPage(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		size     int
+		wantSize int
+		wantErr  error
+	}{
+		{
+			name:     "default page size",
+			size:     0,
+			wantSize: defaultTaskPageSize,
+		},
+		{
+			name:     "requested page size",
+			size:     10,
+			wantSize: 10,
+		},
+		{
+			name:     "clamped to maximum",
+			size:     maxTaskPageSize + 1,
+			wantSize: maxTaskPageSize,
+		},
+		{
+			name:    "negative page size",
+			size:    -1,
+			wantErr: ErrInvalidPageSize,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			ctx := context.Background()
+			filter := repository.TaskFilter{}
+			mockRepo := mockrepository.NewMockTaskRepository(ctrl)
+			if tt.wantErr == nil {
+				mockRepo.EXPECT().
+					FindPage(ctx, filter, repository.PageRequest{Size: tt.wantSize, Token: "token"}).
+					Return(&repository.TaskPage{}, nil)
+			}
+
+			uc := NewTaskUseCase(mockRepo, mockrepository.NewMockCategoryRepository(ctrl), mockrepository.NewMockSubTaskRepository(ctrl), mockrepository.NewMockTaskHistoryRepository(ctrl), mockrepository.NewMockUnitOfWork(ctrl), NewTaskFeed())
+
+			_, err := uc.ListTasksPage(ctx, filter, repository.PageRequest{Size: tt.size, Token: "token"})
+
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("ListTasksPage error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestTaskUseCase_ListTasksPage_DuplicateTagIDs(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ctx := context.Background()
+	filter := repository.TaskFilter{TagIDs: []uint64{1, 2, 1}, TagMatch: repository.TagMatchAll}
+	want := repository.TaskFilter{TagIDs: []uint64{1, 2}, TagMatch: repository.TagMatchAll}
+	mockRepo := mockrepository.NewMockTaskRepository(ctrl)
+	mockRepo.EXPECT().FindPage(ctx, want, repository.PageRequest{Size: defaultTaskPageSize}).Return(&repository.TaskPage{}, nil)
+
+	uc := NewTaskUseCase(mockRepo, mockrepository.NewMockCategoryRepository(ctrl), mockrepository.NewMockSubTaskRepository(ctrl), mockrepository.NewMockTaskHistoryRepository(ctrl), mockrepository.NewMockUnitOfWork(ctrl), NewTaskFeed())
+
+	if _, err := uc.ListTasksPage(ctx, filter, repository.PageRequest{}); err != nil {
+		t.Fatalf("ListTasksPage returned error: %v", err)
+	}
+}
+
+func TestTaskUseCase_ListTasksPage_InvalidFilter(t *testing.T) {
+	t.Parallel()
+
+	unknownPriority := model.PriorityUrgent + 1
+
+	tests := []struct {
+		name   string
+		filter repository.TaskFilter
+		want   []string
+	}{
+		{
+			name:   "unknown order field",
+			filter: repository.TaskFilter{OrderBy: []repository.TaskOrder{{Field: 0}}},
+			want:   []string{"order_by"},
+		},
+		{
+			name: "repeated order field",
+			filter: repository.TaskFilter{OrderBy: []repository.TaskOrder{
+				{Field: repository.TaskOrderTitle},
+				{Field: repository.TaskOrderTitle, Desc: true},
+			}},
+			want: []string{"order_by"},
+		},
+		{
+			name:   "unknown minimum priority",
+			filter: repository.TaskFilter{MinPriority: &unknownPriority},
+			want:   []string{"min_priority"},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			uc := NewTaskUseCase(mockrepository.NewMockTaskRepository(ctrl), mockrepository.NewMockCategoryRepository(ctrl), mockrepository.NewMockSubTaskRepository(ctrl), mockrepository.NewMockTaskHistoryRepository(ctrl), mockrepository.NewMockUnitOfWork(ctrl), NewTaskFeed())
+
+			_, err := uc.ListTasksPage(context.Background(), tt.filter, repository.PageRequest{})
+
+			if got := violatedFields(t, err); !reflect.DeepEqual(got, tt.want) {
+				t.Fatalf("violated fields = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTaskUseCase_ListTasksNeedingAttention(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ctx := context.Background()
+	now := time.Now()
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+
+	mockRepo := mockrepository.NewMockTaskRepository(ctrl)
+	mockRepo.EXPECT().FindAll(ctx, gomock.Any()).DoAndReturn(func(_ context.Context, filter repository.TaskFilter) ([]model.Task, error) {
+		if filter.IncompleteOnly == nil || !*filter.IncompleteOnly {
+			t.Errorf("IncompleteOnly = %v, want true", filter.IncompleteOnly)
+		}
+		if filter.MinPriority == nil || *filter.MinPriority != model.PriorityHigh {
+			t.Errorf("MinPriority = %v, want high", filter.MinPriority)
+		}
+		if filter.DueDateTo == nil || !filter.DueDateTo.Before(today) {
+			t.Errorf("DueDateTo = %v, want a day before %v", filter.DueDateTo, today)
+		}
+		wantOrder := []repository.TaskOrder{
+			{Field: repository.TaskOrderPriority, Desc: true},
+			{Field: repository.TaskOrderDueDate},
+		}
+		if !reflect.DeepEqual(filter.OrderBy, wantOrder) {
+			t.Errorf("OrderBy = %v, want %v", filter.OrderBy, wantOrder)
+		}
+		return []model.Task{{ID: 1, Priority: model.PriorityUrgent}}, nil
+	})
+
+	uc := NewTaskUseCase(mockRepo, mockrepository.NewMockCategoryRepository(ctrl), mockrepository.NewMockSubTaskRepository(ctrl), mockrepository.NewMockTaskHistoryRepository(ctrl), mockrepository.NewMockUnitOfWork(ctrl), NewTaskFeed())
+
+	tasks, err := uc.ListTasksNeedingAttention(ctx)
+	if err != nil {
+		t.Fatalf("ListTasksNeedingAttention returned error: %v", err)
+	}
+	if len(tasks) != 1 || tasks[0].ID != 1 {
+		t.Fatalf("tasks = %v, want task 1", tasks)
+	}
+}
+
+func TestTaskUseCase_BatchGetTasks(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ctx := context.Background()
+	mockRepo := mockrepository.NewMockTaskRepository(ctrl)
+	mockRepo.EXPECT().
+		FindAll(ctx, repository.TaskFilter{IDs: []uint64{3, 1, 2}}).
+		Return([]model.Task{{ID: 1}, {ID: 3}}, nil)
+
+	uc := NewTaskUseCase(mockRepo, mockrepository.NewMockCategoryRepository(ctrl), mockrepository.NewMockSubTaskRepository(ctrl), mockrepository.NewMockTaskHistoryRepository(ctrl), mockrepository.NewMockUnitOfWork(ctrl), NewTaskFeed())
+
+	// the requested order is kept, repeated ids are returned once and unknown ones are left out
+	got, err := uc.BatchGetTasks(ctx, []uint64{3, 1, 3, 2})
+	if err != nil {
+		t.Fatalf("BatchGetTasks returned error: %v", err)
+	}
+
+	want := []model.Task{{ID: 3}, {ID: 1}}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("BatchGetTasks = %#v, want %#v", got, want)
+	}
+}
+
+func TestTaskUseCase_CreateTask(t *testing.T) {
+	t.Parallel()
+
+	yearOne := time.Date(1, time.January, 1, 0, 0, 0, 0, time.UTC)
+	dueDate := time.Date(2025, time.March, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name           string
+		in             model.Task
+		categoryExists bool
+		knownTags      []uint64
+		wantFields     []string
+	}{
+		{
+			name:           "valid task",
+			in:             model.Task{Title: "  write report  ", CategoryID: 1, DueDate: &dueDate},
+			categoryExists: true,
+		},
+		{
+			name:       "empty title",
+			in:         model.Task{Title: "   "},
+			wantFields: []string{"title"},
+		},
+		{
+			name:       "title too long",
+			in:         model.Task{Title: strings.Repeat("あ", maxTitleLength+1)},
+			wantFields: []string{"title"},
+		},
+		{
+			name:       "missing category",
+			in:         model.Task{Title: "write report", CategoryID: 99},
+			wantFields: []string{"category_id"},
+		},
+		{
+			name:      "duplicate tags are dropped",
+			in:        model.Task{Title: "write report", TagIDs: []uint64{1, 2, 1}},
+			knownTags: []uint64{1, 2},
+		},
+		{
+			name:       "unknown tag",
+			in:         model.Task{Title: "write report", TagIDs: []uint64{1, 3}},
+			knownTags:  []uint64{1},
+			wantFields: []string{"tag_ids"},
+		},
+		{
+			name: "urgent priority",
+			in:   model.Task{Title: "write report", Priority: model.PriorityUrgent},
+		},
+		{
+			name:       "unknown priority",
+			in:         model.Task{Title: "write report", Priority: model.PriorityUrgent + 1},
+			wantFields: []string{"priority"},
+		},
+		{
+			name:       "every invalid field is reported",
+			in:         model.Task{Title: "", DueDate: &yearOne, CategoryID: 99},
+			wantFields: []string{"title", "due_date", "category_id"},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			ctx := context.Background()
+			mockRepo := mockrepository.NewMockTaskRepository(ctrl)
+			mockCategoryRepo := mockrepository.NewMockCategoryRepository(ctrl)
+			if tt.in.CategoryID != 0 {
+				if tt.categoryExists {
+					mockCategoryRepo.EXPECT().FindCategoryByID(ctx, tt.in.CategoryID).Return(&model.Category{ID: tt.in.CategoryID}, nil)
+				} else {
+					mockCategoryRepo.EXPECT().FindCategoryByID(ctx, tt.in.CategoryID).Return(nil, apperr.NotFound("category", tt.in.CategoryID))
+				}
+			}
+			mockTagRepo := mockrepository.NewMockTagRepository(ctrl)
+			if len(tt.in.TagIDs) > 0 {
+				mockTagRepo.EXPECT().FindTagsByIDs(ctx, uniqueIDs(tt.in.TagIDs)).Return(tagsAmong(tt.in.TagIDs, tt.knownTags), nil)
+			}
+			mockHistoryRepo := mockrepository.NewMockTaskHistoryRepository(ctrl)
+			if len(tt.wantFields) == 0 {
+				want := tt.in
+				want.Title = strings.TrimSpace(tt.in.Title)
+				want.TagIDs = uniqueIDs(tt.in.TagIDs)
+				created := want
+				created.ID = 1
+				mockRepo.EXPECT().Create(ctx, want).Return(&created, nil)
+				mockHistoryRepo.EXPECT().Append(ctx, []model.TaskHistoryEntry{{TaskID: 1, Action: model.TaskHistoryCreated}}).Return(nil)
+			}
+			uow := inlineUnitOfWork(ctrl, repository.Repositories{Tasks: mockRepo, TaskHistory: mockHistoryRepo, Tags: mockTagRepo})
+
+			uc := NewTaskUseCase(mockRepo, mockCategoryRepo, mockrepository.NewMockSubTaskRepository(ctrl), mockHistoryRepo, uow, NewTaskFeed())
+
+			_, err := uc.CreateTask(ctx, tt.in)
+
+			if len(tt.wantFields) == 0 {
+				if err != nil {
+					t.Fatalf("CreateTask returned error: %v", err)
+				}
+				return
+			}
+			if got := violatedFields(t, err); !reflect.DeepEqual(got, tt.wantFields) {
+				t.Fatalf("violated fields = %v, want %v", got, tt.wantFields)
+			}
+		})
+	}
+}
+
+func TestTaskUseCase_CreateTask_SubTasks(t *testing.T) {
+	t.Parallel()
+
+	t.Run("task and subtasks are created together", func(t *testing.T) {
+		t.Parallel()
+
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		ctx := context.Background()
+		mockRepo := mockrepository.NewMockTaskRepository(ctrl)
+		mockHistoryRepo := mockrepository.NewMockTaskHistoryRepository(ctrl)
+
+		in := model.Task{
+			Title: "pack for trip",
+			SubTasks: []model.SubTask{
+				{Title: " passport ", Completed: 1},
+				{Title: "charger", Note: "usb-c"},
+			},
+		}
+		want := model.Task{
+			Title: "pack for trip",
+			SubTasks: []model.SubTask{
+				{Position: 1, Title: "passport"},
+				{Position: 2, Title: "charger", Note: "usb-c"},
+			},
+		}
+		created := want
+		created.ID = 1
+		created.SubTasks = []model.SubTask{
+			{ID: 10, TaskID: 1, Position: 1, Title: "passport"},
+			{ID: 11, TaskID: 1, Position: 2, Title: "charger", Note: "usb-c"},
+		}
+		mockRepo.EXPECT().CreateWithSubTasks(ctx, want).Return(&created, nil)
+		mockHistoryRepo.EXPECT().Append(ctx, []model.TaskHistoryEntry{
+			{TaskID: 1, Action: model.TaskHistoryCreated},
+			{TaskID: 1, SubTaskID: &created.SubTasks[0].ID, Action: model.TaskHistoryCreated},
+			{TaskID: 1, SubTaskID: &created.SubTasks[1].ID, Action: model.TaskHistoryCreated},
+		}).Return(nil)
+		uow := inlineUnitOfWork(ctrl, repository.Repositories{Tasks: mockRepo, TaskHistory: mockHistoryRepo})
+
+		uc := NewTaskUseCase(mockRepo, mockrepository.NewMockCategoryRepository(ctrl), mockrepository.NewMockSubTaskRepository(ctrl),
+			mockHistoryRepo, uow, NewTaskFeed())
+
+		got, err := uc.CreateTask(ctx, in)
+		if err != nil {
+			t.Fatalf("CreateTask returned error: %v", err)
+		}
+		if len(got.SubTasks) != 2 {
+			t.Fatalf("got %d sub tasks, want 2", len(got.SubTasks))
+		}
+	})
+
+	t.Run("invalid subtasks are reported by index", func(t *testing.T) {
+		t.Parallel()
+
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		yearOne := time.Date(1, time.January, 1, 0, 0, 0, 0, time.UTC)
+		in := model.Task{
+			Title: "pack for trip",
+			SubTasks: []model.SubTask{
+				{Title: "passport"},
+				{Title: "  "},
+				{Title: "charger", DueDate: &yearOne},
+			},
+		}
+		uc := NewTaskUseCase(mockrepository.NewMockTaskRepository(ctrl), mockrepository.NewMockCategoryRepository(ctrl), mockrepository.NewMockSubTaskRepository(ctrl),
+			mockrepository.NewMockTaskHistoryRepository(ctrl), mockrepository.NewMockUnitOfWork(ctrl), NewTaskFeed())
+
+		_, err := uc.CreateTask(context.Background(), in)
+
+		want := []string{"sub_tasks[1].title", "sub_tasks[2].due_date"}
+		if got := violatedFields(t, err); !reflect.DeepEqual(got, want) {
+			t.Fatalf("violated fields = %v, want %v", got, want)
+		}
+	})
+
+	t.Run("too many subtasks", func(t *testing.T) {
+		t.Parallel()
+
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		in := model.Task{Title: "pack for trip", SubTasks: make([]model.SubTask, maxNewSubTasks+1)}
+		uc := NewTaskUseCase(mockrepository.NewMockTaskRepository(ctrl), mockrepository.NewMockCategoryRepository(ctrl), mockrepository.NewMockSubTaskRepository(ctrl),
+			mockrepository.NewMockTaskHistoryRepository(ctrl), mockrepository.NewMockUnitOfWork(ctrl), NewTaskFeed())
+
+		_, err := uc.CreateTask(context.Background(), in)
+
+		if got := violatedFields(t, err); !reflect.DeepEqual(got, []string{"sub_tasks"}) {
+			t.Fatalf("violated fields = %v, want [sub_tasks]", got)
+		}
+	})
+}
+
+func TestTaskUseCase_UpdateTask_Recurrence(t *testing.T) {
+	t.Parallel()
+
+	date := func(y int, m time.Month, d int) *time.Time {
+		t := time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+		return &t
+	}
+
+	tests := []struct {
+		name        string
+		dueDate     *time.Time
+		rule        model.Recurrence
+		wantNextDue *time.Time
+		// wantLaterDues are the due dates of the occurrences after that, each created by
+		// completing the one before.
+		wantLaterDues []*time.Time
+	}{
+		{
+			name:        "daily",
+			dueDate:     date(2025, time.March, 1),
+			rule:        model.Recurrence{Frequency: model.RecurrenceDaily, Interval: 3},
+			wantNextDue: date(2025, time.March, 4),
+		},
+		{
+			name:        "weekly on the weekday of the due date",
+			dueDate:     date(2025, time.March, 3),
+			rule:        model.Recurrence{Frequency: model.RecurrenceWeekly},
+			wantNextDue: date(2025, time.March, 10),
+		},
+		{
+			name:        "weekly on listed weekdays in the same week",
+			dueDate:     date(2025, time.March, 3), // Monday
+			rule:        model.Recurrence{Frequency: model.RecurrenceWeekly, Interval: 2, Weekdays: []time.Weekday{time.Monday, time.Friday}},
+			wantNextDue: date(2025, time.March, 7),
+		},
+		{
+			name:        "weekly on listed weekdays skips to the next interval",
+			dueDate:     date(2025, time.March, 7), // Friday
+			rule:        model.Recurrence{Frequency: model.RecurrenceWeekly, Interval: 2, Weekdays: []time.Weekday{time.Monday, time.Friday}},
+			wantNextDue: date(2025, time.March, 17),
+		},
+		{
+			name:        "monthly clamps to the end of the month",
+			dueDate:     date(2025, time.January, 31),
+			rule:        model.Recurrence{Frequency: model.RecurrenceMonthly},
+			wantNextDue: date(2025, time.February, 28),
+		},
+		{
+			name:          "monthly returns to the day the series started on",
+			dueDate:       date(2025, time.January, 31),
+			rule:          model.Recurrence{Frequency: model.RecurrenceMonthly},
+			wantNextDue:   date(2025, time.February, 28),
+			wantLaterDues: []*time.Time{date(2025, time.March, 31), date(2025, time.April, 30), date(2025, time.May, 31)},
+		},
+		{
+			name:        "yearly from a leap day",
+			dueDate:     date(2024, time.February, 29),
+			rule:        model.Recurrence{Frequency: model.RecurrenceYearly},
+			wantNextDue: date(2025, time.February, 28),
+		},
+		{
+			name:          "yearly returns to the leap day",
+			dueDate:       date(2024, time.February, 29),
+			rule:          model.Recurrence{Frequency: model.RecurrenceYearly},
+			wantNextDue:   date(2025, time.February, 28),
+			wantLaterDues: []*time.Time{date(2026, time.February, 28), date(2027, time.February, 28), date(2028, time.February, 29)},
+		},
+		{
+			name:    "schedule ended",
+			dueDate: date(2025, time.March, 1),
+			rule:    model.Recurrence{Frequency: model.RecurrenceDaily, Until: date(2025, time.March, 1)},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			ctx := context.Background()
+			rule := tt.rule
+			task := &model.Task{ID: 1, Title: "water plants", DueDate: tt.dueDate, Recurrence: &rule}
+			subDue := tt.dueDate.AddDate(0, 0, -1)
+			subTasks := []model.SubTask{
+				{ID: 10, TaskID: 1, Position: 1, Title: "fill can", Completed: 1, CompletedAt: tt.dueDate},
+				{ID: 11, TaskID: 1, Position: 2, Title: "check soil", DueDate: &subDue},
+			}
+
+			mockRepo := mockrepository.NewMockTaskRepository(ctrl)
+			mockSubTaskRepo := mockrepository.NewMockSubTaskRepository(ctrl)
+			mockRepo.EXPECT().FindByID(ctx, task.ID).Return(task, nil)
+
+			var completed model.Task
+			mockRepo.EXPECT().Update(ctx, gomock.Any()).DoAndReturn(func(_ context.Context, in model.Task) (*model.Task, error) {
+				completed = in
+				return &in, nil
+			})
+
+			var next model.Task
+			if tt.wantNextDue != nil {
+				mockSubTaskRepo.EXPECT().ListByTaskID(ctx, task.ID).Return(subTasks, nil)
+				mockRepo.EXPECT().CreateWithSubTasks(ctx, gomock.Any()).DoAndReturn(func(_ context.Context, in model.Task) (*model.Task, error) {
+					next = in
+					next.ID = 2
+					return &next, nil
+				})
+			}
+
+			var history []model.TaskHistoryEntry
+			mockHistoryRepo := mockrepository.NewMockTaskHistoryRepository(ctrl)
+			mockHistoryRepo.EXPECT().Append(ctx, gomock.Any()).DoAndReturn(func(_ context.Context, entries []model.TaskHistoryEntry) error {
+				history = append(history, entries...)
+				return nil
+			}).AnyTimes()
+			uow := inlineUnitOfWork(ctrl, repository.Repositories{Tasks: mockRepo, SubTasks: mockSubTaskRepo, TaskHistory: mockHistoryRepo})
+
+			uc := NewTaskUseCase(mockRepo, mockrepository.NewMockCategoryRepository(ctrl), mockSubTaskRepo, mockHistoryRepo, uow, NewTaskFeed())
+
+			completedFlag := int32(1)
+			if _, err := uc.UpdateTask(ctx, model.UpdateTaskRequest{ID: task.ID, Completed: &completedFlag}); err != nil {
+				t.Fatalf("UpdateTask returned error: %v", err)
+			}
+
+			if completed.Completed != 1 || completed.CompletedAt == nil {
+				t.Fatalf("task was not completed: %+v", completed)
+			}
+			if completed.Recurrence != nil {
+				t.Fatalf("completed task kept its schedule: %+v", completed.Recurrence)
+			}
+			wantHistory := []model.TaskHistoryEntry{
+				{TaskID: 1, Action: model.TaskHistoryUpdated, Field: "completed", OldValue: strPtr("0"), NewValue: strPtr("1")},
+				{TaskID: 1, Action: model.TaskHistoryUpdated, Field: "recurrence", OldValue: strPtr(rule.RRule())},
+			}
+			if tt.wantNextDue != nil {
+				wantHistory = append(wantHistory, model.TaskHistoryEntry{TaskID: 2, Action: model.TaskHistoryCreated})
+			}
+			if !reflect.DeepEqual(history, wantHistory) {
+				t.Fatalf("history = %+v, want %+v", history, wantHistory)
+			}
+			if tt.wantNextDue == nil {
+				return
+			}
+
+			if next.DueDate == nil || !next.DueDate.Equal(*tt.wantNextDue) {
+				t.Fatalf("next due date = %v, want %v", next.DueDate, tt.wantNextDue)
+			}
+			wantRule := rule.AnchoredAt(*tt.dueDate)
+			if next.Completed != 0 || next.Title != task.Title || !reflect.DeepEqual(next.Recurrence, &wantRule) {
+				t.Fatalf("next occurrence = %+v", next)
+			}
+			if len(next.SubTasks) != len(subTasks) {
+				t.Fatalf("copied %d subtasks, want %d", len(next.SubTasks), len(subTasks))
+			}
+			for i, st := range next.SubTasks {
+				if st.ID != 0 || st.Completed != 0 || st.CompletedAt != nil || st.Position != subTasks[i].Position {
+					t.Fatalf("subtask %d was not reset: %+v", i, st)
+				}
+			}
+			wantSubDue := tt.wantNextDue.AddDate(0, 0, -1)
+			if got := next.SubTasks[1].DueDate; got == nil || !got.Equal(wantSubDue) {
+				t.Fatalf("subtask due date = %v, want %v", got, wantSubDue)
+			}
+
+			current := next
+			current.SubTasks = nil
+			for _, want := range tt.wantLaterDues {
+				var created model.Task
+				mockRepo.EXPECT().FindByID(ctx, current.ID).Return(&current, nil)
+				mockRepo.EXPECT().Update(ctx, gomock.Any()).DoAndReturn(func(_ context.Context, in model.Task) (*model.Task, error) {
+					return &in, nil
+				})
+				mockSubTaskRepo.EXPECT().ListByTaskID(ctx, current.ID).Return(nil, nil)
+				mockRepo.EXPECT().CreateWithSubTasks(ctx, gomock.Any()).DoAndReturn(func(_ context.Context, in model.Task) (*model.Task, error) {
+					created = in
+					created.ID = current.ID + 1
+					return &created, nil
+				})
+
+				if _, err := uc.UpdateTask(ctx, model.UpdateTaskRequest{ID: current.ID, Completed: &completedFlag}); err != nil {
+					t.Fatalf("UpdateTask returned error: %v", err)
+				}
+				if created.DueDate == nil || !created.DueDate.Equal(*want) {
+					t.Fatalf("occurrence after %v is due %v, want %v", current.DueDate, created.DueDate, want)
+				}
+				current = created
+			}
+		})
+	}
+}
+
+func TestTaskUseCase_UpdateTask_RecurrenceAnchor(t *testing.T) {
+	t.Parallel()
+
+	due := time.Date(2025, time.February, 28, 0, 0, 0, 0, time.UTC)
+	moved := time.Date(2025, time.March, 15, 0, 0, 0, 0, time.UTC)
+	anchored := model.Recurrence{Frequency: model.RecurrenceMonthly, Interval: 1, MonthDay: 31}
+	unanchored := anchored.Unanchored()
+	weekly := model.Recurrence{Frequency: model.RecurrenceWeekly, Interval: 1}
+
+	tests := []struct {
+		name     string
+		in       model.UpdateTaskRequest
+		wantRule model.Recurrence
+	}{
+		{
+			name:     "same schedule sent back",
+			in:       model.UpdateTaskRequest{ID: 1, Recurrence: &unanchored, DueDate: &due},
+			wantRule: anchored,
+		},
+		{
+			name:     "due date moved",
+			in:       model.UpdateTaskRequest{ID: 1, DueDate: &moved},
+			wantRule: unanchored,
+		},
+		{
+			name:     "schedule replaced",
+			in:       model.UpdateTaskRequest{ID: 1, Recurrence: &weekly},
+			wantRule: weekly,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			ctx := context.Background()
+			rule := anchored
+			task := &model.Task{ID: 1, Title: "pay rent", DueDate: &due, Recurrence: &rule}
+
+			var saved model.Task
+			mockRepo := mockrepository.NewMockTaskRepository(ctrl)
+			mockRepo.EXPECT().FindByID(ctx, task.ID).Return(task, nil)
+			mockRepo.EXPECT().Update(ctx, gomock.Any()).DoAndReturn(func(_ context.Context, in model.Task) (*model.Task, error) {
+				saved = in
+				return &in, nil
+			})
+			mockHistoryRepo := mockrepository.NewMockTaskHistoryRepository(ctrl)
+			mockHistoryRepo.EXPECT().Append(ctx, gomock.Any()).Return(nil).AnyTimes()
+			uow := inlineUnitOfWork(ctrl, repository.Repositories{Tasks: mockRepo, TaskHistory: mockHistoryRepo})
+
+			uc := NewTaskUseCase(mockRepo, mockrepository.NewMockCategoryRepository(ctrl), mockrepository.NewMockSubTaskRepository(ctrl), mockHistoryRepo, uow, NewTaskFeed())
+			if _, err := uc.UpdateTask(ctx, tt.in); err != nil {
+				t.Fatalf("UpdateTask returned error: %v", err)
+			}
+			if !reflect.DeepEqual(saved.Recurrence, &tt.wantRule) {
+				t.Fatalf("saved recurrence = %+v, want %+v", saved.Recurrence, tt.wantRule)
+			}
+		})
+	}
+}
+
+func TestTaskUseCase_UpdateTask_History(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ctx := context.Background()
+	oldDue := time.Date(2025, time.March, 1, 0, 0, 0, 0, time.UTC)
+	newDue := time.Date(2025, time.March, 8, 0, 0, 0, 0, time.UTC)
+	task := &model.Task{ID: 1, Title: "write report", Note: "draft", CategoryID: 3, DueDate: &oldDue, TagIDs: []uint64{1}}
+
+	mockRepo := mockrepository.NewMockTaskRepository(ctrl)
+	mockRepo.EXPECT().FindByID(ctx, task.ID).Return(task, nil)
+	mockRepo.EXPECT().Update(ctx, gomock.Any()).DoAndReturn(func(_ context.Context, in model.Task) (*model.Task, error) {
+		return &in, nil
+	})
+	mockTagRepo := mockrepository.NewMockTagRepository(ctrl)
+	mockTagRepo.EXPECT().FindTagsByIDs(ctx, []uint64{1, 2}).Return(tagsAmong([]uint64{1, 2}, []uint64{1, 2}), nil)
+	mockHistoryRepo := mockrepository.NewMockTaskHistoryRepository(ctrl)
+	mockHistoryRepo.EXPECT().Append(ctx, []model.TaskHistoryEntry{
+		{TaskID: 1, Action: model.TaskHistoryUpdated, Field: "title", OldValue: strPtr("write report"), NewValue: strPtr("write final report")},
+		{TaskID: 1, Action: model.TaskHistoryUpdated, Field: "category_id", OldValue: strPtr("3")},
+		{TaskID: 1, Action: model.TaskHistoryUpdated, Field: "due_date", OldValue: strPtr("2025-03-01"), NewValue: strPtr("2025-03-08")},
+		{TaskID: 1, Action: model.TaskHistoryUpdated, Field: "tag_ids", OldValue: strPtr("1"), NewValue: strPtr("1,2")},
+	}).Return(nil)
+	uow := inlineUnitOfWork(ctrl, repository.Repositories{Tasks: mockRepo, TaskHistory: mockHistoryRepo, Tags: mockTagRepo})
+
+	uc := NewTaskUseCase(mockRepo, mockrepository.NewMockCategoryRepository(ctrl), mockrepository.NewMockSubTaskRepository(ctrl), mockHistoryRepo, uow, NewTaskFeed())
+
+	// note is sent unchanged and must not be recorded
+	title, note, noCategory := "write final report", "draft", uint64(0)
+	_, err := uc.UpdateTask(ctx, model.UpdateTaskRequest{
+		ID:         task.ID,
+		Title:      &title,
+		Note:       &note,
+		CategoryID: &noCategory,
+		DueDate:    &newDue,
+		TagIDs:     []uint64{1, 2},
+	})
+	if err != nil {
+		t.Fatalf("UpdateTask returned error: %v", err)
+	}
+}
+
+func TestTaskUseCase_UpdateTask_ClearFields(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ctx := context.Background()
+	due := time.Date(2025, time.March, 1, 0, 0, 0, 0, time.UTC)
+	task := &model.Task{ID: 1, Title: "write report", CategoryID: 3, DueDate: &due}
+
+	mockRepo := mockrepository.NewMockTaskRepository(ctrl)
+	mockRepo.EXPECT().FindByID(ctx, task.ID).Return(task, nil)
+	mockRepo.EXPECT().Update(ctx, model.Task{ID: 1, Title: "write report"}).DoAndReturn(func(_ context.Context, in model.Task) (*model.Task, error) {
+		return &in, nil
+	})
+	mockHistoryRepo := mockrepository.NewMockTaskHistoryRepository(ctrl)
+	mockHistoryRepo.EXPECT().Append(ctx, []model.TaskHistoryEntry{
+		{TaskID: 1, Action: model.TaskHistoryUpdated, Field: "category_id", OldValue: strPtr("3")},
+		{TaskID: 1, Action: model.TaskHistoryUpdated, Field: "due_date", OldValue: strPtr("2025-03-01")},
+	}).Return(nil)
+	uow := inlineUnitOfWork(ctrl, repository.Repositories{Tasks: mockRepo, TaskHistory: mockHistoryRepo})
+
+	uc := NewTaskUseCase(mockRepo, mockrepository.NewMockCategoryRepository(ctrl), mockrepository.NewMockSubTaskRepository(ctrl), mockHistoryRepo, uow, NewTaskFeed())
+
+	// values sent along with a clear flag are neither validated nor applied
+	otherCategory := uint64(4)
+	outOfRange := time.Date(1900, time.January, 1, 0, 0, 0, 0, time.UTC)
+	_, err := uc.UpdateTask(ctx, model.UpdateTaskRequest{
+		ID:            task.ID,
+		CategoryID:    &otherCategory,
+		ClearCategory: true,
+		DueDate:       &outOfRange,
+		ClearDueDate:  true,
+	})
+	if err != nil {
+		t.Fatalf("UpdateTask returned error: %v", err)
+	}
+}
+
+func TestTaskUseCase_UpdateTask_CompletesSubTasks(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ctx := auth.WithUserID(context.Background(), 1)
+	task := &model.Task{ID: 1, Title: "pack for trip", SyncCompletion: true}
+
+	mockRepo := mockrepository.NewMockTaskRepository(ctrl)
+	mockRepo.EXPECT().FindByID(ctx, task.ID).Return(task, nil)
+	mockRepo.EXPECT().Update(ctx, gomock.Any()).DoAndReturn(func(_ context.Context, in model.Task) (*model.Task, error) {
+		return &in, nil
+	})
+	mockSubTaskRepo := mockrepository.NewMockSubTaskRepository(ctrl)
+	mockSubTaskRepo.EXPECT().ListByTaskID(ctx, task.ID).Return([]model.SubTask{
+		{ID: 10, TaskID: 1, Title: "passport", Completed: 1},
+		{ID: 11, TaskID: 1, Title: "charger"},
+	}, nil)
+	mockSubTaskRepo.EXPECT().Update(ctx, gomock.Any()).DoAndReturn(func(_ context.Context, in model.SubTask) (*model.SubTask, error) {
+		if in.ID != 11 || in.Completed != 1 || in.CompletedAt == nil {
+			t.Fatalf("sub task saved as %+v, want sub task 11 completed", in)
+		}
+		return &in, nil
+	})
+	mockHistoryRepo := mockrepository.NewMockTaskHistoryRepository(ctrl)
+	mockHistoryRepo.EXPECT().Append(ctx, gomock.Any()).Return(nil).Times(2)
+	uow := inlineUnitOfWork(ctrl, repository.Repositories{Tasks: mockRepo, SubTasks: mockSubTaskRepo, TaskHistory: mockHistoryRepo})
+
+	feed := NewTaskFeed()
+	events, cancel := feed.Subscribe(1)
+	defer cancel()
+
+	uc := NewTaskUseCase(mockRepo, mockrepository.NewMockCategoryRepository(ctrl), mockSubTaskRepo, mockHistoryRepo, uow, feed)
+
+	if _, err := uc.UpdateTask(ctx, model.UpdateTaskRequest{ID: task.ID, Completed: int32Ptr(1)}); err != nil {
+		t.Fatalf("UpdateTask returned error: %v", err)
+	}
+
+	// watchers learn about the subtask completed along with the task
+	published := publishedEvents(events)
+	if len(published) != 2 || published[0].Type != model.SubTaskToggled || published[0].SubTask.ID != 11 || published[1].Type != model.TaskUpdated {
+		t.Fatalf("published events = %+v, want sub task 11 toggled and task 1 updated", published)
+	}
+}
+
+func TestTaskUseCase_UpdateTask_ExpectedVersion(t *testing.T) {
+	t.Parallel()
+
+	current, stale := int32(3), int32(2)
+	tests := []struct {
+		name     string
+		expected *int32
+		wantErr  bool
+	}{
+		{name: "unchecked", expected: nil},
+		{name: "current version", expected: &current},
+		{name: "stale version", expected: &stale, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			ctx := context.Background()
+			task := &model.Task{ID: 1, Title: "write report", Version: current}
+
+			mockRepo := mockrepository.NewMockTaskRepository(ctrl)
+			mockRepo.EXPECT().FindByID(ctx, task.ID).Return(task, nil)
+			mockHistoryRepo := mockrepository.NewMockTaskHistoryRepository(ctrl)
+			if !tt.wantErr {
+				mockRepo.EXPECT().Update(ctx, gomock.Any()).DoAndReturn(func(_ context.Context, in model.Task) (*model.Task, error) {
+					in.Version++
+					return &in, nil
+				})
+				mockHistoryRepo.EXPECT().Append(ctx, gomock.Any()).Return(nil)
+			}
+			uow := inlineUnitOfWork(ctrl, repository.Repositories{Tasks: mockRepo, TaskHistory: mockHistoryRepo})
+
+			uc := NewTaskUseCase(mockRepo, mockrepository.NewMockCategoryRepository(ctrl), mockrepository.NewMockSubTaskRepository(ctrl), mockHistoryRepo, uow, NewTaskFeed())
+
+			title := "write final report"
+			_, err := uc.UpdateTask(ctx, model.UpdateTaskRequest{ID: task.ID, Title: &title, ExpectedVersion: tt.expected})
+
+			if !tt.wantErr {
+				if err != nil {
+					t.Fatalf("UpdateTask returned error: %v", err)
+				}
+				return
+			}
+			var appErr *apperr.Error
+			if !errors.As(err, &appErr) || appErr.Code != apperr.CodeAborted {
+				t.Fatalf("UpdateTask error = %v, want Aborted", err)
+			}
+			if got, ok := appErr.Current.(*model.Task); !ok || got.Title != "write report" || got.Version != current {
+				t.Fatalf("Current = %#v, want the unchanged task at version %d", appErr.Current, current)
+			}
+		})
+	}
+}
+
+func TestTaskUseCase_UpdateTask_InvalidRecurrence(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	dueDate := time.Date(2025, time.March, 1, 0, 0, 0, 0, time.UTC)
+	until := dueDate.AddDate(0, 0, -1)
+	uc := NewTaskUseCase(mockrepository.NewMockTaskRepository(ctrl), mockrepository.NewMockCategoryRepository(ctrl), mockrepository.NewMockSubTaskRepository(ctrl), mockrepository.NewMockTaskHistoryRepository(ctrl), mockrepository.NewMockUnitOfWork(ctrl), NewTaskFeed())
+
+	_, err := uc.UpdateTask(context.Background(), model.UpdateTaskRequest{
+		ID:      1,
+		DueDate: &dueDate,
+		Recurrence: &model.Recurrence{
+			Frequency: model.RecurrenceDaily,
+			Weekdays:  []time.Weekday{time.Monday},
+			Until:     &until,
+		},
+	})
+
+	want := []string{"recurrence.weekdays", "recurrence.until"}
+	if got := violatedFields(t, err); !reflect.DeepEqual(got, want) {
+		t.Fatalf("violated fields = %v, want %v", got, want)
+	}
+}
+
+func TestTaskUseCase_SearchTasks(t *testing.T) {
+	t.Parallel()
+
+	t.Run("short query", func(t *testing.T) {
+		t.Parallel()
+
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		uc := NewTaskUseCase(mockrepository.NewMockTaskRepository(ctrl), mockrepository.NewMockCategoryRepository(ctrl), mockrepository.NewMockSubTaskRepository(ctrl), mockrepository.NewMockTaskHistoryRepository(ctrl), mockrepository.NewMockUnitOfWork(ctrl), NewTaskFeed())
+
+		_, err := uc.SearchTasks(context.Background(), " a ", repository.PageRequest{})
+
+		if got := violatedFields(t, err); !reflect.DeepEqual(got, []string{"query"}) {
+			t.Fatalf("violated fields = %v, want [query]", got)
+		}
+	})
+
+	t.Run("results are highlighted", func(t *testing.T) {
+		t.Parallel()
+
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		ctx := context.Background()
+		mockRepo := mockrepository.NewMockTaskRepository(ctrl)
+		mockSubTaskRepo := mockrepository.NewMockSubTaskRepository(ctrl)
+		mockRepo.EXPECT().
+			Search(ctx, "report", repository.PageRequest{Size: defaultTaskPageSize}).
+			Return(&repository.TaskSearchPage{
+				Results: []model.TaskSearchResult{
+					{Task: model.Task{ID: 1, Title: "Write report", Note: "due friday"}, Score: 2},
+					{Task: model.Task{ID: 2, Title: "Monthly close"}, Score: 1},
+				},
+			}, nil)
+		mockSubTaskRepo.EXPECT().
+			ListByTaskIDs(ctx, []uint64{1, 2}).
+			Return(map[uint64][]model.SubTask{2: {{ID: 5, TaskID: 2, Title: "send report"}}}, nil)
+
+		uc := NewTaskUseCase(mockRepo, mockrepository.NewMockCategoryRepository(ctrl), mockSubTaskRepo, mockrepository.NewMockTaskHistoryRepository(ctrl), mockrepository.NewMockUnitOfWork(ctrl), NewTaskFeed())
+
+		got, err := uc.SearchTasks(ctx, "  report ", repository.PageRequest{})
+		if err != nil {
+			t.Fatalf("SearchTasks returned error: %v", err)
+		}
+
+		want := [][]model.SearchHighlight{
+			{{Field: "title", Snippet: "Write <mark>report</mark>"}},
+			{{Field: "sub_tasks.title", Snippet: "send <mark>report</mark>"}},
+		}
+		for i, r := range got.Results {
+			if !reflect.DeepEqual(r.Highlights, want[i]) {
+				t.Fatalf("result %d highlights = %v, want %v", i, r.Highlights, want[i])
+			}
+		}
+		if len(got.Results[1].Task.SubTasks) != 1 {
+			t.Fatalf("result 2 sub tasks = %v, want the matching one", got.Results[1].Task.SubTasks)
+		}
+	})
+}
+
+func TestTaskUseCase_RestoreTask(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		restoreErr error
+	}{
+		{name: "trashed task"},
+		// the store only restores trashed tasks, so live and unknown tasks are both not found
+		{name: "task not in the trash", restoreErr: apperr.NotFound("task", 1)},
+		{name: "missing task", restoreErr: apperr.NotFound("task", 1)},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			ctx := context.Background()
+			mockRepo := mockrepository.NewMockTaskRepository(ctrl)
+			mockHistoryRepo := mockrepository.NewMockTaskHistoryRepository(ctrl)
+			if tt.restoreErr != nil {
+				mockRepo.EXPECT().Restore(ctx, uint64(1)).Return(nil, tt.restoreErr)
+			} else {
+				mockRepo.EXPECT().Restore(ctx, uint64(1)).Return(&model.Task{ID: 1, Title: "write report"}, nil)
+				mockHistoryRepo.EXPECT().Append(ctx, []model.TaskHistoryEntry{{TaskID: 1, Action: model.TaskHistoryRestored}}).Return(nil)
+			}
+			uow := inlineUnitOfWork(ctrl, repository.Repositories{Tasks: mockRepo, TaskHistory: mockHistoryRepo})
+
+			uc := NewTaskUseCase(mockRepo, mockrepository.NewMockCategoryRepository(ctrl), mockrepository.NewMockSubTaskRepository(ctrl), mockHistoryRepo, uow, NewTaskFeed())
+
+			task, err := uc.RestoreTask(ctx, 1)
+			if tt.restoreErr != nil {
+				var appErr *apperr.Error
+				if !errors.As(err, &appErr) || appErr.Code != apperr.CodeNotFound {
+					t.Fatalf("RestoreTask error = %v, want NotFound", err)
+				}
+				return
+			}
+			if err != nil || task.ID != 1 {
+				t.Fatalf("RestoreTask = %v, %v, want task 1", task, err)
+			}
+		})
+	}
+}
+
+func TestTaskUseCase_PurgeTask(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		purgeErr error
+	}{
+		{name: "trashed task"},
+		// the store refuses to purge tasks outside the trash
+		{name: "live task", purgeErr: apperr.NotFound("task", 1)},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			ctx := context.Background()
+			mockRepo := mockrepository.NewMockTaskRepository(ctrl)
+			mockRepo.EXPECT().Purge(ctx, uint64(1)).Return(tt.purgeErr)
+			mockHistoryRepo := mockrepository.NewMockTaskHistoryRepository(ctrl)
+			if tt.purgeErr == nil {
+				mockHistoryRepo.EXPECT().Append(ctx, []model.TaskHistoryEntry{{TaskID: 1, Action: model.TaskHistoryPurged}}).Return(nil)
+			}
+			uow := inlineUnitOfWork(ctrl, repository.Repositories{Tasks: mockRepo, TaskHistory: mockHistoryRepo})
+
+			uc := NewTaskUseCase(mockRepo, mockrepository.NewMockCategoryRepository(ctrl), mockrepository.NewMockSubTaskRepository(ctrl), mockHistoryRepo, uow, NewTaskFeed())
+
+			err := uc.PurgeTask(ctx, 1)
+			if !errors.Is(err, tt.purgeErr) {
+				t.Fatalf("PurgeTask error = %v, want %v", err, tt.purgeErr)
+			}
+		})
+	}
+}
+
+func TestTaskUseCase_PurgeExpiredTasks(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ctx := context.Background()
+	retention := 30 * 24 * time.Hour
+	var cutoff time.Time
+	mockRepo := mockrepository.NewMockTaskRepository(ctrl)
+	mockHistoryRepo := mockrepository.NewMockTaskHistoryRepository(ctrl)
+	mockRepo.EXPECT().FindDeletedBefore(ctx, gomock.Any()).DoAndReturn(func(_ context.Context, c time.Time) ([]repository.ExpiredTask, error) {
+		cutoff = c
+		return []repository.ExpiredTask{{ID: 1, UserID: 7}, {ID: 2, UserID: 8}}, nil
+	})
+	// every task is purged, and its purge recorded, on behalf of its own owner
+	owners := map[uint64]uint64{1: 7, 2: 8}
+	checkOwner := func(ctx context.Context, id uint64) {
+		if userID, _ := auth.UserIDFromContext(ctx); userID != owners[id] {
+			t.Errorf("task %d handled as user %d, want %d", id, userID, owners[id])
+		}
+	}
+	for _, id := range []uint64{1, 2} {
+		id := id
+		purge := mockRepo.EXPECT().Purge(gomock.Any(), id).DoAndReturn(func(ctx context.Context, _ uint64) error {
+			checkOwner(ctx, id)
+			return nil
+		})
+		mockHistoryRepo.EXPECT().Append(gomock.Any(), []model.TaskHistoryEntry{{TaskID: id, Action: model.TaskHistoryPurged}}).DoAndReturn(func(ctx context.Context, _ []model.TaskHistoryEntry) error {
+			checkOwner(ctx, id)
+			return nil
+		}).After(purge)
+	}
+	uow := inlineUnitOfWork(ctrl, repository.Repositories{Tasks: mockRepo, TaskHistory: mockHistoryRepo})
+
+	uc := NewTaskUseCase(mockRepo, mockrepository.NewMockCategoryRepository(ctrl), mockrepository.NewMockSubTaskRepository(ctrl), mockHistoryRepo, uow, NewTaskFeed())
+
+	before := time.Now()
+	purged, err := uc.PurgeExpiredTasks(ctx, retention)
+	after := time.Now()
+
+	if err != nil || purged != 2 {
+		t.Fatalf("PurgeExpiredTasks = %d, %v, want 2", purged, err)
+	}
+	if cutoff.Before(before.Add(-retention)) || cutoff.After(after.Add(-retention)) {
+		t.Fatalf("cutoff = %v, want %v before now", cutoff, retention)
+	}
+}
+
+// inlineUnitOfWork returns a UnitOfWork that runs every unit of work directly against repos.
+func inlineUnitOfWork(ctrl *gomock.Controller, repos repository.Repositories) repository.UnitOfWork {
+	uow := mockrepository.NewMockUnitOfWork(ctrl)
+	uow.EXPECT().Do(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, fn func(repository.Repositories) error) error {
+		return fn(repos)
+	}).AnyTimes()
+	return uow
+}
+
+func strPtr(s string) *string {
+	return &s
+}
+
+func int32Ptr(n int32) *int32 {
+	return &n
+}
+
+// tagsAmong returns the known tags among ids, as TagRepository.FindTagsByIDs would.
+func tagsAmong(ids, known []uint64) []model.Tag {
+	var tags []model.Tag
+	for _, id := range uniqueIDs(ids) {
+		for _, k := range known {
+			if id == k {
+				tags = append(tags, model.Tag{ID: id})
+			}
+		}
+	}
+	return tags
+}
+
+// violatedFields asserts that err is an InvalidArgument error and returns the fields it reports.
+func violatedFields(t *testing.T, err error) []string {
+	t.Helper()
+
+	var appErr *apperr.Error
+	if !errors.As(err, &appErr) || appErr.Code != apperr.CodeInvalidArgument {
+		t.Fatalf("error = %v, want InvalidArgument", err)
+	}
+	fields := make([]string, 0, len(appErr.Violations))
+	for _, v := range appErr.Violations {
+		fields = append(fields, v.Field)
+	}
+	return fields
+}
@@ -0,0 +1,30 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/labstack/gommon/log"
+)
+
+// RunTrashPurger periodically purges tasks that have stayed in the trash
+// longer than retention. It blocks until ctx is cancelled.
+func RunTrashPurger(ctx context.Context, uc TaskUseCase, retention, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		purged, err := uc.PurgeExpiredTasks(ctx, retention)
+		if err != nil {
+			log.Errorf("failed to purge trashed tasks: %v", err)
+		} else if purged > 0 {
+			log.Infof("purged %d trashed tasks", purged)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,52 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"backend/domain/repository"
+	mockrepository "backend/domain/repository/mock"
+
+	"github.com/golang/mock/gomock"
+)
+
+func TestRunTrashPurger_StopsOnCancel(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	purged := make(chan struct{}, 1)
+	mockRepo := mockrepository.NewMockTaskRepository(ctrl)
+	mockRepo.EXPECT().FindDeletedBefore(gomock.Any(), gomock.Any()).DoAndReturn(func(context.Context, time.Time) ([]repository.ExpiredTask, error) {
+		select {
+		case purged <- struct{}{}:
+		default:
+		}
+		return nil, nil
+	}).MinTimes(1)
+
+	uc := NewTaskUseCase(mockRepo, mockrepository.NewMockCategoryRepository(ctrl), mockrepository.NewMockSubTaskRepository(ctrl), mockrepository.NewMockTaskHistoryRepository(ctrl), inlineUnitOfWork(ctrl, repository.Repositories{Tasks: mockRepo}), NewTaskFeed())
+
+	done := make(chan struct{})
+	go func() {
+		RunTrashPurger(ctx, uc, time.Hour, time.Hour)
+		close(done)
+	}()
+
+	// the first purge runs immediately, without waiting for the interval
+	select {
+	case <-purged:
+	case <-time.After(time.Second):
+		t.Fatal("RunTrashPurger did not purge on start")
+	}
+
+	cancel()
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("RunTrashPurger did not return after ctx was cancelled")
+	}
+}
@@ -0,0 +1,81 @@
+package usecase
+
+import (
+	"context"
+	"net/mail"
+	"strings"
+
+	"backend/domain/apperr"
+	"backend/domain/model"
+	"backend/domain/repository"
+
+	"golang.org/x/crypto/bcrypt"
+)
+
+const (
+	minPasswordLength = 8
+	// maxPasswordLength is the number of bytes bcrypt takes into account.
+	maxPasswordLength = 72
+)
+
+// ErrInvalidCredentials is returned when the email or password does not match an account.
+var ErrInvalidCredentials = apperr.Unauthenticated("invalid email or password")
+
+// UserUseCase defines account management logic.
+type UserUseCase interface {
+	SignUp(ctx context.Context, email, password string) (*model.User, error)
+	Authenticate(ctx context.Context, email, password string) (*model.User, error)
+}
+
+type userUseCase struct {
+	repo repository.UserRepository
+}
+
+// NewUserUseCase constructs a UserUseCase.
+func NewUserUseCase(repo repository.UserRepository) UserUseCase {
+	return &userUseCase{repo: repo}
+}
+
+// SignUp registers a new account, storing only a bcrypt hash of the password.
+func (uc *userUseCase) SignUp(ctx context.Context, email, password string) (*model.User, error) {
+	email = normalizeEmail(email)
+
+	var v violations
+	if _, err := mail.ParseAddress(email); err != nil {
+		v.add("email", "must be a valid email address")
+	}
+	if len(password) < minPasswordLength || len(password) > maxPasswordLength {
+		v.add("password", "must be between 8 and 72 bytes")
+	}
+	if err := v.err("invalid sign up request"); err != nil {
+		return nil, err
+	}
+
+	hash, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+	if err != nil {
+		return nil, err
+	}
+
+	return uc.repo.Create(ctx, model.User{Email: email, PasswordHash: string(hash)})
+}
+
+// Authenticate returns the account matching the credentials.
+func (uc *userUseCase) Authenticate(ctx context.Context, email, password string) (*model.User, error) {
+	user, err := uc.repo.FindByEmail(ctx, normalizeEmail(email))
+	if err != nil {
+		if apperr.IsNotFound(err) {
+			return nil, ErrInvalidCredentials
+		}
+		return nil, err
+	}
+
+	if err := bcrypt.CompareHashAndPassword([]byte(user.PasswordHash), []byte(password)); err != nil {
+		return nil, ErrInvalidCredentials
+	}
+
+	return user, nil
+}
+
+func normalizeEmail(email string) string {
+	return strings.ToLower(strings.TrimSpace(email))
+}
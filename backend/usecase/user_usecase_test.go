@@ -0,0 +1,153 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+
+	"backend/domain/apperr"
+	"backend/domain/model"
+	mockrepository "backend/domain/repository/mock"
+
+	"github.com/golang/mock/gomock"
+	"golang.org/x/crypto/bcrypt"
+)
+
+func TestUserUseCase_SignUp(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		email      string
+		password   string
+		wantFields []string
+	}{
+		{
+			name:     "success",
+			email:    "  Alice@Example.com ",
+			password: "correct horse",
+		},
+		{
+			name:       "invalid email",
+			email:      "alice",
+			password:   "correct horse",
+			wantFields: []string{"email"},
+		},
+		{
+			name:       "short password",
+			email:      "alice@example.com",
+			password:   "short",
+			wantFields: []string{"password"},
+		},
+		{
+			name:       "password longer than bcrypt accepts",
+			email:      "alice@example.com",
+			password:   strings.Repeat("a", maxPasswordLength+1),
+			wantFields: []string{"password"},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			ctx := context.Background()
+			mockRepo := mockrepository.NewMockUserRepository(ctrl)
+			if len(tt.wantFields) == 0 {
+				mockRepo.EXPECT().Create(ctx, gomock.Any()).DoAndReturn(func(_ context.Context, in model.User) (*model.User, error) {
+					if in.Email != "alice@example.com" {
+						t.Fatalf("email = %q, want normalized address", in.Email)
+					}
+					if err := bcrypt.CompareHashAndPassword([]byte(in.PasswordHash), []byte(tt.password)); err != nil {
+						t.Fatalf("password hash does not match: %v", err)
+					}
+					in.ID = 1
+					return &in, nil
+				})
+			}
+
+			uc := NewUserUseCase(mockRepo)
+
+			_, err := uc.SignUp(ctx, tt.email, tt.password)
+
+			if len(tt.wantFields) == 0 {
+				if err != nil {
+					t.Fatalf("SignUp returned error: %v", err)
+				}
+				return
+			}
+			if got := violatedFields(t, err); !reflect.DeepEqual(got, tt.wantFields) {
+				t.Fatalf("violated fields = %v, want %v", got, tt.wantFields)
+			}
+		})
+	}
+}
+
+func TestUserUseCase_Authenticate(t *testing.T) {
+	t.Parallel()
+
+	hash, err := bcrypt.GenerateFromPassword([]byte("correct horse"), bcrypt.MinCost)
+	if err != nil {
+		t.Fatalf("failed to hash password: %v", err)
+	}
+	user := &model.User{ID: 1, Email: "alice@example.com", PasswordHash: string(hash)}
+
+	tests := []struct {
+		name     string
+		password string
+		found    bool
+		wantErr  error
+	}{
+		{
+			name:     "success",
+			password: "correct horse",
+			found:    true,
+		},
+		{
+			name:     "wrong password",
+			password: "battery staple",
+			found:    true,
+			wantErr:  ErrInvalidCredentials,
+		},
+		{
+			name:     "unknown email",
+			password: "correct horse",
+			wantErr:  ErrInvalidCredentials,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			ctx := context.Background()
+			mockRepo := mockrepository.NewMockUserRepository(ctrl)
+			if tt.found {
+				mockRepo.EXPECT().FindByEmail(ctx, user.Email).Return(user, nil)
+			} else {
+				mockRepo.EXPECT().FindByEmail(ctx, user.Email).Return(nil, apperr.NotFound("user", 0))
+			}
+
+			uc := NewUserUseCase(mockRepo)
+
+			got, err := uc.Authenticate(ctx, "Alice@example.com", tt.password)
+
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Authenticate error = %v, want %v", err, tt.wantErr)
+			}
+			if tt.wantErr == nil && got.ID != user.ID {
+				t.Fatalf("Authenticate = %#v, want %#v", got, user)
+			}
+		})
+	}
+}
@@ -0,0 +1,212 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"backend/domain/apperr"
+	"backend/domain/model"
+	"backend/domain/repository"
+)
+
+const (
+	// maxTitleLength mirrors the VARCHAR(255) title columns of tasks and sub_tasks.
+	maxTitleLength = 255
+	// maxNoteBytes mirrors the TEXT note columns.
+	maxNoteBytes = 65535
+)
+
+var (
+	minDueDate = time.Date(1970, time.January, 1, 0, 0, 0, 0, time.UTC)
+	maxDueDate = time.Date(9999, time.December, 31, 23, 59, 59, 0, time.UTC)
+)
+
+// violations collects field violations so that every invalid field is reported at once.
+type violations []apperr.FieldViolation
+
+func (v *violations) add(field, description string) {
+	*v = append(*v, apperr.FieldViolation{Field: field, Description: description})
+}
+
+// err returns an InvalidArgument error carrying the collected violations, or nil when there are none.
+func (v violations) err(message string) error {
+	if len(v) == 0 {
+		return nil
+	}
+	return apperr.InvalidArgument(message, v...)
+}
+
+func (v *violations) checkTitle(field, title string) {
+	switch {
+	case strings.TrimSpace(title) == "":
+		v.add(field, "must not be empty")
+	case utf8.RuneCountInString(title) > maxTitleLength:
+		v.add(field, fmt.Sprintf("must be at most %d characters", maxTitleLength))
+	}
+}
+
+func (v *violations) checkNote(field, note string) {
+	if len(note) > maxNoteBytes {
+		v.add(field, fmt.Sprintf("must be at most %d bytes", maxNoteBytes))
+	}
+}
+
+func (v *violations) checkDueDate(field string, dueDate *time.Time) {
+	if dueDate == nil {
+		return
+	}
+	if dueDate.Before(minDueDate) || dueDate.After(maxDueDate) {
+		v.add(field, fmt.Sprintf("must be between %s and %s", minDueDate.Format("2006-01-02"), maxDueDate.Format("2006-01-02")))
+	}
+}
+
+// checkRecurrence validates a schedule. The until date must not fall before dueDate when both are set.
+func (v *violations) checkRecurrence(field string, r *model.Recurrence, dueDate *time.Time) {
+	if r == nil {
+		return
+	}
+	switch r.Frequency {
+	case model.RecurrenceDaily, model.RecurrenceWeekly, model.RecurrenceMonthly, model.RecurrenceYearly:
+	default:
+		v.add(field+".frequency", "must be one of DAILY, WEEKLY, MONTHLY or YEARLY")
+	}
+	if r.Interval < 0 {
+		v.add(field+".interval", "must not be negative")
+	}
+	if len(r.Weekdays) > 0 && r.Frequency != model.RecurrenceWeekly {
+		v.add(field+".weekdays", "may only be set for weekly schedules")
+	}
+	for _, d := range r.Weekdays {
+		if d < time.Sunday || d > time.Saturday {
+			v.add(field+".weekdays", "must be days of the week")
+			break
+		}
+	}
+	if r.Until != nil && dueDate != nil && r.Until.Before(*dueDate) {
+		v.add(field+".until", "must not be before due_date")
+	}
+}
+
+func (v *violations) checkPriority(field string, p model.Priority) {
+	if p < model.PriorityNone || p > model.PriorityUrgent {
+		v.add(field, "must be one of NONE, LOW, MEDIUM, HIGH or URGENT")
+	}
+}
+
+// checkNewSubTasks validates the subtasks created together with a new task.
+func (v *violations) checkNewSubTasks(field string, subTasks []model.SubTask) {
+	if len(subTasks) > maxNewSubTasks {
+		v.add(field, fmt.Sprintf("must list at most %d sub tasks", maxNewSubTasks))
+		return
+	}
+	for i, st := range subTasks {
+		prefix := fmt.Sprintf("%s[%d]", field, i)
+		v.checkTitle(prefix+".title", st.Title)
+		v.checkNote(prefix+".note", st.Note)
+		v.checkDueDate(prefix+".due_date", st.DueDate)
+	}
+}
+
+// checkListing validates the parts of a listing filter the caller chooses freely.
+func (v *violations) checkListing(filter repository.TaskFilter) {
+	if filter.MinPriority != nil {
+		v.checkPriority("min_priority", *filter.MinPriority)
+	}
+	v.checkOrder("order_by", filter.OrderBy)
+}
+
+// checkBulkTarget records a violation unless target names its tasks in exactly one way.
+func (v *violations) checkBulkTarget(target BulkTaskTarget) {
+	switch {
+	case len(target.IDs) == 0 && target.Filter == nil:
+		v.add("ids", "must not be empty unless filter is set")
+	case len(target.IDs) != 0 && target.Filter != nil:
+		v.add("filter", "must not be combined with ids")
+	case len(target.IDs) > maxBulkTasks:
+		v.add("ids", fmt.Sprintf("must list at most %d tasks", maxBulkTasks))
+	case target.Filter != nil:
+		v.checkListing(*target.Filter)
+	}
+}
+
+// checkOrder records a violation for unknown or repeated sort keys.
+func (v *violations) checkOrder(field string, order []repository.TaskOrder) {
+	seen := make(map[repository.TaskOrderField]bool, len(order))
+	for _, o := range order {
+		switch o.Field {
+		case repository.TaskOrderDueDate, repository.TaskOrderCreatedAt, repository.TaskOrderUpdatedAt,
+			repository.TaskOrderTitle, repository.TaskOrderCompletedAt, repository.TaskOrderPriority:
+		default:
+			v.add(field, "must only contain known fields")
+			return
+		}
+		if seen[o.Field] {
+			v.add(field, "must not repeat a field")
+			return
+		}
+		seen[o.Field] = true
+	}
+}
+
+// checkCategory records a violation when categoryID does not reference an existing category.
+// Zero means "no category" and is always accepted.
+func (v *violations) checkCategory(ctx context.Context, repo repository.CategoryRepository, field string, categoryID uint64) error {
+	if categoryID == 0 {
+		return nil
+	}
+	if _, err := repo.FindCategoryByID(ctx, categoryID); err != nil {
+		if apperr.IsNotFound(err) {
+			v.add(field, "must reference an existing category")
+			return nil
+		}
+		return err
+	}
+	return nil
+}
+
+// checkTags records a violation when any of tagIDs does not reference one of the user's tags.
+func (v *violations) checkTags(ctx context.Context, repo repository.TagRepository, field string, tagIDs []uint64) error {
+	if len(tagIDs) == 0 {
+		return nil
+	}
+	tags, err := repo.FindTagsByIDs(ctx, tagIDs)
+	if err != nil {
+		return err
+	}
+	if len(tags) != len(tagIDs) {
+		v.add(field, "must reference existing tags")
+	}
+	return nil
+}
+
+// uniqueIDs returns ids without duplicates, keeping the first occurrence of each.
+// A nil slice stays nil so that "not given" can still be told apart from "empty".
+func uniqueIDs(ids []uint64) []uint64 {
+	if ids == nil {
+		return nil
+	}
+	seen := make(map[uint64]bool, len(ids))
+	res := make([]uint64, 0, len(ids))
+	for _, id := range ids {
+		if !seen[id] {
+			seen[id] = true
+			res = append(res, id)
+		}
+	}
+	return res
+}
+
+// checkTask records a violation when taskID does not reference an existing task.
+func (v *violations) checkTask(ctx context.Context, repo repository.TaskRepository, field string, taskID uint64) error {
+	if _, err := repo.FindByID(ctx, taskID); err != nil {
+		if apperr.IsNotFound(err) {
+			v.add(field, "must reference an existing task")
+			return nil
+		}
+		return err
+	}
+	return nil
+}
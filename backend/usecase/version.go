@@ -0,0 +1,26 @@
+package usecase
+
+import (
+	"fmt"
+
+	"backend/domain/apperr"
+	"backend/domain/model"
+)
+
+// checkTaskVersion rejects an update made against a stale copy of task.
+// A nil expected version skips the check.
+func checkTaskVersion(task *model.Task, expected *int32) error {
+	if expected == nil || *expected == task.Version {
+		return nil
+	}
+	return apperr.Aborted(fmt.Sprintf("task %d is at version %d, not %d", task.ID, task.Version, *expected), task)
+}
+
+// checkSubTaskVersion rejects an update made against a stale copy of subTask.
+// A nil expected version skips the check.
+func checkSubTaskVersion(subTask *model.SubTask, expected *int32) error {
+	if expected == nil || *expected == subTask.Version {
+		return nil
+	}
+	return apperr.Aborted(fmt.Sprintf("sub task %d is at version %d, not %d", subTask.ID, subTask.Version, *expected), subTask)
+}
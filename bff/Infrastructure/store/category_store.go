@@ -29,11 +29,60 @@ func (s *CategoryStore) ListCategories(ctx context.Context) ([]*model.Category,
 
 	categories := make([]*model.Category, 0, len(res.Categories))
 	for _, c := range res.Categories {
-		categories = append(categories, &model.Category{
-			ID:   c.GetId(),
-			Name: c.GetName(),
-		})
+		categories = append(categories, toDomainCategory(c))
 	}
 
 	return categories, nil
 }
+
+func (s *CategoryStore) CreateCategory(ctx context.Context, name string) (*model.Category, error) {
+	res, err := s.client.CreateCategory(ctx, &pb.CreateCategoryRequest{Name: name})
+	if err != nil {
+		return nil, err
+	}
+
+	return toDomainCategory(res), nil
+}
+
+func (s *CategoryStore) RenameCategory(ctx context.Context, id uint64, name string) (*model.Category, error) {
+	res, err := s.client.UpdateCategory(ctx, &pb.UpdateCategoryRequest{Id: id, Name: name})
+	if err != nil {
+		return nil, err
+	}
+
+	return toDomainCategory(res), nil
+}
+
+func (s *CategoryStore) DeleteCategory(ctx context.Context, input repository.DeleteCategoryInput) (bool, error) {
+	req := &pb.DeleteCategoryRequest{
+		Id:         input.ID,
+		Policy:     toPBDeleteCategoryPolicy(input.Policy),
+		ReassignTo: input.ReassignTo,
+	}
+
+	res, err := s.client.DeleteCategory(ctx, req)
+	if err != nil {
+		return false, err
+	}
+
+	return res.Success, nil
+}
+
+func toDomainCategory(c *pb.Category) *model.Category {
+	return &model.Category{
+		ID:     c.GetId(),
+		NodeID: model.NewNodeID(model.NodeTypeCategory, c.GetId()),
+		Name:   c.GetName(),
+	}
+}
+
+func toPBDeleteCategoryPolicy(policy model.DeleteCategoryPolicy) pb.DeleteCategoryPolicy {
+	switch policy {
+	case model.DeleteCategoryPolicyReassign:
+		return pb.DeleteCategoryPolicy_DELETE_CATEGORY_POLICY_REASSIGN
+	case model.DeleteCategoryPolicyNullify:
+		return pb.DeleteCategoryPolicy_DELETE_CATEGORY_POLICY_NULLIFY
+	default:
+		return pb.DeleteCategoryPolicy_DELETE_CATEGORY_POLICY_REJECT
+	}
+}
@@ -0,0 +1,70 @@
+package store
+
+import (
+	"context"
+
+	"github.com/naoyakurokawa/go_grpc_graphql/domain/model"
+	"github.com/naoyakurokawa/go_grpc_graphql/domain/repository"
+	pb "github.com/naoyakurokawa/go_grpc_graphql/pkg/pb"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+var _ repository.TagRepository = (*TagStore)(nil)
+
+// TagStore implements TagRepository via gRPC.
+type TagStore struct {
+	client pb.TagServiceClient
+}
+
+// NewTagStore creates a TagStore.
+func NewTagStore(client pb.TagServiceClient) repository.TagRepository {
+	return &TagStore{client: client}
+}
+
+func (s *TagStore) ListTags(ctx context.Context) ([]*model.Tag, error) {
+	res, err := s.client.GetTags(ctx, &emptypb.Empty{})
+	if err != nil {
+		return nil, err
+	}
+
+	tags := make([]*model.Tag, 0, len(res.Tags))
+	for _, t := range res.Tags {
+		tags = append(tags, toDomainTag(t))
+	}
+
+	return tags, nil
+}
+
+func (s *TagStore) CreateTag(ctx context.Context, name string) (*model.Tag, error) {
+	res, err := s.client.CreateTag(ctx, &pb.CreateTagRequest{Name: name})
+	if err != nil {
+		return nil, err
+	}
+
+	return toDomainTag(res), nil
+}
+
+func (s *TagStore) RenameTag(ctx context.Context, id uint64, name string) (*model.Tag, error) {
+	res, err := s.client.UpdateTag(ctx, &pb.UpdateTagRequest{Id: id, Name: name})
+	if err != nil {
+		return nil, err
+	}
+
+	return toDomainTag(res), nil
+}
+
+func (s *TagStore) DeleteTag(ctx context.Context, id uint64) (bool, error) {
+	res, err := s.client.DeleteTag(ctx, &pb.DeleteTagRequest{Id: id})
+	if err != nil {
+		return false, err
+	}
+
+	return res.Success, nil
+}
+
+func toDomainTag(t *pb.Tag) *model.Tag {
+	return &model.Tag{
+		ID:   t.GetId(),
+		Name: t.GetName(),
+	}
+}
@@ -2,13 +2,20 @@ package store
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"io"
+	"log"
 	"strings"
 	"time"
 
 	"github.com/naoyakurokawa/go_grpc_graphql/domain/model"
 	"github.com/naoyakurokawa/go_grpc_graphql/domain/repository"
 	pb "github.com/naoyakurokawa/go_grpc_graphql/pkg/pb"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
@@ -30,16 +37,41 @@ func (s *TodoStore) CreateTask(ctx context.Context, input model.NewTask) (*model
 			Title:      input.Title,
 			Note:       input.Note,
 			CategoryId: input.CategoryID,
+			TagIds:     input.TagIds,
 		},
 	}
+	if input.Priority != nil {
+		req.Input.Priority = toPBPriority(*input.Priority)
+	}
+	if input.SyncCompletion != nil {
+		req.Input.SyncCompletion = *input.SyncCompletion
+	}
 
 	if input.DueDate != nil {
-		ts, err := parseDateString(input.DueDate)
+		ts, err := parseDateString("due_date", input.DueDate)
 		if err != nil {
 			return nil, err
 		}
 		req.Input.DueDate = ts
 	}
+	if input.Recurrence != nil {
+		rule, err := toPBRecurrence(input.Recurrence)
+		if err != nil {
+			return nil, err
+		}
+		req.Input.Recurrence = rule
+	}
+	for i, sub := range input.SubTasks {
+		dueDate, err := parseDateString(fmt.Sprintf("sub_tasks[%d].due_date", i), sub.DueDate)
+		if err != nil {
+			return nil, err
+		}
+		req.Input.SubTasks = append(req.Input.SubTasks, &pb.NewTaskSubTask{
+			Title:   sub.Title,
+			Note:    sub.Note,
+			DueDate: dueDate,
+		})
+	}
 
 	res, err := s.client.CreateTask(ctx, req)
 	if err != nil {
@@ -65,21 +97,49 @@ func (s *TodoStore) UpdateTask(ctx context.Context, input model.UpdateTask) (*mo
 	if input.Completed != nil {
 		req.Input.Completed = input.Completed
 	}
-	if input.CategoryID != nil {
-		value := *input.CategoryID
-		req.Input.CategoryId = &value
+	// 省略された項目は変更せず、明示的な null は値を削除する
+	if categoryID, ok := input.CategoryID.ValueOK(); ok {
+		if categoryID == nil {
+			req.Input.ClearCategory = true
+		} else {
+			req.Input.CategoryId = categoryID
+		}
 	}
-	if input.DueDate != nil {
-		ts, err := parseDateString(input.DueDate)
-		if err != nil {
-			return nil, err
+	if dueDate, ok := input.DueDate.ValueOK(); ok {
+		if dueDate == nil {
+			req.Input.ClearDueDate = true
+		} else {
+			ts, err := parseDateString("due_date", dueDate)
+			if err != nil {
+				return nil, err
+			}
+			req.Input.DueDate = ts
 		}
-		req.Input.DueDate = ts
 	}
+	if recurrence, ok := input.Recurrence.ValueOK(); ok {
+		if recurrence == nil {
+			req.Input.ClearRecurrence = true
+		} else {
+			rule, err := toPBRecurrence(recurrence)
+			if err != nil {
+				return nil, err
+			}
+			req.Input.Recurrence = rule
+		}
+	}
+	if input.TagIds != nil {
+		req.Input.TagIds = &pb.TagIdList{Ids: input.TagIds}
+	}
+	if input.Priority != nil {
+		priority := toPBPriority(*input.Priority)
+		req.Input.Priority = &priority
+	}
+	req.Input.ExpectedVersion = input.ExpectedVersion
+	req.Input.SyncCompletion = input.SyncCompletion
 
 	res, err := s.client.UpdateTask(ctx, req)
 	if err != nil {
-		return nil, err
+		return nil, versionConflict(err)
 	}
 
 	return toDomainTask(res), nil
@@ -96,20 +156,220 @@ func (s *TodoStore) DeleteTask(ctx context.Context, id uint64) (bool, error) {
 	return res.Success, nil
 }
 
-func (s *TodoStore) ListTasks(ctx context.Context, filter repository.TaskFilter) ([]*model.Task, error) {
-	req := &pb.GetTasksRequest{}
+func (s *TodoStore) GetTask(ctx context.Context, id uint64) (*model.Task, error) {
+	res, err := s.client.GetTask(ctx, &pb.TaskId{Id: id})
+	if err != nil {
+		return nil, err
+	}
+
+	return toDomainTask(res), nil
+}
+
+// BatchGetTasks returns the listed tasks without their subtasks, which the
+// Task.sub_tasks resolver loads separately.
+func (s *TodoStore) BatchGetTasks(ctx context.Context, ids []uint64) ([]*model.Task, error) {
+	res, err := s.client.BatchGetTasks(ctx, &pb.BatchGetTasksRequest{Ids: ids, SkipSubTasks: true})
+	if err != nil {
+		return nil, err
+	}
+
+	tasks := make([]*model.Task, 0, len(res.Tasks))
+	for _, task := range res.Tasks {
+		tasks = append(tasks, toDomainTask(task))
+	}
+
+	return tasks, nil
+}
+
+func (s *TodoStore) GetSubTask(ctx context.Context, id uint64) (*model.SubTask, error) {
+	res, err := s.client.GetSubTask(ctx, &pb.SubTaskId{Id: id})
+	if err != nil {
+		return nil, err
+	}
+
+	return toDomainSubTask(res), nil
+}
+
+func (s *TodoStore) ListDeletedTasks(ctx context.Context) ([]*model.Task, error) {
+	res, err := s.client.ListDeletedTasks(ctx, &emptypb.Empty{})
+	if err != nil {
+		return nil, err
+	}
+
+	tasks := make([]*model.Task, 0, len(res.Tasks))
+	for _, task := range res.Tasks {
+		tasks = append(tasks, toDomainTask(task))
+	}
+
+	return tasks, nil
+}
+
+func (s *TodoStore) ListTasksNeedingAttention(ctx context.Context) ([]*model.Task, error) {
+	res, err := s.client.ListTasksNeedingAttention(ctx, &emptypb.Empty{})
+	if err != nil {
+		return nil, err
+	}
+
+	tasks := make([]*model.Task, 0, len(res.Tasks))
+	for _, task := range res.Tasks {
+		tasks = append(tasks, toDomainTask(task))
+	}
+
+	return tasks, nil
+}
+
+func (s *TodoStore) RestoreTask(ctx context.Context, id uint64) (*model.Task, error) {
+	res, err := s.client.RestoreTask(ctx, &pb.TaskId{Id: id})
+	if err != nil {
+		return nil, err
+	}
+
+	return toDomainTask(res), nil
+}
+
+func (s *TodoStore) ListTaskHistory(ctx context.Context, taskID uint64) ([]*model.TaskHistoryEntry, error) {
+	res, err := s.client.ListTaskHistory(ctx, &pb.TaskId{Id: taskID})
+	if err != nil {
+		return nil, err
+	}
+
+	entries := make([]*model.TaskHistoryEntry, 0, len(res.GetEntries()))
+	for _, e := range res.GetEntries() {
+		entry := &model.TaskHistoryEntry{
+			ID:        e.GetId(),
+			Action:    model.TaskHistoryAction(strings.TrimPrefix(e.GetAction().String(), "TASK_HISTORY_ACTION_")),
+			SubTaskID: e.SubTaskId,
+			OldValue:  e.OldValue,
+			NewValue:  e.NewValue,
+			UserID:    e.GetUserId(),
+			CreatedAt: formatTimestamp(e.GetCreatedAt()),
+		}
+		if e.GetField() != "" {
+			field := e.GetField()
+			entry.Field = &field
+		}
+		entries = append(entries, entry)
+	}
+
+	return entries, nil
+}
+
+func (s *TodoStore) ListTasks(ctx context.Context, filter repository.TaskFilter, limit int32) ([]*model.Task, error) {
+	req, err := toGetTasksRequest(filter)
+	if err != nil {
+		return nil, err
+	}
+	// tasks クエリは先頭ページだけを返す。続きは tasksConnection でたどる
+	req.PageSize = limit
+
+	res, err := s.client.GetTasks(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	tasks := make([]*model.Task, 0, len(res.Tasks))
+	for _, task := range res.Tasks {
+		tasks = append(tasks, toDomainTask(task))
+	}
+
+	return tasks, nil
+}
+
+func (s *TodoStore) ListTasksConnection(ctx context.Context, filter repository.TaskFilter, page repository.PageArgs) (*model.TaskConnection, error) {
+	req, err := toGetTasksRequest(filter)
+	if err != nil {
+		return nil, err
+	}
+	req.PageSize = page.First
+	if page.After != nil {
+		req.PageToken = *page.After
+	}
+
+	res, err := s.client.GetTasks(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	edges := make([]*model.TaskEdge, 0, len(res.Tasks))
+	for i, task := range res.Tasks {
+		edges = append(edges, &model.TaskEdge{
+			Cursor: res.GetCursors()[i],
+			Node:   toDomainTask(task),
+		})
+	}
+
+	pageInfo := &model.PageInfo{
+		HasNextPage:     res.GetNextPageToken() != "",
+		HasPreviousPage: req.PageToken != "",
+	}
+	if len(edges) > 0 {
+		pageInfo.StartCursor = &edges[0].Cursor
+		pageInfo.EndCursor = &edges[len(edges)-1].Cursor
+	}
+
+	return &model.TaskConnection{
+		Edges:      edges,
+		PageInfo:   pageInfo,
+		TotalCount: res.GetTotalCount(),
+	}, nil
+}
+
+func (s *TodoStore) SearchTasks(ctx context.Context, query string, page repository.PageArgs) (*model.TaskSearchConnection, error) {
+	req := &pb.SearchTasksRequest{Query: query, PageSize: page.First}
+	if page.After != nil {
+		req.PageToken = *page.After
+	}
+
+	res, err := s.client.SearchTasks(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	edges := make([]*model.TaskSearchEdge, 0, len(res.Results))
+	for i, r := range res.Results {
+		highlights := make([]*model.SearchHighlight, 0, len(r.GetHighlights()))
+		for _, hl := range r.GetHighlights() {
+			highlights = append(highlights, &model.SearchHighlight{Field: hl.GetField(), Snippet: hl.GetSnippet()})
+		}
+		edges = append(edges, &model.TaskSearchEdge{
+			Cursor:     res.GetCursors()[i],
+			Node:       toDomainTask(r.GetTask()),
+			Score:      r.GetScore(),
+			Highlights: highlights,
+		})
+	}
+
+	pageInfo := &model.PageInfo{
+		HasNextPage:     res.GetNextPageToken() != "",
+		HasPreviousPage: req.PageToken != "",
+	}
+	if len(edges) > 0 {
+		pageInfo.StartCursor = &edges[0].Cursor
+		pageInfo.EndCursor = &edges[len(edges)-1].Cursor
+	}
+
+	return &model.TaskSearchConnection{
+		Edges:      edges,
+		PageInfo:   pageInfo,
+		TotalCount: res.GetTotalCount(),
+	}, nil
+}
+
+func toGetTasksRequest(filter repository.TaskFilter) (*pb.GetTasksRequest, error) {
+	// サブタスクは Task.sub_tasks のリゾルバが DataLoader 経由で取得する
+	req := &pb.GetTasksRequest{SkipSubTasks: true}
 	if filter.CategoryID != nil {
 		req.CategoryId = filter.CategoryID
 	}
 	if filter.DueDateStart != nil {
-		ts, err := parseDateString(filter.DueDateStart)
+		ts, err := parseDateString("due_date_start", filter.DueDateStart)
 		if err != nil {
 			return nil, err
 		}
 		req.DueDateStart = ts
 	}
 	if filter.DueDateEnd != nil {
-		ts, err := parseDateString(filter.DueDateEnd)
+		ts, err := parseDateString("due_date_end", filter.DueDateEnd)
 		if err != nil {
 			return nil, err
 		}
@@ -118,18 +378,27 @@ func (s *TodoStore) ListTasks(ctx context.Context, filter repository.TaskFilter)
 	if filter.IncompleteOnly {
 		req.IncompleteOnly = &filter.IncompleteOnly
 	}
-
-	res, err := s.client.GetTasks(ctx, req)
-	if err != nil {
-		return nil, err
+	if len(filter.TagIDs) > 0 {
+		req.TagIds = filter.TagIDs
+		if filter.TagMatch == model.TagMatchAll {
+			req.TagMatch = pb.TagMatch_TAG_MATCH_ALL
+		}
 	}
-
-	tasks := make([]*model.Task, 0, len(res.Tasks))
-	for _, task := range res.Tasks {
-		tasks = append(tasks, toDomainTask(task))
+	if filter.MinPriority != nil {
+		priority := toPBPriority(*filter.MinPriority)
+		req.MinPriority = &priority
+	}
+	for _, o := range filter.OrderBy {
+		order := &pb.TaskOrder{
+			Field: pb.TaskOrderField(pb.TaskOrderField_value["TASK_ORDER_FIELD_"+o.Field.String()]),
+		}
+		if o.Direction != nil && *o.Direction == model.SortDirectionDesc {
+			order.Direction = pb.SortDirection_SORT_DIRECTION_DESC
+		}
+		req.OrderBy = append(req.OrderBy, order)
 	}
 
-	return tasks, nil
+	return req, nil
 }
 
 func toDomainTask(task *pb.Task) *model.Task {
@@ -137,23 +406,89 @@ func toDomainTask(task *pb.Task) *model.Task {
 		return nil
 	}
 
-	subTasks := make([]*model.SubTask, 0, len(task.GetSubTasks()))
-	for _, st := range task.GetSubTasks() {
-		subTasks = append(subTasks, toDomainSubTask(st))
+	// subtask_total は常に数えられているので、件数が一致すれば埋め込みのサブタスクは揃っている。
+	// SkipSubTasks で省かれたときは一致しないので Task.sub_tasks のリゾルバが読み込み直す
+	var loadedSubTasks []*model.SubTask
+	if len(task.GetSubTasks()) == int(task.GetSubtaskTotal()) {
+		loadedSubTasks = make([]*model.SubTask, 0, len(task.GetSubTasks()))
+		for _, sub := range task.GetSubTasks() {
+			loadedSubTasks = append(loadedSubTasks, toDomainSubTask(sub))
+		}
 	}
 
 	return &model.Task{
-		ID:          task.GetId(),
-		Title:       task.GetTitle(),
-		Note:        task.GetNote(),
-		Completed:   task.GetCompleted(),
-		CategoryID:  toUint64Ptr(task.GetCategoryId()),
-		DueDate:     formatDate(task.GetDueDate()),
-		CompletedAt: formatTimestampPtr(task.GetCompletedAt()),
-		CreatedAt:   formatTimestamp(task.GetCreatedAt()),
-		UpdatedAt:   formatTimestamp(task.GetUpdatedAt()),
-		SubTasks:    subTasks,
+		ID:               task.GetId(),
+		NodeID:           model.NewNodeID(model.NodeTypeTask, task.GetId()),
+		Title:            task.GetTitle(),
+		Note:             task.GetNote(),
+		Completed:        task.GetCompleted(),
+		CategoryID:       toUint64Ptr(task.GetCategoryId()),
+		DueDate:          formatDate(task.GetDueDate()),
+		CompletedAt:      formatTimestampPtr(task.GetCompletedAt()),
+		CreatedAt:        formatTimestamp(task.GetCreatedAt()),
+		UpdatedAt:        formatTimestamp(task.GetUpdatedAt()),
+		DeletedAt:        formatTimestampPtr(task.GetDeletedAt()),
+		Recurrence:       toDomainRecurrence(task.GetRecurrence()),
+		TagIds:           toTagIDs(task.GetTagIds()),
+		Priority:         model.Priority(strings.TrimPrefix(task.GetPriority().String(), "PRIORITY_")),
+		Version:          task.GetVersion(),
+		SubtaskTotal:     task.GetSubtaskTotal(),
+		SubtaskCompleted: task.GetSubtaskCompleted(),
+		Progress:         task.GetProgress(),
+		SyncCompletion:   task.GetSyncCompletion(),
+		LoadedSubTasks:   loadedSubTasks,
+	}
+}
+
+func toPBPriority(p model.Priority) pb.Priority {
+	return pb.Priority(pb.Priority_value["PRIORITY_"+p.String()])
+}
+
+// toTagIDs keeps Task.tag_ids non-null for tasks without tags.
+func toTagIDs(ids []uint64) []uint64 {
+	if ids == nil {
+		return []uint64{}
+	}
+	return ids
+}
+
+func toPBRecurrence(input *model.RecurrenceInput) (*pb.Recurrence, error) {
+	until, err := parseDateString("recurrence.until", input.Until)
+	if err != nil {
+		return nil, err
+	}
+
+	rule := &pb.Recurrence{
+		Frequency: pb.RecurrenceFrequency(pb.RecurrenceFrequency_value["RECURRENCE_FREQUENCY_"+input.Frequency.String()]),
+		Until:     until,
 	}
+	if input.Interval != nil {
+		rule.Interval = *input.Interval
+	}
+	for _, d := range input.Weekdays {
+		rule.Weekdays = append(rule.Weekdays, pb.Weekday(pb.Weekday_value["WEEKDAY_"+d.String()]))
+	}
+	return rule, nil
+}
+
+func toDomainRecurrence(rule *pb.Recurrence) *model.Recurrence {
+	if rule == nil {
+		return nil
+	}
+
+	res := &model.Recurrence{
+		Frequency: model.RecurrenceFrequency(strings.TrimPrefix(rule.GetFrequency().String(), "RECURRENCE_FREQUENCY_")),
+		Interval:  rule.GetInterval(),
+		Weekdays:  make([]model.Weekday, 0, len(rule.GetWeekdays())),
+		Until:     formatDate(rule.GetUntil()),
+	}
+	if res.Interval == 0 {
+		res.Interval = 1
+	}
+	for _, d := range rule.GetWeekdays() {
+		res.Weekdays = append(res.Weekdays, model.Weekday(strings.TrimPrefix(d.String(), "WEEKDAY_")))
+	}
+	return res
 }
 
 func (s *TodoStore) CreateSubTask(ctx context.Context, input model.NewSubTask) (*model.SubTask, error) {
@@ -166,7 +501,7 @@ func (s *TodoStore) CreateSubTask(ctx context.Context, input model.NewSubTask) (
 	}
 
 	if input.DueDate != nil {
-		ts, err := parseDateString(input.DueDate)
+		ts, err := parseDateString("due_date", input.DueDate)
 		if err != nil {
 			return nil, err
 		}
@@ -181,20 +516,147 @@ func (s *TodoStore) CreateSubTask(ctx context.Context, input model.NewSubTask) (
 	return toDomainSubTask(res), nil
 }
 
-func (s *TodoStore) ToggleSubTask(ctx context.Context, id uint64, completed bool) (*model.SubTask, error) {
+func (s *TodoStore) ToggleSubTask(ctx context.Context, id uint64, completed bool, expectedVersion *int32) (*model.SubTask, error) {
 	req := &pb.ToggleSubTaskRequest{
-		Id:        id,
-		Completed: completed,
+		Id:              id,
+		Completed:       completed,
+		ExpectedVersion: expectedVersion,
 	}
 
 	res, err := s.client.ToggleSubTask(ctx, req)
 	if err != nil {
-		return nil, err
+		return nil, versionConflict(err)
+	}
+
+	return toDomainSubTask(res), nil
+}
+
+func (s *TodoStore) UpdateSubTask(ctx context.Context, input model.UpdateSubTask) (*model.SubTask, error) {
+	req := &pb.UpdateSubTaskRequest{
+		Input: &pb.UpdateSubTask{
+			Id:              input.ID,
+			Title:           input.Title,
+			Note:            input.Note,
+			ExpectedVersion: input.ExpectedVersion,
+		},
+	}
+
+	if input.DueDate != nil {
+		ts, err := parseDateString("due_date", input.DueDate)
+		if err != nil {
+			return nil, err
+		}
+		req.Input.DueDate = ts
+	}
+
+	res, err := s.client.UpdateSubTask(ctx, req)
+	if err != nil {
+		return nil, versionConflict(err)
 	}
 
 	return toDomainSubTask(res), nil
 }
 
+func (s *TodoStore) DeleteSubTask(ctx context.Context, id uint64) (bool, error) {
+	res, err := s.client.DeleteSubTask(ctx, &pb.SubTaskId{Id: id})
+	if err != nil {
+		return false, err
+	}
+
+	return res.Success, nil
+}
+
+func (s *TodoStore) ReorderSubTasks(ctx context.Context, taskID uint64, subTaskIDs []uint64) ([]*model.SubTask, error) {
+	req := &pb.ReorderSubTasksRequest{
+		TaskId:     taskID,
+		SubTaskIds: subTaskIDs,
+	}
+
+	res, err := s.client.ReorderSubTasks(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	subTasks := make([]*model.SubTask, 0, len(res.GetSubTasks()))
+	for _, st := range res.GetSubTasks() {
+		subTasks = append(subTasks, toDomainSubTask(st))
+	}
+
+	return subTasks, nil
+}
+
+func (s *TodoStore) ListSubTasksByTaskIDs(ctx context.Context, taskIDs []uint64) (map[uint64][]*model.SubTask, error) {
+	res, err := s.client.BatchListSubTasks(ctx, &pb.TaskIds{Ids: taskIDs})
+	if err != nil {
+		return nil, err
+	}
+
+	subTasksByTask := make(map[uint64][]*model.SubTask, len(res.GetSubTasks()))
+	for taskID, list := range res.GetSubTasks() {
+		subTasks := make([]*model.SubTask, 0, len(list.GetSubTasks()))
+		for _, st := range list.GetSubTasks() {
+			subTasks = append(subTasks, toDomainSubTask(st))
+		}
+		subTasksByTask[taskID] = subTasks
+	}
+
+	return subTasksByTask, nil
+}
+
+// WatchTasks opens a WatchTasks stream and forwards its events until ctx is
+// cancelled or the stream ends, at which point the returned channel is closed.
+func (s *TodoStore) WatchTasks(ctx context.Context, types ...repository.TaskEventType) (<-chan repository.TaskEvent, error) {
+	req := &pb.WatchTasksRequest{}
+	for _, t := range types {
+		req.Types = append(req.Types, pb.TaskEventType(t))
+	}
+
+	stream, err := s.client.WatchTasks(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	// backend は購読を登録した時点でヘッダーを返すので、認証エラーなどはここで受け取れる
+	if _, err := stream.Header(); err != nil {
+		return nil, err
+	}
+
+	events := make(chan repository.TaskEvent)
+	go func() {
+		defer close(events)
+		for {
+			res, err := stream.Recv()
+			if err != nil {
+				if !errors.Is(err, io.EOF) && status.Code(err) != codes.Canceled {
+					log.Printf("task watch stream ended: %v", err)
+				}
+				return
+			}
+
+			select {
+			case events <- toTaskEvent(res):
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return events, nil
+}
+
+func toTaskEvent(ev *pb.TaskEvent) repository.TaskEvent {
+	event := repository.TaskEvent{
+		Type:   repository.TaskEventType(ev.GetType()),
+		TaskID: ev.GetTaskId(),
+	}
+	if ev.GetTask() != nil {
+		event.Task = toDomainTask(ev.GetTask())
+	}
+	if ev.GetSubTask() != nil {
+		event.SubTask = toDomainSubTask(ev.GetSubTask())
+	}
+	return event
+}
+
 func toUint64Ptr(v uint64) *uint64 {
 	if v == 0 {
 		return nil
@@ -231,7 +693,7 @@ func formatTimestampPtr(ts *timestamppb.Timestamp) *string {
 
 const dateLayout = "2006-01-02"
 
-func parseDateString(value *string) (*timestamppb.Timestamp, error) {
+func parseDateString(field string, value *string) (*timestamppb.Timestamp, error) {
 	if value == nil {
 		return nil, nil
 	}
@@ -243,7 +705,7 @@ func parseDateString(value *string) (*timestamppb.Timestamp, error) {
 
 	parsed, err := time.ParseInLocation(dateLayout, trimmed, time.Local)
 	if err != nil {
-		return nil, fmt.Errorf("invalid due_date format (expected YYYY-MM-DD): %w", err)
+		return nil, invalidArgument(field, fmt.Sprintf("invalid %s format (expected YYYY-MM-DD)", field))
 	}
 
 	return timestamppb.New(parsed), nil
@@ -256,7 +718,9 @@ func toDomainSubTask(sub *pb.SubTask) *model.SubTask {
 
 	return &model.SubTask{
 		ID:          sub.GetId(),
+		NodeID:      model.NewNodeID(model.NodeTypeSubTask, sub.GetId()),
 		TaskID:      sub.GetTaskId(),
+		Position:    sub.GetPosition(),
 		Title:       sub.GetTitle(),
 		Note:        sub.GetNote(),
 		Completed:   sub.GetCompleted(),
@@ -264,5 +728,146 @@ func toDomainSubTask(sub *pb.SubTask) *model.SubTask {
 		DueDate:     formatDate(sub.GetDueDate()),
 		CreatedAt:   formatTimestamp(sub.GetCreatedAt()),
 		UpdatedAt:   formatTimestamp(sub.GetUpdatedAt()),
+		Version:     sub.GetVersion(),
+	}
+}
+
+func (s *TodoStore) BulkUpdateTasks(ctx context.Context, target repository.BulkTaskTarget, change model.BulkTaskChange) (*repository.BulkTaskResult, error) {
+	pbTarget, err := toPBBulkTaskTarget(target)
+	if err != nil {
+		return nil, err
+	}
+	req := &pb.BulkUpdateTasksRequest{
+		Target:           pbTarget,
+		Completed:        change.Completed,
+		CategoryId:       change.CategoryID,
+		ShiftDueDateDays: change.ShiftDueDateDays,
+	}
+	if change.DueDate != nil {
+		ts, err := parseDateString("due_date", change.DueDate)
+		if err != nil {
+			return nil, err
+		}
+		req.DueDate = ts
+	}
+
+	res, err := s.client.BulkUpdateTasks(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return toBulkTaskResult(res), nil
+}
+
+func (s *TodoStore) BulkDeleteTasks(ctx context.Context, target repository.BulkTaskTarget) (*repository.BulkTaskResult, error) {
+	pbTarget, err := toPBBulkTaskTarget(target)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := s.client.BulkDeleteTasks(ctx, &pb.BulkDeleteTasksRequest{Target: pbTarget})
+	if err != nil {
+		return nil, err
+	}
+	return toBulkTaskResult(res), nil
+}
+
+// statsWeeks maps each StatsRange onto the number of weeks GetTaskStats covers.
+var statsWeeks = map[model.StatsRange]int32{
+	model.StatsRangeLastWeek:    1,
+	model.StatsRangeLast4Weeks:  4,
+	model.StatsRangeLast12Weeks: 12,
+	model.StatsRangeLast52Weeks: 52,
+}
+
+func (s *TodoStore) GetTaskStats(ctx context.Context, statsRange model.StatsRange, categoryID *uint64) (*model.TaskStats, error) {
+	res, err := s.client.GetTaskStats(ctx, &pb.GetTaskStatsRequest{
+		Weeks:      statsWeeks[statsRange],
+		CategoryId: categoryID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	stats := &model.TaskStats{
+		From:                     res.GetFrom().AsTime().In(time.Local).Format(dateLayout),
+		To:                       res.GetTo().AsTime().In(time.Local).Format(dateLayout),
+		Categories:               make([]*model.CategoryTaskStats, 0, len(res.GetCategories())),
+		Overdue:                  res.GetOverdue(),
+		CompletedPerDay:          make([]*model.DailyCompletion, 0, len(res.GetCompletedPerDay())),
+		AverageCompletionSeconds: res.AverageCompletionSeconds,
+	}
+	for _, c := range res.GetCategories() {
+		stats.Categories = append(stats.Categories, &model.CategoryTaskStats{
+			CategoryID: toUint64Ptr(c.GetCategoryId()),
+			Open:       c.GetOpen(),
+			Completed:  c.GetCompleted(),
+		})
+	}
+	for _, d := range res.GetCompletedPerDay() {
+		stats.CompletedPerDay = append(stats.CompletedPerDay, &model.DailyCompletion{
+			Date:      d.GetDate().AsTime().In(time.Local).Format(dateLayout),
+			Completed: d.GetCompleted(),
+		})
+	}
+
+	return stats, nil
+}
+
+func toPBBulkTaskTarget(target repository.BulkTaskTarget) (*pb.BulkTaskTarget, error) {
+	res := &pb.BulkTaskTarget{Ids: target.IDs}
+	if target.Filter != nil {
+		filter, err := toGetTasksRequest(*target.Filter)
+		if err != nil {
+			return nil, err
+		}
+		res.Filter = filter
+	}
+	return res, nil
+}
+
+func toBulkTaskResult(res *pb.BulkTasksResponse) *repository.BulkTaskResult {
+	outcomes := make([]repository.BulkTaskOutcome, 0, len(res.GetResults()))
+	for _, r := range res.GetResults() {
+		outcome := repository.BulkTaskOutcome{TaskID: r.GetTaskId(), Task: toDomainTask(r.GetTask())}
+		if e := r.GetError(); e != nil {
+			outcome.Err = status.Error(codes.Code(e.GetCode()), e.GetMessage())
+		}
+		outcomes = append(outcomes, outcome)
+	}
+	return &repository.BulkTaskResult{Applied: res.GetApplied(), Outcomes: outcomes}
+}
+
+// versionConflict turns the Aborted status of a stale update into a
+// repository.VersionConflictError carrying the current server copy. Other
+// errors are returned as is.
+func versionConflict(err error) error {
+	st, ok := status.FromError(err)
+	if !ok || st.Code() != codes.Aborted {
+		return err
+	}
+	for _, detail := range st.Details() {
+		switch d := detail.(type) {
+		case *pb.Task:
+			return repository.NewVersionConflictError(st, toDomainTask(d))
+		case *pb.SubTask:
+			return repository.NewVersionConflictError(st, toDomainSubTask(d))
+		}
+	}
+	return err
+}
+
+// invalidArgument builds an InvalidArgument status carrying a single field
+// violation, so input errors detected before calling the backend are reported
+// the same way as the backend's own validation errors.
+func invalidArgument(field, description string) error {
+	st := status.New(codes.InvalidArgument, description)
+	detailed, err := st.WithDetails(&errdetails.BadRequest{
+		FieldViolations: []*errdetails.BadRequest_FieldViolation{
+			{Field: field, Description: description},
+		},
+	})
+	if err != nil {
+		return st.Err()
 	}
+	return detailed.Err()
 }
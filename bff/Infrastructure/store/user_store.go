@@ -0,0 +1,47 @@
+package store
+
+import (
+	"context"
+
+	"github.com/naoyakurokawa/go_grpc_graphql/domain/model"
+	"github.com/naoyakurokawa/go_grpc_graphql/domain/repository"
+	pb "github.com/naoyakurokawa/go_grpc_graphql/pkg/pb"
+)
+
+var _ repository.UserRepository = (*UserStore)(nil)
+
+// UserStore implements UserRepository via gRPC.
+type UserStore struct {
+	client pb.UserServiceClient
+}
+
+// NewUserStore creates a UserStore.
+func NewUserStore(client pb.UserServiceClient) repository.UserRepository {
+	return &UserStore{client: client}
+}
+
+func (s *UserStore) SignUp(ctx context.Context, email, password string) (*model.User, error) {
+	res, err := s.client.SignUp(ctx, &pb.SignUpRequest{Email: email, Password: password})
+	if err != nil {
+		return nil, err
+	}
+
+	return toDomainUser(res), nil
+}
+
+func (s *UserStore) Authenticate(ctx context.Context, email, password string) (*model.User, error) {
+	res, err := s.client.Authenticate(ctx, &pb.AuthenticateRequest{Email: email, Password: password})
+	if err != nil {
+		return nil, err
+	}
+
+	return toDomainUser(res), nil
+}
+
+func toDomainUser(u *pb.User) *model.User {
+	return &model.User{
+		ID:        u.GetId(),
+		Email:     u.GetEmail(),
+		CreatedAt: formatTimestamp(u.GetCreatedAt()),
+	}
+}
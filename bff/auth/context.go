@@ -0,0 +1,18 @@
+// Package auth verifies the tokens presented by GraphQL clients and forwards
+// the authenticated user to the backend.
+package auth
+
+import "context"
+
+type userIDKey struct{}
+
+// WithUserID returns a copy of ctx that carries the authenticated user's id.
+func WithUserID(ctx context.Context, userID uint64) context.Context {
+	return context.WithValue(ctx, userIDKey{}, userID)
+}
+
+// UserIDFromContext returns the authenticated user's id, if ctx carries one.
+func UserIDFromContext(ctx context.Context) (uint64, bool) {
+	userID, ok := ctx.Value(userIDKey{}).(uint64)
+	return userID, ok && userID != 0
+}
@@ -0,0 +1,48 @@
+package auth
+
+import (
+	"context"
+	"strconv"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// UserIDMetadataKey is the gRPC metadata key the backend reads the calling user from.
+const UserIDMetadataKey = "x-user-id"
+
+// ServiceTokenMetadataKey is the gRPC metadata key carrying the secret shared with the backend.
+const ServiceTokenMetadataKey = "x-service-token"
+
+// ServiceToken authenticates the BFF to the backend, which trusts the user in
+// UserIDMetadataKey only on calls that carry it. Use it with grpc.WithPerRPCCredentials.
+type ServiceToken string
+
+// GetRequestMetadata attaches the token to every call.
+func (t ServiceToken) GetRequestMetadata(context.Context, ...string) (map[string]string, error) {
+	return map[string]string{ServiceTokenMetadataKey: string(t)}, nil
+}
+
+// RequireTransportSecurity allows the token over plaintext: the backend is only
+// reachable on the internal network shared with the BFF.
+func (ServiceToken) RequireTransportSecurity() bool {
+	return false
+}
+
+// UnaryClientInterceptor forwards the authenticated user in ctx to the backend as metadata.
+func UnaryClientInterceptor(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+	return invoker(withOutgoingUserID(ctx), method, req, reply, cc, opts...)
+}
+
+// StreamClientInterceptor forwards the authenticated user in ctx to the backend
+// as metadata when a stream, such as a subscription's WatchTasks, is opened.
+func StreamClientInterceptor(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+	return streamer(withOutgoingUserID(ctx), desc, cc, method, opts...)
+}
+
+func withOutgoingUserID(ctx context.Context) context.Context {
+	if userID, ok := UserIDFromContext(ctx); ok {
+		ctx = metadata.AppendToOutgoingContext(ctx, UserIDMetadataKey, strconv.FormatUint(userID, 10))
+	}
+	return ctx
+}
@@ -0,0 +1,64 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/99designs/gqlgen/graphql/handler/transport"
+	"github.com/labstack/echo"
+)
+
+const bearerPrefix = "Bearer "
+
+var errNotBearer = errors.New("authorization must use the Bearer scheme")
+
+// Middleware verifies the bearer token of the request, if any, and puts the
+// user it identifies into the request context. Requests without a token pass
+// through anonymously so that signUp and login stay reachable; the backend
+// rejects every other operation for them.
+func Middleware(tokens *TokenManager) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			header := c.Request().Header.Get(echo.HeaderAuthorization)
+			if header == "" {
+				return next(c)
+			}
+
+			userID, err := tokens.verifyBearer(header)
+			if err != nil {
+				return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+			}
+
+			req := c.Request()
+			c.SetRequest(req.WithContext(WithUserID(req.Context(), userID)))
+			return next(c)
+		}
+	}
+}
+
+// WebsocketInitFunc authenticates websocket connections. Browsers cannot set
+// headers on websocket requests, so clients send the same "Bearer <token>"
+// value as the authorization field of the connection_init payload instead.
+func WebsocketInitFunc(tokens *TokenManager) transport.WebsocketInitFunc {
+	return func(ctx context.Context, payload transport.InitPayload) (context.Context, *transport.InitPayload, error) {
+		header := payload.Authorization()
+		if header == "" {
+			return ctx, nil, nil
+		}
+
+		userID, err := tokens.verifyBearer(header)
+		if err != nil {
+			return ctx, nil, err
+		}
+		return WithUserID(ctx, userID), nil, nil
+	}
+}
+
+func (m *TokenManager) verifyBearer(header string) (uint64, error) {
+	if !strings.HasPrefix(header, bearerPrefix) {
+		return 0, errNotBearer
+	}
+	return m.Verify(strings.TrimPrefix(header, bearerPrefix))
+}
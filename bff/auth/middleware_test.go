@@ -0,0 +1,76 @@
+package auth
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/labstack/echo"
+)
+
+func TestMiddleware(t *testing.T) {
+	t.Parallel()
+
+	tokens := NewTokenManager("secret", time.Hour)
+	valid, _, err := tokens.Issue(42)
+	if err != nil {
+		t.Fatalf("Issue returned error: %v", err)
+	}
+	expiredTokens := NewTokenManager("secret", time.Hour)
+	expiredTokens.now = func() time.Time { return time.Now().Add(-2 * time.Hour) }
+	expired, _, err := expiredTokens.Issue(42)
+	if err != nil {
+		t.Fatalf("Issue returned error: %v", err)
+	}
+	foreign, _, err := NewTokenManager("other", time.Hour).Issue(42)
+	if err != nil {
+		t.Fatalf("Issue returned error: %v", err)
+	}
+
+	tests := []struct {
+		name       string
+		header     string
+		wantStatus int
+		wantUserID uint64
+	}{
+		{name: "valid", header: "Bearer " + valid, wantStatus: http.StatusOK, wantUserID: 42},
+		{name: "expired", header: "Bearer " + expired, wantStatus: http.StatusUnauthorized},
+		{name: "wrong secret", header: "Bearer " + foreign, wantStatus: http.StatusUnauthorized},
+		{name: "not bearer", header: "Basic " + valid, wantStatus: http.StatusUnauthorized},
+		// requests without a token pass through anonymously
+		{name: "missing header", wantStatus: http.StatusOK},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			e := echo.New()
+			req := httptest.NewRequest(http.MethodPost, "/query", nil)
+			if tt.header != "" {
+				req.Header.Set(echo.HeaderAuthorization, tt.header)
+			}
+			rec := httptest.NewRecorder()
+
+			var gotUserID uint64
+			next := func(c echo.Context) error {
+				gotUserID, _ = UserIDFromContext(c.Request().Context())
+				return c.NoContent(http.StatusOK)
+			}
+
+			c := e.NewContext(req, rec)
+			if err := Middleware(tokens)(next)(c); err != nil {
+				e.HTTPErrorHandler(err, c)
+			}
+
+			if rec.Code != tt.wantStatus {
+				t.Fatalf("status = %d, want %d", rec.Code, tt.wantStatus)
+			}
+			if gotUserID != tt.wantUserID {
+				t.Fatalf("user id = %d, want %d", gotUserID, tt.wantUserID)
+			}
+		})
+	}
+}
@@ -0,0 +1,67 @@
+package auth
+
+import (
+	"errors"
+	"strconv"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+const issuer = "go_grpc_graphql"
+
+// ErrInvalidToken is returned when a token is malformed, expired or signed with another key.
+var ErrInvalidToken = errors.New("invalid token")
+
+// TokenManager issues and verifies HS256 signed JWTs. Verification only needs
+// the shared secret, so no key server is involved.
+type TokenManager struct {
+	secret []byte
+	ttl    time.Duration
+	now    func() time.Time
+}
+
+// NewTokenManager creates a TokenManager signing with secret. Issued tokens expire after ttl.
+func NewTokenManager(secret string, ttl time.Duration) *TokenManager {
+	return &TokenManager{secret: []byte(secret), ttl: ttl, now: time.Now}
+}
+
+// Issue returns a signed token identifying userID, along with its expiry.
+func (m *TokenManager) Issue(userID uint64) (string, time.Time, error) {
+	now := m.now()
+	expiresAt := now.Add(m.ttl)
+	claims := jwt.RegisteredClaims{
+		Issuer:    issuer,
+		Subject:   strconv.FormatUint(userID, 10),
+		IssuedAt:  jwt.NewNumericDate(now),
+		ExpiresAt: jwt.NewNumericDate(expiresAt),
+	}
+
+	signed, err := jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString(m.secret)
+	if err != nil {
+		return "", time.Time{}, err
+	}
+	return signed, expiresAt, nil
+}
+
+// Verify checks the token's signature and expiry and returns the user it identifies.
+func (m *TokenManager) Verify(token string) (uint64, error) {
+	var claims jwt.RegisteredClaims
+	_, err := jwt.ParseWithClaims(token, &claims, func(*jwt.Token) (any, error) {
+		return m.secret, nil
+	},
+		jwt.WithValidMethods([]string{jwt.SigningMethodHS256.Alg()}),
+		jwt.WithIssuer(issuer),
+		jwt.WithExpirationRequired(),
+		jwt.WithTimeFunc(m.now),
+	)
+	if err != nil {
+		return 0, ErrInvalidToken
+	}
+
+	userID, err := strconv.ParseUint(claims.Subject, 10, 64)
+	if err != nil || userID == 0 {
+		return 0, ErrInvalidToken
+	}
+	return userID, nil
+}
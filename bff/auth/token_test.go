@@ -0,0 +1,51 @@
+package auth
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestTokenManager_Verify(t *testing.T) {
+	t.Parallel()
+
+	issuedAt := time.Date(2025, 4, 1, 9, 0, 0, 0, time.UTC)
+	issuer := NewTokenManager("secret", time.Hour)
+	issuer.now = func() time.Time { return issuedAt }
+	token, _, err := issuer.Issue(42)
+	if err != nil {
+		t.Fatalf("Issue returned error: %v", err)
+	}
+
+	tests := []struct {
+		name    string
+		secret  string
+		now     time.Time
+		token   string
+		want    uint64
+		wantErr error
+	}{
+		{name: "valid", secret: "secret", now: issuedAt.Add(time.Minute), token: token, want: 42},
+		{name: "expired", secret: "secret", now: issuedAt.Add(2 * time.Hour), token: token, wantErr: ErrInvalidToken},
+		{name: "wrong secret", secret: "other", now: issuedAt.Add(time.Minute), token: token, wantErr: ErrInvalidToken},
+		{name: "malformed", secret: "secret", now: issuedAt.Add(time.Minute), token: "not-a-token", wantErr: ErrInvalidToken},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			m := NewTokenManager(tt.secret, time.Hour)
+			m.now = func() time.Time { return tt.now }
+
+			got, err := m.Verify(tt.token)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Verify error = %v, want %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Fatalf("Verify = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,50 @@
+package config
+
+import (
+	"time"
+
+	"github.com/kelseyhightower/envconfig"
+)
+
+// Config represents application configuration.
+type Config struct {
+	App     AppConfig
+	Auth    AuthConfig
+	Backend BackendConfig
+}
+
+// AppConfig bundles settings describing the running environment.
+type AppConfig struct {
+	Env string `envconfig:"APP_ENV" default:"development"`
+}
+
+// IsProduction reports whether the BFF runs in production mode.
+func (c AppConfig) IsProduction() bool {
+	return c.Env == "production"
+}
+
+// AuthConfig holds the key and lifetime of the JWTs issued to clients.
+type AuthConfig struct {
+	JWTSecret string        `envconfig:"JWT_SECRET" required:"true"`
+	TokenTTL  time.Duration `envconfig:"JWT_TTL" default:"24h"`
+}
+
+// BackendConfig holds the secret the BFF authenticates itself to the backend with.
+type BackendConfig struct {
+	ServiceToken string `envconfig:"BACKEND_SERVICE_TOKEN" required:"true"`
+}
+
+// Load reads environment variables into Config using envconfig.
+func Load() (*Config, error) {
+	cfg := &Config{}
+	if err := envconfig.Process("", &cfg.App); err != nil {
+		return nil, err
+	}
+	if err := envconfig.Process("", &cfg.Auth); err != nil {
+		return nil, err
+	}
+	if err := envconfig.Process("", &cfg.Backend); err != nil {
+		return nil, err
+	}
+	return cfg, nil
+}
@@ -5,6 +5,7 @@ import (
 	"log"
 
 	"github.com/naoyakurokawa/go_grpc_graphql/domain/model"
+	"github.com/naoyakurokawa/go_grpc_graphql/domain/repository"
 	"github.com/naoyakurokawa/go_grpc_graphql/usecase"
 )
 
@@ -27,3 +28,33 @@ func (c *CategoryController) ListCategories(ctx context.Context) ([]*model.Categ
 
 	return categories, nil
 }
+
+func (c *CategoryController) CreateCategory(ctx context.Context, name string) (*model.Category, error) {
+	category, err := c.usecase.CreateCategory(ctx, name)
+	if err != nil {
+		log.Printf("failed to create category: %v", err)
+		return nil, err
+	}
+
+	return category, nil
+}
+
+func (c *CategoryController) RenameCategory(ctx context.Context, id uint64, name string) (*model.Category, error) {
+	category, err := c.usecase.RenameCategory(ctx, id, name)
+	if err != nil {
+		log.Printf("failed to rename category: %v", err)
+		return nil, err
+	}
+
+	return category, nil
+}
+
+func (c *CategoryController) DeleteCategory(ctx context.Context, input repository.DeleteCategoryInput) (bool, error) {
+	ok, err := c.usecase.DeleteCategory(ctx, input)
+	if err != nil {
+		log.Printf("failed to delete category: %v", err)
+		return false, err
+	}
+
+	return ok, nil
+}
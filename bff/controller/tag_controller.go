@@ -0,0 +1,59 @@
+package controller
+
+import (
+	"context"
+	"log"
+
+	"github.com/naoyakurokawa/go_grpc_graphql/domain/model"
+	"github.com/naoyakurokawa/go_grpc_graphql/usecase"
+)
+
+// TagController orchestrates tag related operations.
+type TagController struct {
+	usecase usecase.TagUsecase
+}
+
+// NewTagController constructs a TagController instance.
+func NewTagController(uc usecase.TagUsecase) *TagController {
+	return &TagController{usecase: uc}
+}
+
+func (c *TagController) ListTags(ctx context.Context) ([]*model.Tag, error) {
+	tags, err := c.usecase.ListTags(ctx)
+	if err != nil {
+		log.Printf("failed to fetch tags: %v", err)
+		return nil, err
+	}
+
+	return tags, nil
+}
+
+func (c *TagController) CreateTag(ctx context.Context, name string) (*model.Tag, error) {
+	tag, err := c.usecase.CreateTag(ctx, name)
+	if err != nil {
+		log.Printf("failed to create tag: %v", err)
+		return nil, err
+	}
+
+	return tag, nil
+}
+
+func (c *TagController) RenameTag(ctx context.Context, id uint64, name string) (*model.Tag, error) {
+	tag, err := c.usecase.RenameTag(ctx, id, name)
+	if err != nil {
+		log.Printf("failed to rename tag: %v", err)
+		return nil, err
+	}
+
+	return tag, nil
+}
+
+func (c *TagController) DeleteTag(ctx context.Context, id uint64) (bool, error) {
+	ok, err := c.usecase.DeleteTag(ctx, id)
+	if err != nil {
+		log.Printf("failed to delete tag: %v", err)
+		return false, err
+	}
+
+	return ok, nil
+}
@@ -49,8 +49,47 @@ func (c *TodoController) DeleteTask(ctx context.Context, id uint64) (bool, error
 	return ok, nil
 }
 
-func (c *TodoController) ListTasks(ctx context.Context, filter repository.TaskFilter) ([]*model.Task, error) {
-	tasks, err := c.usecase.ListTasks(ctx, filter)
+func (c *TodoController) GetSubTask(ctx context.Context, id uint64) (*model.SubTask, error) {
+	subTask, err := c.usecase.GetSubTask(ctx, id)
+	if err != nil {
+		log.Printf("failed to fetch sub task: %v", err)
+		return nil, err
+	}
+	return subTask, nil
+}
+
+func (c *TodoController) ListDeletedTasks(ctx context.Context) ([]*model.Task, error) {
+	tasks, err := c.usecase.ListDeletedTasks(ctx)
+	if err != nil {
+		log.Printf("failed to fetch deleted tasks: %v", err)
+		return nil, err
+	}
+
+	return tasks, nil
+}
+
+func (c *TodoController) ListTasksNeedingAttention(ctx context.Context) ([]*model.Task, error) {
+	tasks, err := c.usecase.ListTasksNeedingAttention(ctx)
+	if err != nil {
+		log.Printf("failed to fetch tasks needing attention: %v", err)
+		return nil, err
+	}
+
+	return tasks, nil
+}
+
+func (c *TodoController) RestoreTask(ctx context.Context, id uint64) (*model.Task, error) {
+	task, err := c.usecase.RestoreTask(ctx, id)
+	if err != nil {
+		log.Printf("failed to restore task: %v", err)
+		return nil, err
+	}
+
+	return task, nil
+}
+
+func (c *TodoController) ListTasks(ctx context.Context, filter repository.TaskFilter, limit int32) ([]*model.Task, error) {
+	tasks, err := c.usecase.ListTasks(ctx, filter, limit)
 	if err != nil {
 		log.Printf("failed to fetch tasks: %v", err)
 		return nil, err
@@ -59,6 +98,26 @@ func (c *TodoController) ListTasks(ctx context.Context, filter repository.TaskFi
 	return tasks, nil
 }
 
+func (c *TodoController) ListTasksConnection(ctx context.Context, filter repository.TaskFilter, page repository.PageArgs) (*model.TaskConnection, error) {
+	conn, err := c.usecase.ListTasksConnection(ctx, filter, page)
+	if err != nil {
+		log.Printf("failed to fetch tasks connection: %v", err)
+		return nil, err
+	}
+
+	return conn, nil
+}
+
+func (c *TodoController) SearchTasks(ctx context.Context, query string, page repository.PageArgs) (*model.TaskSearchConnection, error) {
+	conn, err := c.usecase.SearchTasks(ctx, query, page)
+	if err != nil {
+		log.Printf("failed to search tasks: %v", err)
+		return nil, err
+	}
+
+	return conn, nil
+}
+
 func (c *TodoController) CreateSubTask(ctx context.Context, input model.NewSubTask) (*model.SubTask, error) {
 	subTask, err := c.usecase.CreateSubTask(ctx, input)
 	if err != nil {
@@ -68,11 +127,85 @@ func (c *TodoController) CreateSubTask(ctx context.Context, input model.NewSubTa
 	return subTask, nil
 }
 
-func (c *TodoController) ToggleSubTask(ctx context.Context, id uint64, completed bool) (*model.SubTask, error) {
-	subTask, err := c.usecase.ToggleSubTask(ctx, id, completed)
+func (c *TodoController) UpdateSubTask(ctx context.Context, input model.UpdateSubTask) (*model.SubTask, error) {
+	subTask, err := c.usecase.UpdateSubTask(ctx, input)
+	if err != nil {
+		log.Printf("failed to update sub task: %v", err)
+		return nil, err
+	}
+	return subTask, nil
+}
+
+func (c *TodoController) ToggleSubTask(ctx context.Context, id uint64, completed bool, expectedVersion *int32) (*model.SubTask, error) {
+	subTask, err := c.usecase.ToggleSubTask(ctx, id, completed, expectedVersion)
 	if err != nil {
 		log.Printf("failed to toggle sub task: %v", err)
 		return nil, err
 	}
 	return subTask, nil
 }
+
+func (c *TodoController) DeleteSubTask(ctx context.Context, id uint64) (bool, error) {
+	ok, err := c.usecase.DeleteSubTask(ctx, id)
+	if err != nil {
+		log.Printf("failed to delete sub task: %v", err)
+		return false, err
+	}
+	return ok, nil
+}
+
+func (c *TodoController) ReorderSubTasks(ctx context.Context, taskID uint64, subTaskIDs []uint64) ([]*model.SubTask, error) {
+	subTasks, err := c.usecase.ReorderSubTasks(ctx, taskID, subTaskIDs)
+	if err != nil {
+		log.Printf("failed to reorder sub tasks: %v", err)
+		return nil, err
+	}
+	return subTasks, nil
+}
+
+func (c *TodoController) WatchTasks(ctx context.Context, types ...repository.TaskEventType) (<-chan repository.TaskEvent, error) {
+	events, err := c.usecase.WatchTasks(ctx, types...)
+	if err != nil {
+		log.Printf("failed to watch tasks: %v", err)
+		return nil, err
+	}
+	return events, nil
+}
+
+func (c *TodoController) ListTaskHistory(ctx context.Context, taskID uint64) ([]*model.TaskHistoryEntry, error) {
+	entries, err := c.usecase.ListTaskHistory(ctx, taskID)
+	if err != nil {
+		log.Printf("failed to fetch task history: %v", err)
+		return nil, err
+	}
+
+	return entries, nil
+}
+
+func (c *TodoController) BulkUpdateTasks(ctx context.Context, target repository.BulkTaskTarget, change model.BulkTaskChange) (*repository.BulkTaskResult, error) {
+	res, err := c.usecase.BulkUpdateTasks(ctx, target, change)
+	if err != nil {
+		log.Printf("failed to bulk update tasks: %v", err)
+		return nil, err
+	}
+	return res, nil
+}
+
+func (c *TodoController) BulkDeleteTasks(ctx context.Context, target repository.BulkTaskTarget) (*repository.BulkTaskResult, error) {
+	res, err := c.usecase.BulkDeleteTasks(ctx, target)
+	if err != nil {
+		log.Printf("failed to bulk delete tasks: %v", err)
+		return nil, err
+	}
+	return res, nil
+}
+
+func (c *TodoController) GetTaskStats(ctx context.Context, statsRange model.StatsRange, categoryID *uint64) (*model.TaskStats, error) {
+	stats, err := c.usecase.GetTaskStats(ctx, statsRange, categoryID)
+	if err != nil {
+		log.Printf("failed to fetch task stats: %v", err)
+		return nil, err
+	}
+
+	return stats, nil
+}
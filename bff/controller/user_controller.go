@@ -0,0 +1,39 @@
+package controller
+
+import (
+	"context"
+	"log"
+
+	"github.com/naoyakurokawa/go_grpc_graphql/domain/model"
+	"github.com/naoyakurokawa/go_grpc_graphql/usecase"
+)
+
+// UserController orchestrates account related operations.
+type UserController struct {
+	usecase usecase.UserUsecase
+}
+
+// NewUserController constructs a UserController instance.
+func NewUserController(uc usecase.UserUsecase) *UserController {
+	return &UserController{usecase: uc}
+}
+
+func (c *UserController) SignUp(ctx context.Context, email, password string) (*model.AuthPayload, error) {
+	payload, err := c.usecase.SignUp(ctx, email, password)
+	if err != nil {
+		log.Printf("failed to sign up: %v", err)
+		return nil, err
+	}
+
+	return payload, nil
+}
+
+func (c *UserController) Login(ctx context.Context, email, password string) (*model.AuthPayload, error) {
+	payload, err := c.usecase.Login(ctx, email, password)
+	if err != nil {
+		log.Printf("failed to log in: %v", err)
+		return nil, err
+	}
+
+	return payload, nil
+}
@@ -0,0 +1,18 @@
+-- +goose Up
+ALTER TABLE sub_tasks
+  ADD COLUMN position INT NOT NULL DEFAULT 0 AFTER task_id;
+
+UPDATE sub_tasks s
+JOIN (
+  SELECT id, ROW_NUMBER() OVER (PARTITION BY task_id ORDER BY id) AS pos
+  FROM sub_tasks
+) ordered ON ordered.id = s.id
+SET s.position = ordered.pos;
+
+CREATE INDEX idx_sub_tasks_task_id_position ON sub_tasks (task_id, position);
+
+-- +goose Down
+DROP INDEX idx_sub_tasks_task_id_position ON sub_tasks;
+
+ALTER TABLE sub_tasks
+  DROP COLUMN position;
@@ -0,0 +1,9 @@
+-- +goose Up
+ALTER TABLE tasks
+  ADD COLUMN deleted_at DATETIME NULL AFTER updated_at,
+  ADD INDEX idx_tasks_deleted_at (deleted_at);
+
+-- +goose Down
+ALTER TABLE tasks
+  DROP INDEX idx_tasks_deleted_at,
+  DROP COLUMN deleted_at;
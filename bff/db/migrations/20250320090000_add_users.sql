@@ -0,0 +1,41 @@
+-- +goose Up
+CREATE TABLE users (
+   id BIGINT UNSIGNED NOT NULL AUTO_INCREMENT PRIMARY KEY,
+   email VARCHAR(255) NOT NULL,
+   password_hash VARCHAR(255) NOT NULL,
+   created_at TIMESTAMP NULL DEFAULT NULL,
+   updated_at TIMESTAMP NULL DEFAULT NULL,
+   UNIQUE KEY uq_users_email (email)
+);
+
+ALTER TABLE tasks
+ADD COLUMN user_id BIGINT UNSIGNED NULL AFTER id,
+ADD CONSTRAINT fk_tasks_user_id FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE;
+
+-- 認証導入前に作られた所有者なしのタスクを引き継ぎ用ユーザーに割り当てる
+-- password_hash は bcrypt として照合できない値なので、このユーザーではログインできない
+-- 別のユーザーに引き継ぐときは tasks.user_id をそのユーザーの id に更新する
+INSERT INTO users (email, password_hash, created_at, updated_at)
+SELECT 'legacy-owner@localhost', '!', NOW(), NOW()
+FROM DUAL
+WHERE EXISTS (SELECT 1 FROM tasks WHERE user_id IS NULL);
+
+UPDATE tasks
+SET user_id = (SELECT id FROM users WHERE email = 'legacy-owner@localhost')
+WHERE user_id IS NULL;
+
+-- user_id が NULL のカテゴリ (初期データ) は全ユーザー共通
+ALTER TABLE categories
+ADD COLUMN user_id BIGINT UNSIGNED NULL AFTER id,
+ADD CONSTRAINT fk_categories_user_id FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE;
+
+-- +goose Down
+ALTER TABLE categories
+DROP FOREIGN KEY fk_categories_user_id,
+DROP COLUMN user_id;
+
+ALTER TABLE tasks
+DROP FOREIGN KEY fk_tasks_user_id,
+DROP COLUMN user_id;
+
+DROP TABLE users;
@@ -0,0 +1,8 @@
+-- +goose Up
+-- RRULE 形式 (例: FREQ=WEEKLY;INTERVAL=2;BYDAY=MO,FR;UNTIL=20251231)。NULL は繰り返しなし
+ALTER TABLE tasks
+ADD COLUMN recurrence_rule VARCHAR(255) NULL AFTER due_date;
+
+-- +goose Down
+ALTER TABLE tasks
+DROP COLUMN recurrence_rule;
@@ -0,0 +1,24 @@
+-- +goose Up
+CREATE TABLE tags (
+   id BIGINT UNSIGNED NOT NULL AUTO_INCREMENT PRIMARY KEY,
+   user_id BIGINT UNSIGNED NOT NULL,
+   name VARCHAR(64) NOT NULL,
+   created_at TIMESTAMP NULL DEFAULT NULL,
+   updated_at TIMESTAMP NULL DEFAULT NULL,
+   UNIQUE KEY uq_tags_user_id_name (user_id, name),
+   CONSTRAINT fk_tags_user_id FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE
+);
+
+-- タスクとタグの多対多。どちらかが削除されると関連も消える
+CREATE TABLE task_tags (
+   task_id BIGINT UNSIGNED NOT NULL,
+   tag_id BIGINT UNSIGNED NOT NULL,
+   PRIMARY KEY (task_id, tag_id),
+   KEY idx_task_tags_tag_id (tag_id),
+   CONSTRAINT fk_task_tags_task_id FOREIGN KEY (task_id) REFERENCES tasks(id) ON DELETE CASCADE,
+   CONSTRAINT fk_task_tags_tag_id FOREIGN KEY (tag_id) REFERENCES tags(id) ON DELETE CASCADE
+);
+
+-- +goose Down
+DROP TABLE task_tags;
+DROP TABLE tags;
@@ -0,0 +1,14 @@
+-- +goose Up
+-- 日本語のタイトルも検索できるよう ngram パーサーを使う
+ALTER TABLE tasks
+ADD FULLTEXT INDEX ft_tasks_title_note (title, note) WITH PARSER ngram;
+
+ALTER TABLE sub_tasks
+ADD FULLTEXT INDEX ft_sub_tasks_title (title) WITH PARSER ngram;
+
+-- +goose Down
+ALTER TABLE sub_tasks
+DROP INDEX ft_sub_tasks_title;
+
+ALTER TABLE tasks
+DROP INDEX ft_tasks_title_note;
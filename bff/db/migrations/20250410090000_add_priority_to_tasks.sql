@@ -0,0 +1,10 @@
+-- +goose Up
+-- 0: なし 1: 低 2: 中 3: 高 4: 緊急
+ALTER TABLE tasks
+  ADD COLUMN priority TINYINT NOT NULL DEFAULT 0 AFTER due_date,
+  ADD INDEX idx_tasks_user_priority_due_date (user_id, priority, due_date);
+
+-- +goose Down
+ALTER TABLE tasks
+  DROP INDEX idx_tasks_user_priority_due_date,
+  DROP COLUMN priority;
@@ -0,0 +1,19 @@
+-- +goose Up
+-- タスクとサブタスクの変更履歴。field / old_value / new_value は更新 (action = 2) の場合のみ設定される
+-- 完全に削除したタスクの履歴も監査用に残すため、tasks への外部キーは張らない
+CREATE TABLE task_events (
+   id BIGINT UNSIGNED NOT NULL AUTO_INCREMENT PRIMARY KEY,
+   task_id BIGINT UNSIGNED NOT NULL,
+   sub_task_id BIGINT UNSIGNED NULL,
+   user_id BIGINT UNSIGNED NOT NULL,
+   action TINYINT NOT NULL,
+   field VARCHAR(64) NULL,
+   old_value TEXT NULL,
+   new_value TEXT NULL,
+   created_at TIMESTAMP NULL DEFAULT NULL,
+   KEY idx_task_events_task_id_id (task_id, id),
+   CONSTRAINT fk_task_events_user_id FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE
+);
+
+-- +goose Down
+DROP TABLE task_events;
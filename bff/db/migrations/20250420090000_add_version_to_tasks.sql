@@ -0,0 +1,14 @@
+-- +goose Up
+-- 楽観的ロック用。更新のたびに 1 ずつ増える
+ALTER TABLE tasks
+ADD COLUMN version INT UNSIGNED NOT NULL DEFAULT 1 AFTER priority;
+
+ALTER TABLE sub_tasks
+ADD COLUMN version INT UNSIGNED NOT NULL DEFAULT 1 AFTER position;
+
+-- +goose Down
+ALTER TABLE sub_tasks
+DROP COLUMN version;
+
+ALTER TABLE tasks
+DROP COLUMN version;
@@ -0,0 +1,8 @@
+-- +goose Up
+-- 有効なタスクはサブタスクの完了状態と連動して完了・再開する
+ALTER TABLE tasks
+ADD COLUMN sync_completion TINYINT(1) NOT NULL DEFAULT 0 AFTER priority;
+
+-- +goose Down
+ALTER TABLE tasks
+DROP COLUMN sync_completion;
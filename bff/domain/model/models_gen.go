@@ -2,11 +2,95 @@
 
 package model
 
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"strconv"
+
+	"github.com/99designs/gqlgen/graphql"
+)
+
+// An object with an opaque id that is unique across every type, for normalizing client caches.
+//
+// Breaking change: id used to be the numeric key of Task, SubTask and Category and the
+// opaque id was called node_id. id is now the opaque id, as Relay expects, and the
+// numeric key has moved to database_id. Clients that read id as a number select
+// database_id instead, e.g. as "id: database_id".
+type Node interface {
+	IsNode()
+	// Stable for the lifetime of the object. Clients must not look into it.
+	GetNodeID() string
+}
+
+type AuthPayload struct {
+	Token     string `json:"token"`
+	ExpiresAt string `json:"expires_at"`
+	User      *User  `json:"user"`
+}
+
+// Fields set on every task of a bulk update. Omitted fields are left as they are.
+type BulkTaskChange struct {
+	Completed *int32 `json:"completed,omitempty"`
+	// 0 removes the category.
+	CategoryID *uint64 `json:"category_id,omitempty"`
+	DueDate    *string `json:"due_date,omitempty"`
+	// Moves each due date by this many days. Tasks without a due date are left as they are. Cannot be combined with due_date.
+	ShiftDueDateDays *int32 `json:"shift_due_date_days,omitempty"`
+}
+
+type BulkTaskError struct {
+	// One of the extensions.code values of GraphQL errors, e.g. NOT_FOUND.
+	Code    string `json:"code"`
+	Message string `json:"message"`
+}
+
+type BulkTaskResult struct {
+	TaskID uint64 `json:"task_id"`
+	// The updated task. Only set by applied bulk updates.
+	Task *Task `json:"task,omitempty"`
+	// Why the task could not be changed.
+	Error *BulkTaskError `json:"error,omitempty"`
+}
+
+// Picks the tasks of a bulk mutation: the tasks listed in ids, or every task matching filter.
+type BulkTaskTarget struct {
+	Ids []uint64 `json:"ids,omitempty"`
+	// Cannot be combined with ids.
+	Filter *TaskFilter `json:"filter,omitempty"`
+}
+
+type BulkTasksPayload struct {
+	// False when any task failed. No task is changed then.
+	Applied bool              `json:"applied"`
+	Results []*BulkTaskResult `json:"results"`
+}
+
 type Category struct {
-	ID   uint64 `json:"id"`
+	// Relay id, see Node.
+	NodeID string `json:"id"`
+	// Numeric key of the category, taken by the category id arguments and category_id fields.
+	ID   uint64 `json:"database_id"`
 	Name string `json:"name"`
 }
 
+func (Category) IsNode() {}
+
+// Stable for the lifetime of the object. Clients must not look into it.
+func (this Category) GetNodeID() string { return this.NodeID }
+
+type CategoryTaskStats struct {
+	// Null for the tasks without a category.
+	CategoryID *uint64 `json:"category_id,omitempty"`
+	Open       int32   `json:"open"`
+	Completed  int32   `json:"completed"`
+}
+
+type DailyCompletion struct {
+	Date      string `json:"date"`
+	Completed int32  `json:"completed"`
+}
+
 type Mutation struct {
 }
 
@@ -18,18 +102,66 @@ type NewSubTask struct {
 }
 
 type NewTask struct {
-	Title      string  `json:"title"`
-	Note       string  `json:"note"`
-	CategoryID uint64  `json:"category_id"`
-	DueDate    *string `json:"due_date,omitempty"`
+	Title      string           `json:"title"`
+	Note       string           `json:"note"`
+	CategoryID uint64           `json:"category_id"`
+	DueDate    *string          `json:"due_date,omitempty"`
+	Recurrence *RecurrenceInput `json:"recurrence,omitempty"`
+	TagIds     []uint64         `json:"tag_ids,omitempty"`
+	Priority   *Priority        `json:"priority,omitempty"`
+	// Subtasks created together with the task, in this order.
+	SubTasks       []*NewTaskSubTask `json:"sub_tasks,omitempty"`
+	SyncCompletion *bool             `json:"sync_completion,omitempty"`
+}
+
+// A subtask created as part of a NewTask.
+type NewTaskSubTask struct {
+	Title   string  `json:"title"`
+	Note    string  `json:"note"`
+	DueDate *string `json:"due_date,omitempty"`
+}
+
+type PageInfo struct {
+	HasNextPage     bool    `json:"hasNextPage"`
+	HasPreviousPage bool    `json:"hasPreviousPage"`
+	StartCursor     *string `json:"startCursor,omitempty"`
+	EndCursor       *string `json:"endCursor,omitempty"`
 }
 
 type Query struct {
 }
 
+type Recurrence struct {
+	Frequency RecurrenceFrequency `json:"frequency"`
+	// Number of frequency units between occurrences.
+	Interval int32 `json:"interval"`
+	// Days a weekly schedule repeats on. Empty repeats on the weekday of the due date.
+	Weekdays []Weekday `json:"weekdays"`
+	// Last date (YYYY-MM-DD) an occurrence may fall on.
+	Until *string `json:"until,omitempty"`
+}
+
+type RecurrenceInput struct {
+	Frequency RecurrenceFrequency `json:"frequency"`
+	Interval  *int32              `json:"interval,omitempty"`
+	Weekdays  []Weekday           `json:"weekdays,omitempty"`
+	Until     *string             `json:"until,omitempty"`
+}
+
+type SearchHighlight struct {
+	// Matched field: `title`, `note` or `sub_tasks.title`.
+	Field string `json:"field"`
+	// HTML-escaped excerpt of the field with the matches wrapped in `<mark></mark>`.
+	Snippet string `json:"snippet"`
+}
+
 type SubTask struct {
-	ID          uint64  `json:"id"`
+	// Relay id, see Node.
+	NodeID string `json:"id"`
+	// Numeric key of the subtask, taken by the subtask id arguments.
+	ID          uint64  `json:"database_id"`
 	TaskID      uint64  `json:"task_id"`
+	Position    int32   `json:"position"`
 	Title       string  `json:"title"`
 	Note        string  `json:"note"`
 	Completed   int32   `json:"completed"`
@@ -37,26 +169,709 @@ type SubTask struct {
 	DueDate     *string `json:"due_date,omitempty"`
 	CreatedAt   string  `json:"created_at"`
 	UpdatedAt   string  `json:"updated_at"`
+	// Incremented on every update. Send it back as expected_version to avoid overwriting someone else's changes.
+	Version int32 `json:"version"`
+}
+
+func (SubTask) IsNode() {}
+
+// Stable for the lifetime of the object. Clients must not look into it.
+func (this SubTask) GetNodeID() string { return this.NodeID }
+
+// Changes to the tasks of the current user, pushed over the websocket transport.
+type Subscription struct {
+}
+
+type Tag struct {
+	ID   uint64 `json:"id"`
+	Name string `json:"name"`
 }
 
 type Task struct {
-	ID          uint64     `json:"id"`
-	Title       string     `json:"title"`
-	Note        string     `json:"note"`
-	CategoryID  *uint64    `json:"category_id,omitempty"`
-	DueDate     *string    `json:"due_date,omitempty"`
-	Completed   int32      `json:"completed"`
-	CompletedAt *string    `json:"completed_at,omitempty"`
-	CreatedAt   string     `json:"created_at"`
-	UpdatedAt   string     `json:"updated_at"`
-	SubTasks    []*SubTask `json:"sub_tasks"`
+	// Relay id, see Node.
+	NodeID string `json:"id"`
+	// Numeric key of the task, taken by the task id arguments and task_id fields.
+	ID          uint64  `json:"database_id"`
+	Title       string  `json:"title"`
+	Note        string  `json:"note"`
+	CategoryID  *uint64 `json:"category_id,omitempty"`
+	DueDate     *string `json:"due_date,omitempty"`
+	Completed   int32   `json:"completed"`
+	CompletedAt *string `json:"completed_at,omitempty"`
+	CreatedAt   string  `json:"created_at"`
+	UpdatedAt   string  `json:"updated_at"`
+	DeletedAt   *string `json:"deleted_at,omitempty"`
+	// Set for tasks that are recreated with the next due date once completed.
+	Recurrence *Recurrence `json:"recurrence,omitempty"`
+	TagIds     []uint64    `json:"tag_ids"`
+	Priority   Priority    `json:"priority"`
+	// Incremented on every update. Send it back as expected_version to avoid overwriting someone else's changes.
+	Version          int32 `json:"version"`
+	SubtaskTotal     int32 `json:"subtask_total"`
+	SubtaskCompleted int32 `json:"subtask_completed"`
+	// Share of completed subtasks from 0 to 1. Without subtasks it is 1 once the task is completed.
+	Progress float64 `json:"progress"`
+	// Completes the task once all subtasks are done, reopens it when one is unchecked and completes the open subtasks when the task is completed.
+	SyncCompletion bool `json:"sync_completion"`
+	// Subtasks that came with the task from the backend. Nil when Task.sub_tasks has to load them.
+	LoadedSubTasks []*SubTask `json:"-"`
+}
+
+func (Task) IsNode() {}
+
+// Stable for the lifetime of the object. Clients must not look into it.
+func (this Task) GetNodeID() string { return this.NodeID }
+
+type TaskConnection struct {
+	Edges      []*TaskEdge `json:"edges"`
+	PageInfo   *PageInfo   `json:"pageInfo"`
+	TotalCount int32       `json:"totalCount"`
+}
+
+type TaskEdge struct {
+	Cursor string `json:"cursor"`
+	Node   *Task  `json:"node"`
+}
+
+// The same filters as the tasks query.
+type TaskFilter struct {
+	CategoryID     *uint64   `json:"category_id,omitempty"`
+	DueDateStart   *string   `json:"due_date_start,omitempty"`
+	DueDateEnd     *string   `json:"due_date_end,omitempty"`
+	IncompleteOnly *bool     `json:"incomplete_only,omitempty"`
+	TagIds         []uint64  `json:"tag_ids,omitempty"`
+	TagMatch       *TagMatch `json:"tag_match,omitempty"`
+	MinPriority    *Priority `json:"min_priority,omitempty"`
+}
+
+type TaskHistoryEntry struct {
+	ID     uint64            `json:"id"`
+	Action TaskHistoryAction `json:"action"`
+	// Set when the change concerns one of the subtasks.
+	SubTaskID *uint64 `json:"sub_task_id,omitempty"`
+	// Changed field for UPDATED entries, e.g. `title`, `due_date` or `sub_task_ids`.
+	Field *string `json:"field,omitempty"`
+	// Null when the field had no value.
+	OldValue *string `json:"old_value,omitempty"`
+	// Null when the field no longer has a value.
+	NewValue *string `json:"new_value,omitempty"`
+	// User who made the change.
+	UserID    uint64 `json:"user_id"`
+	CreatedAt string `json:"created_at"`
+}
+
+type TaskOrderInput struct {
+	Field     TaskOrderField `json:"field"`
+	Direction *SortDirection `json:"direction,omitempty"`
+}
+
+type TaskSearchConnection struct {
+	Edges      []*TaskSearchEdge `json:"edges"`
+	PageInfo   *PageInfo         `json:"pageInfo"`
+	TotalCount int32             `json:"totalCount"`
+}
+
+type TaskSearchEdge struct {
+	Cursor string `json:"cursor"`
+	Node   *Task  `json:"node"`
+	// Relevance of the task to the query.
+	Score      float64            `json:"score"`
+	Highlights []*SearchHighlight `json:"highlights"`
+}
+
+// Open and overdue counts describe the tasks as they are now. Completion figures cover the
+// days from `from` to `to`, both inclusive.
+type TaskStats struct {
+	From string `json:"from"`
+	To   string `json:"to"`
+	// Categories with open tasks or tasks completed in the range.
+	Categories []*CategoryTaskStats `json:"categories"`
+	// Open tasks whose due date has passed.
+	Overdue int32 `json:"overdue"`
+	// One entry per day of the range, oldest first.
+	CompletedPerDay []*DailyCompletion `json:"completed_per_day"`
+	// Mean seconds from creation to completion of the tasks completed in the range. Null when there are none.
+	AverageCompletionSeconds *float64 `json:"average_completion_seconds,omitempty"`
+}
+
+type UpdateSubTask struct {
+	ID      uint64  `json:"id"`
+	Title   *string `json:"title,omitempty"`
+	Note    *string `json:"note,omitempty"`
+	DueDate *string `json:"due_date,omitempty"`
+	// Rejects the update with CONFLICT, carrying the current subtask in extensions.current, when the subtask is at another version.
+	ExpectedVersion *int32 `json:"expected_version,omitempty"`
 }
 
 type UpdateTask struct {
-	ID         uint64  `json:"id"`
-	Title      *string `json:"title,omitempty"`
-	Note       *string `json:"note,omitempty"`
-	CategoryID *uint64 `json:"category_id,omitempty"`
-	DueDate    *string `json:"due_date,omitempty"`
-	Completed  *int32  `json:"completed,omitempty"`
+	ID    uint64  `json:"id"`
+	Title *string `json:"title,omitempty"`
+	Note  *string `json:"note,omitempty"`
+	// null removes the category.
+	CategoryID graphql.Omittable[*uint64] `json:"category_id,omitempty"`
+	// null removes the due date.
+	DueDate   graphql.Omittable[*string] `json:"due_date,omitempty"`
+	Completed *int32                     `json:"completed,omitempty"`
+	// Replaces the schedule. null removes it.
+	Recurrence graphql.Omittable[*RecurrenceInput] `json:"recurrence,omitempty"`
+	// Replaces the tags of the task. An empty list removes every tag.
+	TagIds   []uint64  `json:"tag_ids,omitempty"`
+	Priority *Priority `json:"priority,omitempty"`
+	// Rejects the update with CONFLICT, carrying the current task in extensions.current, when the task is at another version.
+	ExpectedVersion *int32 `json:"expected_version,omitempty"`
+	SyncCompletion  *bool  `json:"sync_completion,omitempty"`
+}
+
+type User struct {
+	ID        uint64 `json:"id"`
+	Email     string `json:"email"`
+	CreatedAt string `json:"created_at"`
+}
+
+// What happens to tasks that still belong to a deleted category.
+type DeleteCategoryPolicy string
+
+const (
+	// Refuse to delete while tasks reference the category.
+	DeleteCategoryPolicyReject DeleteCategoryPolicy = "REJECT"
+	// Move the tasks to `reassign_to` before deleting.
+	DeleteCategoryPolicyReassign DeleteCategoryPolicy = "REASSIGN"
+	// Leave the tasks without a category.
+	DeleteCategoryPolicyNullify DeleteCategoryPolicy = "NULLIFY"
+)
+
+var AllDeleteCategoryPolicy = []DeleteCategoryPolicy{
+	DeleteCategoryPolicyReject,
+	DeleteCategoryPolicyReassign,
+	DeleteCategoryPolicyNullify,
+}
+
+func (e DeleteCategoryPolicy) IsValid() bool {
+	switch e {
+	case DeleteCategoryPolicyReject, DeleteCategoryPolicyReassign, DeleteCategoryPolicyNullify:
+		return true
+	}
+	return false
+}
+
+func (e DeleteCategoryPolicy) String() string {
+	return string(e)
+}
+
+func (e *DeleteCategoryPolicy) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = DeleteCategoryPolicy(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid DeleteCategoryPolicy", str)
+	}
+	return nil
+}
+
+func (e DeleteCategoryPolicy) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *DeleteCategoryPolicy) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e DeleteCategoryPolicy) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+type Priority string
+
+const (
+	PriorityNone   Priority = "NONE"
+	PriorityLow    Priority = "LOW"
+	PriorityMedium Priority = "MEDIUM"
+	PriorityHigh   Priority = "HIGH"
+	PriorityUrgent Priority = "URGENT"
+)
+
+var AllPriority = []Priority{
+	PriorityNone,
+	PriorityLow,
+	PriorityMedium,
+	PriorityHigh,
+	PriorityUrgent,
+}
+
+func (e Priority) IsValid() bool {
+	switch e {
+	case PriorityNone, PriorityLow, PriorityMedium, PriorityHigh, PriorityUrgent:
+		return true
+	}
+	return false
+}
+
+func (e Priority) String() string {
+	return string(e)
+}
+
+func (e *Priority) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = Priority(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid Priority", str)
+	}
+	return nil
+}
+
+func (e Priority) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *Priority) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e Priority) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+type RecurrenceFrequency string
+
+const (
+	RecurrenceFrequencyDaily   RecurrenceFrequency = "DAILY"
+	RecurrenceFrequencyWeekly  RecurrenceFrequency = "WEEKLY"
+	RecurrenceFrequencyMonthly RecurrenceFrequency = "MONTHLY"
+	RecurrenceFrequencyYearly  RecurrenceFrequency = "YEARLY"
+)
+
+var AllRecurrenceFrequency = []RecurrenceFrequency{
+	RecurrenceFrequencyDaily,
+	RecurrenceFrequencyWeekly,
+	RecurrenceFrequencyMonthly,
+	RecurrenceFrequencyYearly,
+}
+
+func (e RecurrenceFrequency) IsValid() bool {
+	switch e {
+	case RecurrenceFrequencyDaily, RecurrenceFrequencyWeekly, RecurrenceFrequencyMonthly, RecurrenceFrequencyYearly:
+		return true
+	}
+	return false
+}
+
+func (e RecurrenceFrequency) String() string {
+	return string(e)
+}
+
+func (e *RecurrenceFrequency) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = RecurrenceFrequency(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid RecurrenceFrequency", str)
+	}
+	return nil
+}
+
+func (e RecurrenceFrequency) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *RecurrenceFrequency) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e RecurrenceFrequency) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+type SortDirection string
+
+const (
+	SortDirectionAsc  SortDirection = "ASC"
+	SortDirectionDesc SortDirection = "DESC"
+)
+
+var AllSortDirection = []SortDirection{
+	SortDirectionAsc,
+	SortDirectionDesc,
+}
+
+func (e SortDirection) IsValid() bool {
+	switch e {
+	case SortDirectionAsc, SortDirectionDesc:
+		return true
+	}
+	return false
+}
+
+func (e SortDirection) String() string {
+	return string(e)
+}
+
+func (e *SortDirection) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = SortDirection(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid SortDirection", str)
+	}
+	return nil
+}
+
+func (e SortDirection) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *SortDirection) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e SortDirection) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+// How far back completion figures reach, ending today.
+type StatsRange string
+
+const (
+	StatsRangeLastWeek    StatsRange = "LAST_WEEK"
+	StatsRangeLast4Weeks  StatsRange = "LAST_4_WEEKS"
+	StatsRangeLast12Weeks StatsRange = "LAST_12_WEEKS"
+	StatsRangeLast52Weeks StatsRange = "LAST_52_WEEKS"
+)
+
+var AllStatsRange = []StatsRange{
+	StatsRangeLastWeek,
+	StatsRangeLast4Weeks,
+	StatsRangeLast12Weeks,
+	StatsRangeLast52Weeks,
+}
+
+func (e StatsRange) IsValid() bool {
+	switch e {
+	case StatsRangeLastWeek, StatsRangeLast4Weeks, StatsRangeLast12Weeks, StatsRangeLast52Weeks:
+		return true
+	}
+	return false
+}
+
+func (e StatsRange) String() string {
+	return string(e)
+}
+
+func (e *StatsRange) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = StatsRange(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid StatsRange", str)
+	}
+	return nil
+}
+
+func (e StatsRange) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *StatsRange) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e StatsRange) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+// How the tags of a task filter are combined.
+type TagMatch string
+
+const (
+	// Tasks carrying at least one of the tags.
+	TagMatchAny TagMatch = "ANY"
+	// Tasks carrying every one of the tags.
+	TagMatchAll TagMatch = "ALL"
+)
+
+var AllTagMatch = []TagMatch{
+	TagMatchAny,
+	TagMatchAll,
+}
+
+func (e TagMatch) IsValid() bool {
+	switch e {
+	case TagMatchAny, TagMatchAll:
+		return true
+	}
+	return false
+}
+
+func (e TagMatch) String() string {
+	return string(e)
+}
+
+func (e *TagMatch) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = TagMatch(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid TagMatch", str)
+	}
+	return nil
+}
+
+func (e TagMatch) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *TagMatch) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e TagMatch) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+type TaskHistoryAction string
+
+const (
+	TaskHistoryActionCreated TaskHistoryAction = "CREATED"
+	// One field changed; see field, old_value and new_value.
+	TaskHistoryActionUpdated TaskHistoryAction = "UPDATED"
+	// Moved to the trash for tasks, removed for subtasks.
+	TaskHistoryActionDeleted  TaskHistoryAction = "DELETED"
+	TaskHistoryActionRestored TaskHistoryAction = "RESTORED"
+	// Removed from the trash for good.
+	TaskHistoryActionPurged TaskHistoryAction = "PURGED"
+)
+
+var AllTaskHistoryAction = []TaskHistoryAction{
+	TaskHistoryActionCreated,
+	TaskHistoryActionUpdated,
+	TaskHistoryActionDeleted,
+	TaskHistoryActionRestored,
+	TaskHistoryActionPurged,
+}
+
+func (e TaskHistoryAction) IsValid() bool {
+	switch e {
+	case TaskHistoryActionCreated, TaskHistoryActionUpdated, TaskHistoryActionDeleted, TaskHistoryActionRestored, TaskHistoryActionPurged:
+		return true
+	}
+	return false
+}
+
+func (e TaskHistoryAction) String() string {
+	return string(e)
+}
+
+func (e *TaskHistoryAction) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = TaskHistoryAction(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid TaskHistoryAction", str)
+	}
+	return nil
+}
+
+func (e TaskHistoryAction) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *TaskHistoryAction) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e TaskHistoryAction) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+type TaskOrderField string
+
+const (
+	// Tasks without a due date sort last in both directions.
+	TaskOrderFieldDueDate   TaskOrderField = "DUE_DATE"
+	TaskOrderFieldCreatedAt TaskOrderField = "CREATED_AT"
+	TaskOrderFieldUpdatedAt TaskOrderField = "UPDATED_AT"
+	TaskOrderFieldTitle     TaskOrderField = "TITLE"
+	// Open tasks sort last in both directions.
+	TaskOrderFieldCompletedAt TaskOrderField = "COMPLETED_AT"
+	TaskOrderFieldPriority    TaskOrderField = "PRIORITY"
+)
+
+var AllTaskOrderField = []TaskOrderField{
+	TaskOrderFieldDueDate,
+	TaskOrderFieldCreatedAt,
+	TaskOrderFieldUpdatedAt,
+	TaskOrderFieldTitle,
+	TaskOrderFieldCompletedAt,
+	TaskOrderFieldPriority,
+}
+
+func (e TaskOrderField) IsValid() bool {
+	switch e {
+	case TaskOrderFieldDueDate, TaskOrderFieldCreatedAt, TaskOrderFieldUpdatedAt, TaskOrderFieldTitle, TaskOrderFieldCompletedAt, TaskOrderFieldPriority:
+		return true
+	}
+	return false
+}
+
+func (e TaskOrderField) String() string {
+	return string(e)
+}
+
+func (e *TaskOrderField) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = TaskOrderField(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid TaskOrderField", str)
+	}
+	return nil
+}
+
+func (e TaskOrderField) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *TaskOrderField) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e TaskOrderField) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+type Weekday string
+
+const (
+	WeekdayMonday    Weekday = "MONDAY"
+	WeekdayTuesday   Weekday = "TUESDAY"
+	WeekdayWednesday Weekday = "WEDNESDAY"
+	WeekdayThursday  Weekday = "THURSDAY"
+	WeekdayFriday    Weekday = "FRIDAY"
+	WeekdaySaturday  Weekday = "SATURDAY"
+	WeekdaySunday    Weekday = "SUNDAY"
+)
+
+var AllWeekday = []Weekday{
+	WeekdayMonday,
+	WeekdayTuesday,
+	WeekdayWednesday,
+	WeekdayThursday,
+	WeekdayFriday,
+	WeekdaySaturday,
+	WeekdaySunday,
+}
+
+func (e Weekday) IsValid() bool {
+	switch e {
+	case WeekdayMonday, WeekdayTuesday, WeekdayWednesday, WeekdayThursday, WeekdayFriday, WeekdaySaturday, WeekdaySunday:
+		return true
+	}
+	return false
+}
+
+func (e Weekday) String() string {
+	return string(e)
+}
+
+func (e *Weekday) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = Weekday(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid Weekday", str)
+	}
+	return nil
+}
+
+func (e Weekday) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *Weekday) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e Weekday) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
 }
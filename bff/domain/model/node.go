@@ -0,0 +1,39 @@
+package model
+
+import (
+	"encoding/base64"
+	"errors"
+	"strconv"
+	"strings"
+)
+
+// Type names encoded into node ids.
+const (
+	NodeTypeTask     = "Task"
+	NodeTypeSubTask  = "SubTask"
+	NodeTypeCategory = "Category"
+)
+
+// ErrInvalidNodeID is returned by ParseNodeID for ids it did not build.
+var ErrInvalidNodeID = errors.New("invalid node id")
+
+// NewNodeID builds the opaque, globally unique id of the object of type typ with the given id.
+func NewNodeID(typ string, id uint64) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(typ + ":" + strconv.FormatUint(id, 10)))
+}
+
+// ParseNodeID reverses NewNodeID.
+func ParseNodeID(nodeID string) (typ string, id uint64, err error) {
+	raw, err := base64.RawURLEncoding.DecodeString(nodeID)
+	if err != nil {
+		return "", 0, ErrInvalidNodeID
+	}
+	typ, rawID, ok := strings.Cut(string(raw), ":")
+	if !ok {
+		return "", 0, ErrInvalidNodeID
+	}
+	if id, err = strconv.ParseUint(rawID, 10, 64); err != nil {
+		return "", 0, ErrInvalidNodeID
+	}
+	return typ, id, nil
+}
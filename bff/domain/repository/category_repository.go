@@ -9,4 +9,14 @@ import (
 // CategoryRepository defines persistence operations for categories.
 type CategoryRepository interface {
 	ListCategories(ctx context.Context) ([]*model.Category, error)
+	CreateCategory(ctx context.Context, name string) (*model.Category, error)
+	RenameCategory(ctx context.Context, id uint64, name string) (*model.Category, error)
+	DeleteCategory(ctx context.Context, input DeleteCategoryInput) (bool, error)
+}
+
+// DeleteCategoryInput represents the parameters of a category deletion.
+type DeleteCategoryInput struct {
+	ID         uint64
+	Policy     model.DeleteCategoryPolicy
+	ReassignTo *uint64
 }
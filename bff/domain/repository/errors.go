@@ -0,0 +1,25 @@
+package repository
+
+import "google.golang.org/grpc/status"
+
+// VersionConflictError is returned when an update names an expected version the resource is
+// no longer at. Current holds the server copy, a *model.Task or *model.SubTask, so that
+// clients can merge their changes and retry.
+type VersionConflictError struct {
+	status  *status.Status
+	Current any
+}
+
+// NewVersionConflictError wraps the Aborted status returned by the backend.
+func NewVersionConflictError(st *status.Status, current any) *VersionConflictError {
+	return &VersionConflictError{status: st, Current: current}
+}
+
+func (e *VersionConflictError) Error() string {
+	return e.status.Err().Error()
+}
+
+// GRPCStatus keeps the error recognisable as the backend's status.
+func (e *VersionConflictError) GRPCStatus() *status.Status {
+	return e.status
+}
@@ -0,0 +1,15 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/naoyakurokawa/go_grpc_graphql/domain/model"
+)
+
+// TagRepository defines persistence operations for tags.
+type TagRepository interface {
+	ListTags(ctx context.Context) ([]*model.Tag, error)
+	CreateTag(ctx context.Context, name string) (*model.Tag, error)
+	RenameTag(ctx context.Context, id uint64, name string) (*model.Tag, error)
+	DeleteTag(ctx context.Context, id uint64) (bool, error)
+}
@@ -11,9 +11,26 @@ type TodoRepository interface {
 	CreateTask(ctx context.Context, input model.NewTask) (*model.Task, error)
 	UpdateTask(ctx context.Context, input model.UpdateTask) (*model.Task, error)
 	DeleteTask(ctx context.Context, id uint64) (bool, error)
-	ListTasks(ctx context.Context, filter TaskFilter) ([]*model.Task, error)
+	GetTask(ctx context.Context, id uint64) (*model.Task, error)
+	BatchGetTasks(ctx context.Context, ids []uint64) ([]*model.Task, error)
+	ListDeletedTasks(ctx context.Context) ([]*model.Task, error)
+	ListTasksNeedingAttention(ctx context.Context) ([]*model.Task, error)
+	RestoreTask(ctx context.Context, id uint64) (*model.Task, error)
+	ListTasks(ctx context.Context, filter TaskFilter, limit int32) ([]*model.Task, error)
+	ListTasksConnection(ctx context.Context, filter TaskFilter, page PageArgs) (*model.TaskConnection, error)
+	SearchTasks(ctx context.Context, query string, page PageArgs) (*model.TaskSearchConnection, error)
 	CreateSubTask(ctx context.Context, input model.NewSubTask) (*model.SubTask, error)
-	ToggleSubTask(ctx context.Context, id uint64, completed bool) (*model.SubTask, error)
+	GetSubTask(ctx context.Context, id uint64) (*model.SubTask, error)
+	UpdateSubTask(ctx context.Context, input model.UpdateSubTask) (*model.SubTask, error)
+	ToggleSubTask(ctx context.Context, id uint64, completed bool, expectedVersion *int32) (*model.SubTask, error)
+	DeleteSubTask(ctx context.Context, id uint64) (bool, error)
+	ReorderSubTasks(ctx context.Context, taskID uint64, subTaskIDs []uint64) ([]*model.SubTask, error)
+	ListSubTasksByTaskIDs(ctx context.Context, taskIDs []uint64) (map[uint64][]*model.SubTask, error)
+	WatchTasks(ctx context.Context, types ...TaskEventType) (<-chan TaskEvent, error)
+	ListTaskHistory(ctx context.Context, taskID uint64) ([]*model.TaskHistoryEntry, error)
+	BulkUpdateTasks(ctx context.Context, target BulkTaskTarget, change model.BulkTaskChange) (*BulkTaskResult, error)
+	BulkDeleteTasks(ctx context.Context, target BulkTaskTarget) (*BulkTaskResult, error)
+	GetTaskStats(ctx context.Context, statsRange model.StatsRange, categoryID *uint64) (*model.TaskStats, error)
 }
 
 // TaskFilter represents query params for task listing.
@@ -22,4 +39,53 @@ type TaskFilter struct {
 	DueDateStart   *string
 	DueDateEnd     *string
 	IncompleteOnly bool
+	TagIDs         []uint64
+	TagMatch       model.TagMatch
+	MinPriority    *model.Priority
+	OrderBy        []*model.TaskOrderInput
+}
+
+// BulkTaskTarget picks the tasks of a bulk operation: the tasks listed in IDs, or every task
+// matching Filter.
+type BulkTaskTarget struct {
+	IDs    []uint64
+	Filter *TaskFilter
+}
+
+// BulkTaskResult reports a bulk operation. When any task failed, Applied is false and no
+// task was changed.
+type BulkTaskResult struct {
+	Applied  bool
+	Outcomes []BulkTaskOutcome
+}
+
+// BulkTaskOutcome is what a bulk operation did to one task. Err is a gRPC status error.
+type BulkTaskOutcome struct {
+	TaskID uint64
+	Task   *model.Task
+	Err    error
+}
+
+// PageArgs represents Relay-style forward pagination arguments.
+type PageArgs struct {
+	First int32
+	After *string
+}
+
+// TaskEventType classifies a change delivered by WatchTasks.
+type TaskEventType int32
+
+const (
+	TaskEventCreated TaskEventType = iota + 1
+	TaskEventUpdated
+	TaskEventDeleted
+	TaskEventSubTaskToggled
+)
+
+// TaskEvent is a single change to the tasks of the current user.
+type TaskEvent struct {
+	Type    TaskEventType
+	TaskID  uint64
+	Task    *model.Task
+	SubTask *model.SubTask
 }
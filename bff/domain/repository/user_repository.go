@@ -0,0 +1,13 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/naoyakurokawa/go_grpc_graphql/domain/model"
+)
+
+// UserRepository defines account operations.
+type UserRepository interface {
+	SignUp(ctx context.Context, email, password string) (*model.User, error)
+	Authenticate(ctx context.Context, email, password string) (*model.User, error)
+}
@@ -0,0 +1,160 @@
+package errpresenter
+
+import (
+	"context"
+	"errors"
+	"log"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/naoyakurokawa/go_grpc_graphql/domain/repository"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Error codes exposed to GraphQL clients through extensions.code.
+const (
+	CodeBadUserInput       = "BAD_USER_INPUT"
+	CodeNotFound           = "NOT_FOUND"
+	CodeConflict           = "CONFLICT"
+	CodeFailedPrecondition = "FAILED_PRECONDITION"
+	CodeUnauthenticated    = "UNAUTHENTICATED"
+	CodeForbidden          = "FORBIDDEN"
+	CodeUnavailable        = "UNAVAILABLE"
+	CodeInternal           = "INTERNAL_SERVER_ERROR"
+)
+
+const (
+	internalMessage    = "internal server error"
+	unavailableMessage = "service temporarily unavailable"
+)
+
+// FieldError describes a single invalid input field.
+type FieldError struct {
+	Field   string `json:"field"`
+	Message string `json:"message"`
+}
+
+// New returns an ErrorPresenterFunc that converts gRPC status errors into
+// GraphQL errors with extensions.code. In production mode the messages of
+// internal and unavailable errors are replaced with generic ones.
+func New(production bool) graphql.ErrorPresenterFunc {
+	return func(ctx context.Context, err error) *gqlerror.Error {
+		gqlErr := graphql.DefaultErrorPresenter(ctx, err)
+		if _, ok := gqlErr.Extensions["code"]; ok {
+			// gqlgen のパース・バリデーションエラーなど、既にコードを持つものはそのまま返す
+			return gqlErr
+		}
+
+		st, ok := grpcStatus(err)
+		if !ok {
+			log.Printf("unexpected error at %v: %v", gqlErr.Path, err)
+			return present(gqlErr, CodeInternal, internalMessage, production)
+		}
+
+		code := CodeOf(st.Code())
+		if code == CodeInternal || code == CodeUnavailable {
+			log.Printf("backend error at %v: %v", gqlErr.Path, st.Err())
+		}
+		gqlErr.Message = st.Message()
+		setDetails(gqlErr, st)
+		var conflict *repository.VersionConflictError
+		if errors.As(err, &conflict) {
+			setExtension(gqlErr, "current", conflict.Current)
+		}
+
+		switch code {
+		case CodeInternal:
+			return present(gqlErr, code, internalMessage, production)
+		case CodeUnavailable:
+			return present(gqlErr, code, unavailableMessage, production)
+		default:
+			return present(gqlErr, code, "", false)
+		}
+	}
+}
+
+// BadUserInput builds a GraphQL error for an argument rejected by a resolver.
+func BadUserInput(field, message string) *gqlerror.Error {
+	return &gqlerror.Error{
+		Message: message,
+		Extensions: map[string]interface{}{
+			"code":        CodeBadUserInput,
+			"fieldErrors": []FieldError{{Field: field, Message: message}},
+		},
+	}
+}
+
+func grpcStatus(err error) (*status.Status, bool) {
+	var withStatus interface{ GRPCStatus() *status.Status }
+	if !errors.As(err, &withStatus) {
+		return nil, false
+	}
+	return withStatus.GRPCStatus(), true
+}
+
+// CodeOf maps a gRPC status code to the extensions.code reported for it.
+func CodeOf(code codes.Code) string {
+	switch code {
+	case codes.InvalidArgument, codes.OutOfRange:
+		return CodeBadUserInput
+	case codes.NotFound:
+		return CodeNotFound
+	case codes.AlreadyExists, codes.Aborted:
+		return CodeConflict
+	case codes.FailedPrecondition:
+		return CodeFailedPrecondition
+	case codes.Unauthenticated:
+		return CodeUnauthenticated
+	case codes.PermissionDenied:
+		return CodeForbidden
+	case codes.Unavailable, codes.DeadlineExceeded, codes.ResourceExhausted:
+		return CodeUnavailable
+	default:
+		return CodeInternal
+	}
+}
+
+func setDetails(gqlErr *gqlerror.Error, st *status.Status) {
+	for _, detail := range st.Details() {
+		switch d := detail.(type) {
+		case *errdetails.BadRequest:
+			fieldErrors := make([]FieldError, 0, len(d.GetFieldViolations()))
+			for _, v := range d.GetFieldViolations() {
+				fieldErrors = append(fieldErrors, FieldError{Field: v.GetField(), Message: v.GetDescription()})
+			}
+			setExtension(gqlErr, "fieldErrors", fieldErrors)
+		case *errdetails.ResourceInfo:
+			setExtension(gqlErr, "resource", map[string]string{
+				"type": d.GetResourceType(),
+				"id":   d.GetResourceName(),
+			})
+		case *errdetails.PreconditionFailure:
+			violations := make([]map[string]string, 0, len(d.GetViolations()))
+			for _, v := range d.GetViolations() {
+				violations = append(violations, map[string]string{
+					"subject": v.GetSubject(),
+					"message": v.GetDescription(),
+				})
+			}
+			setExtension(gqlErr, "preconditionViolations", violations)
+		}
+	}
+}
+
+func present(gqlErr *gqlerror.Error, code, genericMessage string, hide bool) *gqlerror.Error {
+	setExtension(gqlErr, "code", code)
+	if hide {
+		gqlErr.Message = genericMessage
+		gqlErr.Extensions = map[string]interface{}{"code": code}
+	}
+	return gqlErr
+}
+
+func setExtension(gqlErr *gqlerror.Error, key string, value interface{}) {
+	if gqlErr.Extensions == nil {
+		gqlErr.Extensions = map[string]interface{}{}
+	}
+	gqlErr.Extensions[key] = value
+}
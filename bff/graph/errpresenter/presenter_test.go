@@ -0,0 +1,174 @@
+package errpresenter
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/naoyakurokawa/go_grpc_graphql/domain/model"
+	"github.com/naoyakurokawa/go_grpc_graphql/domain/repository"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestCodeOf(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		code codes.Code
+		want string
+	}{
+		{codes.InvalidArgument, CodeBadUserInput},
+		{codes.OutOfRange, CodeBadUserInput},
+		{codes.NotFound, CodeNotFound},
+		{codes.AlreadyExists, CodeConflict},
+		{codes.Aborted, CodeConflict},
+		{codes.FailedPrecondition, CodeFailedPrecondition},
+		{codes.Unauthenticated, CodeUnauthenticated},
+		{codes.PermissionDenied, CodeForbidden},
+		{codes.Unavailable, CodeUnavailable},
+		{codes.DeadlineExceeded, CodeUnavailable},
+		{codes.ResourceExhausted, CodeUnavailable},
+		{codes.Internal, CodeInternal},
+		{codes.Unknown, CodeInternal},
+		{codes.DataLoss, CodeInternal},
+	}
+
+	for _, tt := range tests {
+		if got := CodeOf(tt.code); got != tt.want {
+			t.Errorf("CodeOf(%v) = %q, want %q", tt.code, got, tt.want)
+		}
+	}
+}
+
+func TestNew(t *testing.T) {
+	t.Parallel()
+
+	invalid, err := status.New(codes.InvalidArgument, "invalid task").WithDetails(&errdetails.BadRequest{
+		FieldViolations: []*errdetails.BadRequest_FieldViolation{
+			{Field: "title", Description: "must not be empty"},
+			{Field: "due_date", Description: "must be a valid date"},
+		},
+	})
+	if err != nil {
+		t.Fatalf("WithDetails returned error: %v", err)
+	}
+	missing, err := status.New(codes.NotFound, "task 7 not found").WithDetails(&errdetails.ResourceInfo{ResourceType: "task", ResourceName: "7"})
+	if err != nil {
+		t.Fatalf("WithDetails returned error: %v", err)
+	}
+	current := &model.Task{ID: 7, Title: "write report", Version: 3}
+
+	tests := []struct {
+		name           string
+		err            error
+		production     bool
+		wantMessage    string
+		wantExtensions map[string]interface{}
+	}{
+		{
+			name:        "field violations",
+			err:         invalid.Err(),
+			wantMessage: "invalid task",
+			wantExtensions: map[string]interface{}{
+				"code": CodeBadUserInput,
+				"fieldErrors": []FieldError{
+					{Field: "title", Message: "must not be empty"},
+					{Field: "due_date", Message: "must be a valid date"},
+				},
+			},
+		},
+		{
+			name:        "missing resource",
+			err:         missing.Err(),
+			production:  true,
+			wantMessage: "task 7 not found",
+			wantExtensions: map[string]interface{}{
+				"code":     CodeNotFound,
+				"resource": map[string]string{"type": "task", "id": "7"},
+			},
+		},
+		{
+			name:           "version conflict carries the current copy",
+			err:            repository.NewVersionConflictError(status.New(codes.Aborted, "task 7 was changed"), current),
+			wantMessage:    "task 7 was changed",
+			wantExtensions: map[string]interface{}{"code": CodeConflict, "current": current},
+		},
+		{
+			name:           "wrapped status",
+			err:            fmt.Errorf("load task: %w", status.Error(codes.PermissionDenied, "not yours")),
+			wantMessage:    "not yours",
+			wantExtensions: map[string]interface{}{"code": CodeForbidden},
+		},
+		{
+			name:           "internal error in development",
+			err:            status.Error(codes.Internal, "db unavailable"),
+			wantMessage:    "db unavailable",
+			wantExtensions: map[string]interface{}{"code": CodeInternal},
+		},
+		{
+			name:           "internal error in production",
+			err:            status.Error(codes.Internal, "db unavailable"),
+			production:     true,
+			wantMessage:    internalMessage,
+			wantExtensions: map[string]interface{}{"code": CodeInternal},
+		},
+		{
+			name:           "unavailable in production",
+			err:            status.Error(codes.Unavailable, "connection refused"),
+			production:     true,
+			wantMessage:    unavailableMessage,
+			wantExtensions: map[string]interface{}{"code": CodeUnavailable},
+		},
+		{
+			name:           "non-gRPC error in development",
+			err:            errors.New("boom"),
+			wantMessage:    "boom",
+			wantExtensions: map[string]interface{}{"code": CodeInternal},
+		},
+		{
+			name:           "non-gRPC error in production",
+			err:            errors.New("boom"),
+			production:     true,
+			wantMessage:    internalMessage,
+			wantExtensions: map[string]interface{}{"code": CodeInternal},
+		},
+		{
+			name:        "error with a code is kept",
+			err:         BadUserInput("first", "first must be positive"),
+			production:  true,
+			wantMessage: "first must be positive",
+			wantExtensions: map[string]interface{}{
+				"code":        CodeBadUserInput,
+				"fieldErrors": []FieldError{{Field: "first", Message: "first must be positive"}},
+			},
+		},
+		{
+			name:           "gqlgen error with a code is kept",
+			err:            &gqlerror.Error{Message: "Cannot query field", Extensions: map[string]interface{}{"code": "GRAPHQL_VALIDATION_FAILED"}},
+			production:     true,
+			wantMessage:    "Cannot query field",
+			wantExtensions: map[string]interface{}{"code": "GRAPHQL_VALIDATION_FAILED"},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := New(tt.production)(context.Background(), tt.err)
+
+			if got.Message != tt.wantMessage {
+				t.Fatalf("message = %q, want %q", got.Message, tt.wantMessage)
+			}
+			if !reflect.DeepEqual(got.Extensions, tt.wantExtensions) {
+				t.Fatalf("extensions = %#v, want %#v", got.Extensions, tt.wantExtensions)
+			}
+		})
+	}
+}
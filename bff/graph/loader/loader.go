@@ -0,0 +1,184 @@
+package loader
+
+import (
+	"context"
+	"time"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/graph-gophers/dataloader/v7"
+	"github.com/naoyakurokawa/go_grpc_graphql/domain/model"
+	"github.com/naoyakurokawa/go_grpc_graphql/usecase"
+)
+
+type ctxKey struct{}
+
+// batchWait is how long a loader collects keys before issuing its batch call.
+const batchWait = 2 * time.Millisecond
+
+// Loaders bundles the per-response DataLoaders used by field resolvers.
+type Loaders struct {
+	CategoryByID     *dataloader.Loader[uint64, *model.Category]
+	TagByID          *dataloader.Loader[uint64, *model.Tag]
+	SubTasksByTaskID *dataloader.Loader[uint64, []*model.SubTask]
+	TaskByID         *dataloader.Loader[uint64, *model.Task]
+}
+
+// NewLoaders creates a fresh set of loaders. Loaders cache results, so a new
+// set must be created for every response.
+func NewLoaders(todoUsecase usecase.TodoUsecase, categoryUsecase usecase.CategoryUsecase, tagUsecase usecase.TagUsecase) *Loaders {
+	categories := &categoryBatcher{usecase: categoryUsecase}
+	tags := &tagBatcher{usecase: tagUsecase}
+	subTasks := &subTaskBatcher{usecase: todoUsecase}
+	tasks := &taskBatcher{usecase: todoUsecase}
+
+	return &Loaders{
+		CategoryByID: dataloader.NewBatchedLoader(
+			categories.load,
+			dataloader.WithWait[uint64, *model.Category](batchWait),
+		),
+		TagByID: dataloader.NewBatchedLoader(
+			tags.load,
+			dataloader.WithWait[uint64, *model.Tag](batchWait),
+		),
+		SubTasksByTaskID: dataloader.NewBatchedLoader(
+			subTasks.load,
+			dataloader.WithWait[uint64, []*model.SubTask](batchWait),
+		),
+		TaskByID: dataloader.NewBatchedLoader(
+			tasks.load,
+			dataloader.WithWait[uint64, *model.Task](batchWait),
+		),
+	}
+}
+
+// Middleware installs a new set of loaders around every response. A
+// subscription sends a response per event, so each event is resolved against
+// fresh data rather than what earlier events of the connection cached.
+func Middleware(todoUsecase usecase.TodoUsecase, categoryUsecase usecase.CategoryUsecase, tagUsecase usecase.TagUsecase) graphql.ResponseMiddleware {
+	return func(ctx context.Context, next graphql.ResponseHandler) *graphql.Response {
+		return next(WithLoaders(ctx, NewLoaders(todoUsecase, categoryUsecase, tagUsecase)))
+	}
+}
+
+// WithLoaders returns a copy of ctx carrying the loaders.
+func WithLoaders(ctx context.Context, loaders *Loaders) context.Context {
+	return context.WithValue(ctx, ctxKey{}, loaders)
+}
+
+// For returns the loaders installed in ctx by Middleware.
+func For(ctx context.Context) *Loaders {
+	return ctx.Value(ctxKey{}).(*Loaders)
+}
+
+type categoryBatcher struct {
+	usecase usecase.CategoryUsecase
+}
+
+// load resolves every requested category with a single GetCategories call.
+// Listing them all rather than fetching by id is deliberate: categories are
+// per user and few, the list costs one call per response however many tasks
+// refer to it, and a by-id RPC would save nothing but a few unused rows.
+func (b *categoryBatcher) load(ctx context.Context, ids []uint64) []*dataloader.Result[*model.Category] {
+	results := make([]*dataloader.Result[*model.Category], len(ids))
+
+	categories, err := b.usecase.ListCategories(ctx)
+	if err != nil {
+		for i := range results {
+			results[i] = &dataloader.Result[*model.Category]{Error: err}
+		}
+		return results
+	}
+
+	byID := make(map[uint64]*model.Category, len(categories))
+	for _, c := range categories {
+		byID[c.ID] = c
+	}
+	for i, id := range ids {
+		results[i] = &dataloader.Result[*model.Category]{Data: byID[id]}
+	}
+
+	return results
+}
+
+type tagBatcher struct {
+	usecase usecase.TagUsecase
+}
+
+// load resolves every requested tag with a single GetTags call. Like
+// categories, tags are a short per-user list, so it is listed whole.
+func (b *tagBatcher) load(ctx context.Context, ids []uint64) []*dataloader.Result[*model.Tag] {
+	results := make([]*dataloader.Result[*model.Tag], len(ids))
+
+	tags, err := b.usecase.ListTags(ctx)
+	if err != nil {
+		for i := range results {
+			results[i] = &dataloader.Result[*model.Tag]{Error: err}
+		}
+		return results
+	}
+
+	byID := make(map[uint64]*model.Tag, len(tags))
+	for _, t := range tags {
+		byID[t.ID] = t
+	}
+	for i, id := range ids {
+		results[i] = &dataloader.Result[*model.Tag]{Data: byID[id]}
+	}
+
+	return results
+}
+
+type subTaskBatcher struct {
+	usecase usecase.TodoUsecase
+}
+
+// load resolves the subtasks of every requested task with a single BatchListSubTasks call.
+func (b *subTaskBatcher) load(ctx context.Context, taskIDs []uint64) []*dataloader.Result[[]*model.SubTask] {
+	results := make([]*dataloader.Result[[]*model.SubTask], len(taskIDs))
+
+	subTasksByTask, err := b.usecase.ListSubTasksByTaskIDs(ctx, taskIDs)
+	if err != nil {
+		for i := range results {
+			results[i] = &dataloader.Result[[]*model.SubTask]{Error: err}
+		}
+		return results
+	}
+
+	for i, id := range taskIDs {
+		subTasks := subTasksByTask[id]
+		if subTasks == nil {
+			subTasks = []*model.SubTask{}
+		}
+		results[i] = &dataloader.Result[[]*model.SubTask]{Data: subTasks}
+	}
+
+	return results
+}
+
+type taskBatcher struct {
+	usecase usecase.TodoUsecase
+}
+
+// load resolves every requested task with a single BatchGetTasks call. Tasks
+// that do not exist or are in the trash resolve to nil.
+func (b *taskBatcher) load(ctx context.Context, ids []uint64) []*dataloader.Result[*model.Task] {
+	results := make([]*dataloader.Result[*model.Task], len(ids))
+
+	tasks, err := b.usecase.BatchGetTasks(ctx, ids)
+	if err != nil {
+		for i := range results {
+			results[i] = &dataloader.Result[*model.Task]{Error: err}
+		}
+		return results
+	}
+
+	byID := make(map[uint64]*model.Task, len(tasks))
+	for _, t := range tasks {
+		byID[t.ID] = t
+	}
+	for i, id := range ids {
+		results[i] = &dataloader.Result[*model.Task]{Data: byID[id]}
+	}
+
+	return results
+}
@@ -0,0 +1,168 @@
+package loader
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+
+	"github.com/naoyakurokawa/go_grpc_graphql/domain/model"
+	"github.com/naoyakurokawa/go_grpc_graphql/usecase"
+)
+
+// countingTodos answers the batch lookups of TodoUsecase and records every call.
+type countingTodos struct {
+	usecase.TodoUsecase
+	subTaskCalls atomic.Int32
+	taskCalls    atomic.Int32
+	requested    chan []uint64
+}
+
+func (u *countingTodos) ListSubTasksByTaskIDs(_ context.Context, taskIDs []uint64) (map[uint64][]*model.SubTask, error) {
+	u.subTaskCalls.Add(1)
+	u.requested <- taskIDs
+	res := make(map[uint64][]*model.SubTask, len(taskIDs))
+	for _, id := range taskIDs {
+		if id%2 == 0 {
+			res[id] = []*model.SubTask{{ID: id * 10, TaskID: id}}
+		}
+	}
+	return res, nil
+}
+
+func (u *countingTodos) BatchGetTasks(_ context.Context, ids []uint64) ([]*model.Task, error) {
+	u.taskCalls.Add(1)
+	tasks := make([]*model.Task, 0, len(ids))
+	for _, id := range ids {
+		if id != 0 {
+			tasks = append(tasks, &model.Task{ID: id})
+		}
+	}
+	return tasks, nil
+}
+
+// countingCategories lists categories 1 and 2 and records every call.
+type countingCategories struct {
+	usecase.CategoryUsecase
+	calls atomic.Int32
+	err   error
+}
+
+func (u *countingCategories) ListCategories(context.Context) ([]*model.Category, error) {
+	u.calls.Add(1)
+	if u.err != nil {
+		return nil, u.err
+	}
+	return []*model.Category{{ID: 1, Name: "work"}, {ID: 2, Name: "home"}}, nil
+}
+
+// countingTags lists tag 1 and records every call.
+type countingTags struct {
+	usecase.TagUsecase
+	calls atomic.Int32
+}
+
+func (u *countingTags) ListTags(context.Context) ([]*model.Tag, error) {
+	u.calls.Add(1)
+	return []*model.Tag{{ID: 1, Name: "urgent"}}, nil
+}
+
+// loadAll queues every key before waiting on any of them, the way sibling
+// field resolvers do, so that they fall into a single batch.
+func loadAll[V any](t *testing.T, keys []uint64, load func(id uint64) func() (V, error), check func(id uint64, v V)) {
+	t.Helper()
+
+	thunks := make([]func() (V, error), len(keys))
+	for i, id := range keys {
+		thunks[i] = load(id)
+	}
+	for i, thunk := range thunks {
+		v, err := thunk()
+		if err != nil {
+			t.Fatalf("load %d failed: %v", keys[i], err)
+		}
+		check(keys[i], v)
+	}
+}
+
+func TestLoaders_BatchKeys(t *testing.T) {
+	t.Parallel()
+
+	const n = 20
+	todos := &countingTodos{requested: make(chan []uint64, n)}
+	categories := &countingCategories{}
+	tags := &countingTags{}
+	loaders := NewLoaders(todos, categories, tags)
+	ctx := context.Background()
+
+	keys := make([]uint64, n)
+	for i := range keys {
+		keys[i] = uint64(i + 1)
+	}
+
+	loadAll(t, keys, func(id uint64) func() ([]*model.SubTask, error) {
+		return loaders.SubTasksByTaskID.Load(ctx, id)
+	}, func(id uint64, subTasks []*model.SubTask) {
+		if want := 1 - int(id%2); len(subTasks) != want {
+			t.Errorf("task %d has %d subtasks, want %d", id, len(subTasks), want)
+		}
+	})
+	loadAll(t, keys, func(id uint64) func() (*model.Category, error) {
+		return loaders.CategoryByID.Load(ctx, id%3)
+	}, func(id uint64, category *model.Category) {
+		if (category != nil) != (id%3 != 0) {
+			t.Errorf("category %d = %+v", id%3, category)
+		}
+	})
+	loadAll(t, keys, func(id uint64) func() (*model.Tag, error) {
+		return loaders.TagByID.Load(ctx, 1)
+	}, func(uint64, *model.Tag) {})
+	loadAll(t, keys, func(id uint64) func() (*model.Task, error) {
+		return loaders.TaskByID.Load(ctx, id)
+	}, func(id uint64, task *model.Task) {
+		if task == nil || task.ID != id {
+			t.Errorf("task %d = %+v", id, task)
+		}
+	})
+
+	if got := todos.subTaskCalls.Load(); got != 1 {
+		t.Fatalf("ListSubTasksByTaskIDs called %d times for %d tasks, want 1", got, n)
+	}
+	if got := len(<-todos.requested); got != n {
+		t.Fatalf("ListSubTasksByTaskIDs got %d task ids, want %d", got, n)
+	}
+	if got := categories.calls.Load(); got != 1 {
+		t.Fatalf("ListCategories called %d times, want 1", got)
+	}
+	if got := tags.calls.Load(); got != 1 {
+		t.Fatalf("ListTags called %d times, want 1", got)
+	}
+	if got := todos.taskCalls.Load(); got != 1 {
+		t.Fatalf("BatchGetTasks called %d times, want 1", got)
+	}
+
+	// A key loaded before comes from the cache without another call.
+	if _, err := loaders.CategoryByID.Load(ctx, 1)(); err != nil {
+		t.Fatalf("load failed: %v", err)
+	}
+	if got := categories.calls.Load(); got != 1 {
+		t.Fatalf("ListCategories called %d times after a cached load, want 1", got)
+	}
+}
+
+func TestLoaders_BatchError(t *testing.T) {
+	t.Parallel()
+
+	want := errors.New("backend down")
+	loaders := NewLoaders(&countingTodos{}, &countingCategories{err: want}, &countingTags{})
+
+	_, errs := loaders.CategoryByID.LoadMany(context.Background(), []uint64{1, 2})()
+	if len(errs) != 2 {
+		t.Fatalf("LoadMany returned %d errors, want 2", len(errs))
+	}
+	for _, err := range errs {
+		if !errors.Is(err, want) {
+			t.Fatalf("error = %v, want %v", err, want)
+		}
+	}
+}
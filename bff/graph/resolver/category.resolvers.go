@@ -8,8 +8,32 @@ import (
 	"context"
 
 	"github.com/naoyakurokawa/go_grpc_graphql/domain/model"
+	"github.com/naoyakurokawa/go_grpc_graphql/domain/repository"
 )
 
+// CreateCategory is the resolver for the createCategory field.
+func (r *mutationResolver) CreateCategory(ctx context.Context, name string) (*model.Category, error) {
+	return r.CategoryController.CreateCategory(ctx, name)
+}
+
+// RenameCategory is the resolver for the renameCategory field.
+func (r *mutationResolver) RenameCategory(ctx context.Context, id uint64, name string) (*model.Category, error) {
+	return r.CategoryController.RenameCategory(ctx, id, name)
+}
+
+// DeleteCategory is the resolver for the deleteCategory field.
+func (r *mutationResolver) DeleteCategory(ctx context.Context, id uint64, policy *model.DeleteCategoryPolicy, reassignTo *uint64) (bool, error) {
+	input := repository.DeleteCategoryInput{
+		ID:         id,
+		Policy:     model.DeleteCategoryPolicyReject,
+		ReassignTo: reassignTo,
+	}
+	if policy != nil {
+		input.Policy = *policy
+	}
+	return r.CategoryController.DeleteCategory(ctx, input)
+}
+
 // Categories is the resolver for the categories field.
 func (r *queryResolver) Categories(ctx context.Context) ([]*model.Category, error) {
 	return r.CategoryController.ListCategories(ctx)
@@ -0,0 +1,48 @@
+package resolver
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.81
+
+import (
+	"context"
+
+	"github.com/naoyakurokawa/go_grpc_graphql/domain/model"
+	"github.com/naoyakurokawa/go_grpc_graphql/graph/errpresenter"
+	"github.com/naoyakurokawa/go_grpc_graphql/graph/loader"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Node is the resolver for the node field.
+func (r *queryResolver) Node(ctx context.Context, id string) (model.Node, error) {
+	typ, objID, err := model.ParseNodeID(id)
+	if err != nil {
+		return nil, errpresenter.BadUserInput("id", err.Error())
+	}
+
+	switch typ {
+	case model.NodeTypeTask:
+		task, err := loader.For(ctx).TaskByID.Load(ctx, objID)()
+		if err != nil || task == nil {
+			return nil, err
+		}
+		return task, nil
+	case model.NodeTypeSubTask:
+		subTask, err := r.TodoController.GetSubTask(ctx, objID)
+		if status.Code(err) == codes.NotFound {
+			return nil, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		return subTask, nil
+	case model.NodeTypeCategory:
+		category, err := loader.For(ctx).CategoryByID.Load(ctx, objID)()
+		if err != nil || category == nil {
+			return nil, err
+		}
+		return category, nil
+	}
+	return nil, nil
+}
@@ -11,4 +11,6 @@ import (
 type Resolver struct {
 	TodoController     *controller.TodoController
 	CategoryController *controller.CategoryController
+	UserController     *controller.UserController
+	TagController      *controller.TagController
 }
@@ -0,0 +1,37 @@
+package resolver
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.81
+
+import (
+	"context"
+
+	"github.com/naoyakurokawa/go_grpc_graphql/domain/model"
+	"github.com/naoyakurokawa/go_grpc_graphql/graph"
+	"github.com/naoyakurokawa/go_grpc_graphql/graph/loader"
+)
+
+// Category is the resolver for the category field.
+func (r *categoryTaskStatsResolver) Category(ctx context.Context, obj *model.CategoryTaskStats) (*model.Category, error) {
+	if obj.CategoryID == nil {
+		return nil, nil
+	}
+	return loader.For(ctx).CategoryByID.Load(ctx, *obj.CategoryID)()
+}
+
+// TaskStats is the resolver for the taskStats field.
+func (r *queryResolver) TaskStats(ctx context.Context, rangeArg *model.StatsRange, categoryID *uint64) (*model.TaskStats, error) {
+	statsRange := model.StatsRangeLast4Weeks
+	if rangeArg != nil {
+		statsRange = *rangeArg
+	}
+	return r.TodoController.GetTaskStats(ctx, statsRange, categoryID)
+}
+
+// CategoryTaskStats returns graph.CategoryTaskStatsResolver implementation.
+func (r *Resolver) CategoryTaskStats() graph.CategoryTaskStatsResolver {
+	return &categoryTaskStatsResolver{r}
+}
+
+type categoryTaskStatsResolver struct{ *Resolver }
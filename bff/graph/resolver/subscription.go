@@ -0,0 +1,24 @@
+package resolver
+
+import (
+	"context"
+
+	"github.com/naoyakurokawa/go_grpc_graphql/domain/repository"
+)
+
+// forwardEvents converts task events into the payload of a subscription field.
+// The returned channel is closed when the event stream ends or ctx is done.
+func forwardEvents[T any](ctx context.Context, events <-chan repository.TaskEvent, payload func(repository.TaskEvent) T) <-chan T {
+	out := make(chan T)
+	go func() {
+		defer close(out)
+		for event := range events {
+			select {
+			case out <- payload(event):
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return out
+}
@@ -6,11 +6,15 @@ package resolver
 
 import (
 	"context"
+	"fmt"
 	"strings"
 
 	"github.com/naoyakurokawa/go_grpc_graphql/domain/model"
 	"github.com/naoyakurokawa/go_grpc_graphql/domain/repository"
 	"github.com/naoyakurokawa/go_grpc_graphql/graph"
+	"github.com/naoyakurokawa/go_grpc_graphql/graph/errpresenter"
+	"github.com/naoyakurokawa/go_grpc_graphql/graph/loader"
+	"google.golang.org/grpc/status"
 )
 
 // CreateTask is the resolver for the createTask field.
@@ -33,20 +37,193 @@ func (r *mutationResolver) DeleteTask(ctx context.Context, id uint64) (bool, err
 	return r.TodoController.DeleteTask(ctx, id)
 }
 
+// RestoreTask is the resolver for the restoreTask field.
+func (r *mutationResolver) RestoreTask(ctx context.Context, id uint64) (*model.Task, error) {
+	return r.TodoController.RestoreTask(ctx, id)
+}
+
 // ToggleSubTask is the resolver for the toggleSubTask field.
-func (r *mutationResolver) ToggleSubTask(ctx context.Context, id uint64, completed bool) (*model.SubTask, error) {
-	return r.TodoController.ToggleSubTask(ctx, id, completed)
+func (r *mutationResolver) ToggleSubTask(ctx context.Context, id uint64, completed bool, expectedVersion *int32) (*model.SubTask, error) {
+	return r.TodoController.ToggleSubTask(ctx, id, completed, expectedVersion)
+}
+
+// UpdateSubTask is the resolver for the updateSubTask field.
+func (r *mutationResolver) UpdateSubTask(ctx context.Context, input model.UpdateSubTask) (*model.SubTask, error) {
+	return r.TodoController.UpdateSubTask(ctx, input)
+}
+
+// DeleteSubTask is the resolver for the deleteSubTask field.
+func (r *mutationResolver) DeleteSubTask(ctx context.Context, id uint64) (bool, error) {
+	return r.TodoController.DeleteSubTask(ctx, id)
+}
+
+// ReorderSubTasks is the resolver for the reorderSubTasks field.
+func (r *mutationResolver) ReorderSubTasks(ctx context.Context, taskID uint64, subTaskIds []uint64) ([]*model.SubTask, error) {
+	return r.TodoController.ReorderSubTasks(ctx, taskID, subTaskIds)
+}
+
+// BulkUpdateTasks is the resolver for the bulkUpdateTasks field.
+func (r *mutationResolver) BulkUpdateTasks(ctx context.Context, target model.BulkTaskTarget, input model.BulkTaskChange) (*model.BulkTasksPayload, error) {
+	res, err := r.TodoController.BulkUpdateTasks(ctx, toBulkTaskTarget(target), input)
+	if err != nil {
+		return nil, err
+	}
+	return toBulkTasksPayload(res), nil
+}
+
+// BulkDeleteTasks is the resolver for the bulkDeleteTasks field.
+func (r *mutationResolver) BulkDeleteTasks(ctx context.Context, target model.BulkTaskTarget) (*model.BulkTasksPayload, error) {
+	res, err := r.TodoController.BulkDeleteTasks(ctx, toBulkTaskTarget(target))
+	if err != nil {
+		return nil, err
+	}
+	return toBulkTasksPayload(res), nil
+}
+
+// Category is the resolver for the category field.
+func (r *taskResolver) Category(ctx context.Context, obj *model.Task) (*model.Category, error) {
+	if obj.CategoryID == nil {
+		return nil, nil
+	}
+	return loader.For(ctx).CategoryByID.Load(ctx, *obj.CategoryID)()
+}
+
+// Tags is the resolver for the tags field.
+func (r *taskResolver) Tags(ctx context.Context, obj *model.Task) ([]*model.Tag, error) {
+	loaded, errs := loader.For(ctx).TagByID.LoadMany(ctx, obj.TagIds)()
+	for _, err := range errs {
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	tags := make([]*model.Tag, 0, len(loaded))
+	for _, tag := range loaded {
+		if tag != nil {
+			tags = append(tags, tag)
+		}
+	}
+	return tags, nil
+}
+
+// SubTasks is the resolver for the sub_tasks field.
+func (r *taskResolver) SubTasks(ctx context.Context, obj *model.Task) ([]*model.SubTask, error) {
+	if obj.LoadedSubTasks != nil {
+		return obj.LoadedSubTasks, nil
+	}
+	return loader.For(ctx).SubTasksByTaskID.Load(ctx, obj.ID)()
+}
+
+// History is the resolver for the history field.
+func (r *taskResolver) History(ctx context.Context, obj *model.Task) ([]*model.TaskHistoryEntry, error) {
+	return r.TodoController.ListTaskHistory(ctx, obj.ID)
+}
+
+// Task is the resolver for the task field.
+func (r *queryResolver) Task(ctx context.Context, id uint64) (*model.Task, error) {
+	return loader.For(ctx).TaskByID.Load(ctx, id)()
 }
 
 // Tasks is the resolver for the tasks field.
-func (r *queryResolver) Tasks(ctx context.Context, categoryID *uint64, dueDateStart *string, dueDateEnd *string, incompleteOnly *bool) ([]*model.Task, error) {
+func (r *queryResolver) Tasks(ctx context.Context, first *int32, categoryID *uint64, dueDateStart *string, dueDateEnd *string, incompleteOnly *bool, tagIds []uint64, tagMatch *model.TagMatch, minPriority *model.Priority, orderBy []*model.TaskOrderInput) ([]*model.Task, error) {
 	filter := repository.TaskFilter{
 		CategoryID:     categoryID,
 		DueDateStart:   normalizeDateArg(dueDateStart),
 		DueDateEnd:     normalizeDateArg(dueDateEnd),
 		IncompleteOnly: incompleteOnly != nil && *incompleteOnly,
+		TagIDs:         tagIds,
+		TagMatch:       normalizeTagMatchArg(tagMatch),
+		MinPriority:    minPriority,
+		OrderBy:        orderBy,
 	}
-	return r.TodoController.ListTasks(ctx, filter)
+	var limit int32
+	if first != nil {
+		if *first < 1 || *first > maxTasksFirst {
+			return nil, errpresenter.BadUserInput("first", fmt.Sprintf("first must be between 1 and %d", maxTasksFirst))
+		}
+		limit = *first
+	}
+	return r.TodoController.ListTasks(ctx, filter, limit)
+}
+
+// TasksConnection is the resolver for the tasksConnection field.
+func (r *queryResolver) TasksConnection(ctx context.Context, first *int32, after *string, categoryID *uint64, dueDateStart *string, dueDateEnd *string, incompleteOnly *bool, tagIds []uint64, tagMatch *model.TagMatch, minPriority *model.Priority, orderBy []*model.TaskOrderInput) (*model.TaskConnection, error) {
+	filter := repository.TaskFilter{
+		CategoryID:     categoryID,
+		DueDateStart:   normalizeDateArg(dueDateStart),
+		DueDateEnd:     normalizeDateArg(dueDateEnd),
+		IncompleteOnly: incompleteOnly != nil && *incompleteOnly,
+		TagIDs:         tagIds,
+		TagMatch:       normalizeTagMatchArg(tagMatch),
+		MinPriority:    minPriority,
+		OrderBy:        orderBy,
+	}
+	page := repository.PageArgs{After: normalizeCursorArg(after)}
+	if first != nil {
+		if *first < 1 {
+			return nil, errpresenter.BadUserInput("first", "first must be positive")
+		}
+		page.First = *first
+	}
+	return r.TodoController.ListTasksConnection(ctx, filter, page)
+}
+
+// Trash is the resolver for the trash field.
+func (r *queryResolver) Trash(ctx context.Context) ([]*model.Task, error) {
+	return r.TodoController.ListDeletedTasks(ctx)
+}
+
+// NeedsAttention is the resolver for the needsAttention field.
+func (r *queryResolver) NeedsAttention(ctx context.Context) ([]*model.Task, error) {
+	return r.TodoController.ListTasksNeedingAttention(ctx)
+}
+
+// SearchTasks is the resolver for the searchTasks field.
+func (r *queryResolver) SearchTasks(ctx context.Context, query string, first *int32, after *string) (*model.TaskSearchConnection, error) {
+	page := repository.PageArgs{After: normalizeCursorArg(after)}
+	if first != nil {
+		if *first < 1 {
+			return nil, errpresenter.BadUserInput("first", "first must be positive")
+		}
+		page.First = *first
+	}
+	return r.TodoController.SearchTasks(ctx, query, page)
+}
+
+// TaskCreated is the resolver for the taskCreated field.
+func (r *subscriptionResolver) TaskCreated(ctx context.Context) (<-chan *model.Task, error) {
+	events, err := r.TodoController.WatchTasks(ctx, repository.TaskEventCreated)
+	if err != nil {
+		return nil, err
+	}
+	return forwardEvents(ctx, events, func(e repository.TaskEvent) *model.Task { return e.Task }), nil
+}
+
+// TaskUpdated is the resolver for the taskUpdated field.
+func (r *subscriptionResolver) TaskUpdated(ctx context.Context) (<-chan *model.Task, error) {
+	events, err := r.TodoController.WatchTasks(ctx, repository.TaskEventUpdated)
+	if err != nil {
+		return nil, err
+	}
+	return forwardEvents(ctx, events, func(e repository.TaskEvent) *model.Task { return e.Task }), nil
+}
+
+// TaskDeleted is the resolver for the taskDeleted field.
+func (r *subscriptionResolver) TaskDeleted(ctx context.Context) (<-chan uint64, error) {
+	events, err := r.TodoController.WatchTasks(ctx, repository.TaskEventDeleted)
+	if err != nil {
+		return nil, err
+	}
+	return forwardEvents(ctx, events, func(e repository.TaskEvent) uint64 { return e.TaskID }), nil
+}
+
+// SubTaskToggled is the resolver for the subTaskToggled field.
+func (r *subscriptionResolver) SubTaskToggled(ctx context.Context) (<-chan *model.SubTask, error) {
+	events, err := r.TodoController.WatchTasks(ctx, repository.TaskEventSubTaskToggled)
+	if err != nil {
+		return nil, err
+	}
+	return forwardEvents(ctx, events, func(e repository.TaskEvent) *model.SubTask { return e.SubTask }), nil
 }
 
 // Mutation returns graph.MutationResolver implementation.
@@ -55,8 +232,19 @@ func (r *Resolver) Mutation() graph.MutationResolver { return &mutationResolver{
 // Query returns graph.QueryResolver implementation.
 func (r *Resolver) Query() graph.QueryResolver { return &queryResolver{r} }
 
+// Subscription returns graph.SubscriptionResolver implementation.
+func (r *Resolver) Subscription() graph.SubscriptionResolver { return &subscriptionResolver{r} }
+
+// Task returns graph.TaskResolver implementation.
+func (r *Resolver) Task() graph.TaskResolver { return &taskResolver{r} }
+
 type mutationResolver struct{ *Resolver }
 type queryResolver struct{ *Resolver }
+type subscriptionResolver struct{ *Resolver }
+type taskResolver struct{ *Resolver }
+
+// maxTasksFirst is the most tasks the tasks query returns, the largest page the backend serves.
+const maxTasksFirst = 100
 
 func normalizeDateArg(value *string) *string {
 	if value == nil {
@@ -68,3 +256,46 @@ func normalizeDateArg(value *string) *string {
 	}
 	return &trimmed
 }
+
+func normalizeCursorArg(value *string) *string {
+	if value == nil || *value == "" {
+		return nil
+	}
+	return value
+}
+
+func normalizeTagMatchArg(value *model.TagMatch) model.TagMatch {
+	if value == nil {
+		return model.TagMatchAny
+	}
+	return *value
+}
+
+func toBulkTaskTarget(target model.BulkTaskTarget) repository.BulkTaskTarget {
+	res := repository.BulkTaskTarget{IDs: target.Ids}
+	if f := target.Filter; f != nil {
+		res.Filter = &repository.TaskFilter{
+			CategoryID:     f.CategoryID,
+			DueDateStart:   normalizeDateArg(f.DueDateStart),
+			DueDateEnd:     normalizeDateArg(f.DueDateEnd),
+			IncompleteOnly: f.IncompleteOnly != nil && *f.IncompleteOnly,
+			TagIDs:         f.TagIds,
+			TagMatch:       normalizeTagMatchArg(f.TagMatch),
+			MinPriority:    f.MinPriority,
+		}
+	}
+	return res
+}
+
+func toBulkTasksPayload(res *repository.BulkTaskResult) *model.BulkTasksPayload {
+	results := make([]*model.BulkTaskResult, 0, len(res.Outcomes))
+	for _, o := range res.Outcomes {
+		result := &model.BulkTaskResult{TaskID: o.TaskID, Task: o.Task}
+		if o.Err != nil {
+			st := status.Convert(o.Err)
+			result.Error = &model.BulkTaskError{Code: errpresenter.CodeOf(st.Code()), Message: st.Message()}
+		}
+		results = append(results, result)
+	}
+	return &model.BulkTasksPayload{Applied: res.Applied, Results: results}
+}
@@ -2,29 +2,49 @@ package main
 
 import (
 	"log"
+	"net/http"
+	"slices"
+	"time"
 
+	"github.com/99designs/gqlgen/graphql"
 	"github.com/99designs/gqlgen/graphql/handler"
+	"github.com/99designs/gqlgen/graphql/handler/extension"
+	"github.com/99designs/gqlgen/graphql/handler/lru"
+	"github.com/99designs/gqlgen/graphql/handler/transport"
 	"github.com/99designs/gqlgen/graphql/playground"
+	"github.com/gorilla/websocket"
 	"github.com/labstack/echo"
 	"github.com/labstack/echo/middleware"
 	"github.com/naoyakurokawa/go_grpc_graphql/Infrastructure/store"
+	"github.com/naoyakurokawa/go_grpc_graphql/auth"
+	"github.com/naoyakurokawa/go_grpc_graphql/config"
 	"github.com/naoyakurokawa/go_grpc_graphql/controller"
 	"github.com/naoyakurokawa/go_grpc_graphql/graph"
+	"github.com/naoyakurokawa/go_grpc_graphql/graph/errpresenter"
+	"github.com/naoyakurokawa/go_grpc_graphql/graph/loader"
 	"github.com/naoyakurokawa/go_grpc_graphql/graph/resolver"
 	"github.com/naoyakurokawa/go_grpc_graphql/pkg/pb"
 	"github.com/naoyakurokawa/go_grpc_graphql/usecase"
+	"github.com/vektah/gqlparser/v2/ast"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
 )
 
 const grpcAddress = "backend:50051"
 
+var allowedOrigins = []string{
+	"http://localhost:3000",
+	"http://127.0.0.1:3000",
+}
+
 func main() {
+	cfg, err := config.Load()
+	if err != nil {
+		log.Fatalf("failed to load config: %v", err)
+	}
+
 	// gRPC クライアントの接続
-	conn, err := grpc.NewClient(
-		grpcAddress,
-		grpc.WithTransportCredentials(insecure.NewCredentials()),
-	)
+	conn, err := dialBackend(grpcAddress, cfg.Backend.ServiceToken)
 	if err != nil {
 		log.Fatalf("failed to connect to gRPC server: %v", err)
 	}
@@ -33,6 +53,8 @@ func main() {
 	// gRPC クライアントを作成
 	taskClient := pb.NewTaskServiceClient(conn)
 	categoryClient := pb.NewCategoryServiceClient(conn)
+	userClient := pb.NewUserServiceClient(conn)
+	tagClient := pb.NewTagServiceClient(conn)
 
 	todoRepo := store.NewTodoStore(taskClient)
 	categoryRepo := store.NewCategoryStore(categoryClient)
@@ -41,17 +63,19 @@ func main() {
 	todoController := controller.NewTodoController(todoUsecase)
 	categoryUsecase := usecase.NewCategoryUsecase(categoryRepo)
 	categoryController := controller.NewCategoryController(categoryUsecase)
+	tagUsecase := usecase.NewTagUsecase(store.NewTagStore(tagClient))
+	tagController := controller.NewTagController(tagUsecase)
+	tokens := auth.NewTokenManager(cfg.Auth.JWTSecret, cfg.Auth.TokenTTL)
+	userUsecase := usecase.NewUserUsecase(store.NewUserStore(userClient), tokens)
+	userController := controller.NewUserController(userUsecase)
 
 	e := echo.New()
 
-	e.Debug = true
+	e.Debug = !cfg.App.IsProduction()
 	e.Use(middleware.Logger())
 	e.Use(middleware.Recover())
 	e.Use(middleware.CORSWithConfig(middleware.CORSConfig{
-		AllowOrigins: []string{
-			"http://localhost:3000",
-			"http://127.0.0.1:3000",
-		},
+		AllowOrigins: allowedOrigins,
 		AllowMethods: []string{echo.GET, echo.POST, echo.OPTIONS},
 		AllowHeaders: []string{
 			echo.HeaderOrigin,
@@ -61,20 +85,21 @@ func main() {
 		},
 	}))
 
-	graphqlHandler := handler.NewDefaultServer(
-		graph.NewExecutableSchema(
-			graph.Config{Resolvers: &resolver.Resolver{
-				TodoController:     todoController,
-				CategoryController: categoryController,
-			}},
-		),
-	)
+	graphqlHandler := newGraphQLHandler(&resolver.Resolver{
+		TodoController:     todoController,
+		CategoryController: categoryController,
+		UserController:     userController,
+		TagController:      tagController,
+	}, loader.Middleware(todoUsecase, categoryUsecase, tagUsecase), tokens, cfg.App.IsProduction())
 	playgroundHandler := playground.Handler("GraphQL", "/query")
 
-	e.POST("/query", func(c echo.Context) error {
+	queryHandler := func(c echo.Context) error {
 		graphqlHandler.ServeHTTP(c.Response(), c.Request())
 		return nil
-	})
+	}
+	e.POST("/query", queryHandler, auth.Middleware(tokens))
+	// websocket のハンドシェイクは GET で届く
+	e.GET("/query", queryHandler, auth.Middleware(tokens))
 
 	e.GET("/playground", func(c echo.Context) error {
 		playgroundHandler.ServeHTTP(c.Response(), c.Request())
@@ -86,3 +111,47 @@ func main() {
 		log.Fatalln(err)
 	}
 }
+
+// dialBackend connects to the backend gRPC server, authenticating with serviceToken.
+func dialBackend(target, serviceToken string, opts ...grpc.DialOption) (*grpc.ClientConn, error) {
+	opts = append([]grpc.DialOption{
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		// backend は x-user-id をサービストークン付きの呼び出しでしか信用しない
+		grpc.WithPerRPCCredentials(auth.ServiceToken(serviceToken)),
+		// 認証済みユーザーを x-user-id メタデータとして backend に伝える
+		// subscription は WatchTasks ストリームを使うので stream にも付ける
+		grpc.WithUnaryInterceptor(auth.UnaryClientInterceptor),
+		grpc.WithStreamInterceptor(auth.StreamClientInterceptor),
+	}, opts...)
+	return grpc.NewClient(target, opts...)
+}
+
+// newGraphQLHandler builds the GraphQL server for the resolvers, serving
+// subscriptions over websockets authenticated with tokens.
+func newGraphQLHandler(resolvers *resolver.Resolver, loaders graphql.ResponseMiddleware, tokens *auth.TokenManager, isProduction bool) *handler.Server {
+	graphqlHandler := handler.New(graph.NewExecutableSchema(graph.Config{Resolvers: resolvers}))
+	// DataLoader はレスポンスごと (subscription ではイベントごと) に作り直す
+	graphqlHandler.AroundResponses(loaders)
+	// subscription は websocket 経由で配信する
+	graphqlHandler.AddTransport(transport.Websocket{
+		KeepAlivePingInterval: 10 * time.Second,
+		Upgrader: websocket.Upgrader{
+			CheckOrigin: func(r *http.Request) bool {
+				return slices.Contains(allowedOrigins, r.Header.Get(echo.HeaderOrigin))
+			},
+		},
+		InitFunc: auth.WebsocketInitFunc(tokens),
+	})
+	graphqlHandler.AddTransport(transport.Options{})
+	graphqlHandler.AddTransport(transport.GET{})
+	graphqlHandler.AddTransport(transport.POST{})
+	graphqlHandler.AddTransport(transport.MultipartForm{})
+	graphqlHandler.SetQueryCache(lru.New[*ast.QueryDocument](1000))
+	graphqlHandler.Use(extension.Introspection{})
+	graphqlHandler.Use(extension.AutomaticPersistedQuery{
+		Cache: lru.New[string](100),
+	})
+	graphqlHandler.SetErrorPresenter(errpresenter.New(isProduction))
+
+	return graphqlHandler
+}
@@ -0,0 +1,431 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/99designs/gqlgen/client"
+	"github.com/naoyakurokawa/go_grpc_graphql/Infrastructure/store"
+	"github.com/naoyakurokawa/go_grpc_graphql/auth"
+	"github.com/naoyakurokawa/go_grpc_graphql/controller"
+	"github.com/naoyakurokawa/go_grpc_graphql/graph/loader"
+	"github.com/naoyakurokawa/go_grpc_graphql/graph/resolver"
+	"github.com/naoyakurokawa/go_grpc_graphql/pkg/pb"
+	"github.com/naoyakurokawa/go_grpc_graphql/usecase"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// testServiceToken is the secret the BFF under test authenticates to the backend with.
+const testServiceToken = "test-service-token"
+
+// watchingBackend streams two created tasks in category 1 to every
+// authenticated WatchTasks call, naming the user the backend saw in their titles.
+type watchingBackend struct {
+	pb.UnimplementedTaskServiceServer
+}
+
+func (watchingBackend) WatchTasks(_ *pb.WatchTasksRequest, stream grpc.ServerStreamingServer[pb.TaskEvent]) error {
+	md, _ := metadata.FromIncomingContext(stream.Context())
+	if tokens := md.Get(auth.ServiceTokenMetadataKey); len(tokens) != 1 || tokens[0] != testServiceToken {
+		return status.Error(codes.Unauthenticated, "missing service token")
+	}
+	userIDs := md.Get(auth.UserIDMetadataKey)
+	if len(userIDs) == 0 {
+		return status.Error(codes.Unauthenticated, "missing user")
+	}
+	if err := stream.SendHeader(metadata.MD{}); err != nil {
+		return err
+	}
+	for id := uint64(1); id <= 2; id++ {
+		if err := stream.Send(&pb.TaskEvent{
+			Type:   pb.TaskEventType_TASK_EVENT_TYPE_CREATED,
+			TaskId: id,
+			Task:   &pb.Task{Id: id, Title: "created by user " + userIDs[0], CategoryId: 1},
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// renamingCategories renames category 1 every time categories are listed.
+type renamingCategories struct {
+	pb.UnimplementedCategoryServiceServer
+	calls atomic.Int32
+}
+
+func (s *renamingCategories) GetCategories(context.Context, *emptypb.Empty) (*pb.CategoryList, error) {
+	name := fmt.Sprintf("category v%d", s.calls.Add(1))
+	return &pb.CategoryList{Categories: []*pb.Category{{Id: 1, Name: name}}}, nil
+}
+
+// TestSubscription runs a subscription from the websocket handshake through to
+// the backend stream. It checks that the service token and the user authenticated
+// in connection_init reach the backend and that every event loads its category afresh.
+func TestSubscription(t *testing.T) {
+	t.Parallel()
+
+	conn := startBackend(t, func(srv *grpc.Server) {
+		pb.RegisterTaskServiceServer(srv, watchingBackend{})
+		pb.RegisterCategoryServiceServer(srv, &renamingCategories{})
+	})
+	c, token := newTestClient(t, conn)
+	sub := c.WebsocketWithPayload(
+		`subscription { taskCreated { title category { name } } }`,
+		map[string]any{"authorization": "Bearer " + token},
+		client.AddHeader("Origin", allowedOrigins[0]),
+	)
+	t.Cleanup(func() { sub.Close() })
+
+	for i := 1; i <= 2; i++ {
+		var resp struct {
+			TaskCreated struct {
+				Title    string
+				Category struct {
+					Name string
+				}
+			}
+		}
+		if err := sub.Next(&resp); err != nil {
+			t.Fatalf("subscription failed: %v", err)
+		}
+		if want := "created by user 42"; resp.TaskCreated.Title != want {
+			t.Fatalf("taskCreated.title = %q, want %q", resp.TaskCreated.Title, want)
+		}
+		if want := fmt.Sprintf("category v%d", i); resp.TaskCreated.Category.Name != want {
+			t.Fatalf("event %d has category %q, want %q", i, resp.TaskCreated.Category.Name, want)
+		}
+	}
+}
+
+// pagingBackend records the GetTasks requests it receives and returns no tasks.
+type pagingBackend struct {
+	pb.UnimplementedTaskServiceServer
+	requests chan *pb.GetTasksRequest
+}
+
+func (b *pagingBackend) GetTasks(_ context.Context, in *pb.GetTasksRequest) (*pb.TaskList, error) {
+	b.requests <- in
+	return &pb.TaskList{}, nil
+}
+
+// TestTasks_Paged checks that the tasks query asks the backend for a single page
+// and rejects sizes beyond the largest page.
+func TestTasks_Paged(t *testing.T) {
+	t.Parallel()
+
+	backend := &pagingBackend{requests: make(chan *pb.GetTasksRequest, 1)}
+	conn := startBackend(t, func(srv *grpc.Server) {
+		pb.RegisterTaskServiceServer(srv, backend)
+	})
+	c, token := newTestClient(t, conn)
+	authorize := client.AddHeader("Authorization", "Bearer "+token)
+
+	tests := []struct {
+		name         string
+		query        string
+		wantPageSize int32
+		wantErr      bool
+	}{
+		{name: "default size", query: `{ tasks { database_id } }`, wantPageSize: 50},
+		{name: "requested size", query: `{ tasks(first: 100) { database_id } }`, wantPageSize: 100},
+		{name: "too many", query: `{ tasks(first: 101) { database_id } }`, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		var resp struct {
+			Tasks []struct {
+				DatabaseID uint64 `json:"database_id"`
+			}
+		}
+		err := c.Post(tt.query, &resp, authorize)
+		if tt.wantErr {
+			if err == nil {
+				t.Fatalf("%s: tasks succeeded, want an error", tt.name)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("%s: tasks failed: %v", tt.name, err)
+		}
+		if req := <-backend.requests; req.GetPageSize() != tt.wantPageSize {
+			t.Fatalf("%s: backend asked for %d tasks, want %d", tt.name, req.GetPageSize(), tt.wantPageSize)
+		}
+	}
+}
+
+// startBackend serves the services registered by register over an in-memory listener
+// and returns a BFF connection to them.
+func startBackend(t *testing.T, register func(srv *grpc.Server)) *grpc.ClientConn {
+	t.Helper()
+
+	lis := bufconn.Listen(1 << 20)
+	srv := grpc.NewServer()
+	register(srv)
+	go srv.Serve(lis)
+	t.Cleanup(srv.Stop)
+
+	conn, err := dialBackend("passthrough:///bufnet", testServiceToken, grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+		return lis.DialContext(ctx)
+	}))
+	if err != nil {
+		t.Fatalf("failed to dial backend: %v", err)
+	}
+	t.Cleanup(func() { conn.Close() })
+	return conn
+}
+
+// newTestClient returns a GraphQL client for a BFF talking to conn, and a token of user 42.
+func newTestClient(t *testing.T, conn *grpc.ClientConn) (*client.Client, string) {
+	t.Helper()
+
+	todoUsecase := usecase.NewTodoUsecase(store.NewTodoStore(pb.NewTaskServiceClient(conn)))
+	categoryUsecase := usecase.NewCategoryUsecase(store.NewCategoryStore(pb.NewCategoryServiceClient(conn)))
+	tagUsecase := usecase.NewTagUsecase(store.NewTagStore(pb.NewTagServiceClient(conn)))
+	tokens := auth.NewTokenManager("secret", time.Hour)
+	token, _, err := tokens.Issue(42)
+	if err != nil {
+		t.Fatalf("Issue returned error: %v", err)
+	}
+
+	c := client.New(newGraphQLHandler(
+		&resolver.Resolver{TodoController: controller.NewTodoController(todoUsecase)},
+		loader.Middleware(todoUsecase, categoryUsecase, tagUsecase),
+		tokens,
+		false,
+	))
+	return c, token
+}
+
+// updatingBackend records the UpdateTask requests it receives and echoes the task id.
+type updatingBackend struct {
+	pb.UnimplementedTaskServiceServer
+	requests chan *pb.UpdateTaskRequest
+}
+
+func (b *updatingBackend) UpdateTask(_ context.Context, in *pb.UpdateTaskRequest) (*pb.Task, error) {
+	b.requests <- in
+	return &pb.Task{Id: in.GetInput().GetId()}, nil
+}
+
+// TestUpdateTask_Recurrence checks how the recurrence of updateTask reaches the backend:
+// an omitted schedule is kept and an explicit null clears it.
+func TestUpdateTask_Recurrence(t *testing.T) {
+	t.Parallel()
+
+	backend := &updatingBackend{requests: make(chan *pb.UpdateTaskRequest, 1)}
+	conn := startBackend(t, func(srv *grpc.Server) {
+		pb.RegisterTaskServiceServer(srv, backend)
+	})
+	c, token := newTestClient(t, conn)
+	authorize := client.AddHeader("Authorization", "Bearer "+token)
+
+	tests := []struct {
+		name      string
+		input     string
+		wantClear bool
+		wantRule  bool
+	}{
+		{name: "omitted", input: `{id: 1, title: "t"}`},
+		{name: "null", input: `{id: 1, recurrence: null}`, wantClear: true},
+		{name: "replaced", input: `{id: 1, recurrence: {frequency: DAILY}}`, wantRule: true},
+	}
+
+	for _, tt := range tests {
+		var resp struct {
+			UpdateTask struct {
+				DatabaseID uint64 `json:"database_id"`
+			}
+		}
+		if err := c.Post(`mutation { updateTask(input: `+tt.input+`) { database_id } }`, &resp, authorize); err != nil {
+			t.Fatalf("%s: updateTask failed: %v", tt.name, err)
+		}
+		req := (<-backend.requests).GetInput()
+		if req.GetClearRecurrence() != tt.wantClear || (req.GetRecurrence() != nil) != tt.wantRule {
+			t.Fatalf("%s: backend got clear_recurrence %v and recurrence %v", tt.name, req.GetClearRecurrence(), req.GetRecurrence())
+		}
+	}
+}
+
+// taskBackend serves task 7 to every task listing and lookup.
+type taskBackend struct {
+	pb.UnimplementedTaskServiceServer
+}
+
+func (taskBackend) GetTasks(context.Context, *pb.GetTasksRequest) (*pb.TaskList, error) {
+	return &pb.TaskList{Tasks: []*pb.Task{{Id: 7, Title: "write report"}}}, nil
+}
+
+func (taskBackend) BatchGetTasks(_ context.Context, in *pb.BatchGetTasksRequest) (*pb.TaskList, error) {
+	tasks := make([]*pb.Task, 0, len(in.GetIds()))
+	for _, id := range in.GetIds() {
+		if id == 7 {
+			tasks = append(tasks, &pb.Task{Id: 7, Title: "write report"})
+		}
+	}
+	return &pb.TaskList{Tasks: tasks}, nil
+}
+
+// TestNode checks that id is the opaque Relay id that node resolves, while database_id
+// carries the numeric key.
+func TestNode(t *testing.T) {
+	t.Parallel()
+
+	conn := startBackend(t, func(srv *grpc.Server) {
+		pb.RegisterTaskServiceServer(srv, taskBackend{})
+	})
+	c, token := newTestClient(t, conn)
+	authorize := client.AddHeader("Authorization", "Bearer "+token)
+
+	var list struct {
+		Tasks []struct {
+			ID         string
+			DatabaseID uint64 `json:"database_id"`
+		}
+	}
+	if err := c.Post(`{ tasks { id database_id } }`, &list, authorize); err != nil {
+		t.Fatalf("tasks failed: %v", err)
+	}
+	if len(list.Tasks) != 1 || list.Tasks[0].DatabaseID != 7 || list.Tasks[0].ID == "7" {
+		t.Fatalf("tasks = %+v, want task 7 with an opaque id", list.Tasks)
+	}
+
+	var node struct {
+		Node struct {
+			ID         string
+			DatabaseID uint64 `json:"database_id"`
+			Title      string
+		}
+	}
+	query := `query($id: ID!) { node(id: $id) { id ... on Task { database_id title } } }`
+	if err := c.Post(query, &node, authorize, client.Var("id", list.Tasks[0].ID)); err != nil {
+		t.Fatalf("node failed: %v", err)
+	}
+	if node.Node.ID != list.Tasks[0].ID || node.Node.DatabaseID != 7 || node.Node.Title != "write report" {
+		t.Fatalf("node = %+v, want task 7", node.Node)
+	}
+}
+
+// countingBackend serves n tasks in category 1 with tag 1 and one subtask each, and
+// counts the calls the BFF makes. Listings leave the subtasks out when asked to.
+type countingBackend struct {
+	pb.UnimplementedTaskServiceServer
+	pb.UnimplementedCategoryServiceServer
+	pb.UnimplementedTagServiceServer
+	n                 int
+	getTasks          atomic.Int32
+	batchListSubTasks atomic.Int32
+	getCategories     atomic.Int32
+	getTags           atomic.Int32
+}
+
+func (b *countingBackend) tasks(withSubTasks bool) []*pb.Task {
+	tasks := make([]*pb.Task, 0, b.n)
+	for id := uint64(1); id <= uint64(b.n); id++ {
+		task := &pb.Task{Id: id, Title: fmt.Sprintf("task %d", id), CategoryId: 1, TagIds: []uint64{1}, SubtaskTotal: 1}
+		if withSubTasks {
+			task.SubTasks = []*pb.SubTask{{Id: id * 10, TaskId: id, Title: "step"}}
+		}
+		tasks = append(tasks, task)
+	}
+	return tasks
+}
+
+func (b *countingBackend) GetTasks(_ context.Context, in *pb.GetTasksRequest) (*pb.TaskList, error) {
+	b.getTasks.Add(1)
+	return &pb.TaskList{Tasks: b.tasks(!in.GetSkipSubTasks())}, nil
+}
+
+func (b *countingBackend) ListTasksNeedingAttention(context.Context, *emptypb.Empty) (*pb.TaskList, error) {
+	return &pb.TaskList{Tasks: b.tasks(true)}, nil
+}
+
+func (b *countingBackend) BatchListSubTasks(_ context.Context, in *pb.TaskIds) (*pb.SubTasksByTask, error) {
+	b.batchListSubTasks.Add(1)
+	res := &pb.SubTasksByTask{SubTasks: make(map[uint64]*pb.SubTaskList, len(in.GetIds()))}
+	for _, id := range in.GetIds() {
+		res.SubTasks[id] = &pb.SubTaskList{SubTasks: []*pb.SubTask{{Id: id * 10, TaskId: id, Title: "step"}}}
+	}
+	return res, nil
+}
+
+func (b *countingBackend) GetCategories(context.Context, *emptypb.Empty) (*pb.CategoryList, error) {
+	b.getCategories.Add(1)
+	return &pb.CategoryList{Categories: []*pb.Category{{Id: 1, Name: "work"}}}, nil
+}
+
+func (b *countingBackend) GetTags(context.Context, *emptypb.Empty) (*pb.TagList, error) {
+	b.getTags.Add(1)
+	return &pb.TagList{Tags: []*pb.Tag{{Id: 1, Name: "urgent"}}}, nil
+}
+
+// TestTasks_Batched checks that the fields of every task in a listing are loaded
+// with one backend call per field rather than one per task, and that subtasks
+// that came with the tasks are not fetched again.
+func TestTasks_Batched(t *testing.T) {
+	t.Parallel()
+
+	const n = 30
+	backend := &countingBackend{n: n}
+	conn := startBackend(t, func(srv *grpc.Server) {
+		pb.RegisterTaskServiceServer(srv, backend)
+		pb.RegisterCategoryServiceServer(srv, backend)
+		pb.RegisterTagServiceServer(srv, backend)
+	})
+	c, token := newTestClient(t, conn)
+	authorize := client.AddHeader("Authorization", "Bearer "+token)
+
+	type task struct {
+		Category struct{ Name string }
+		Tags     []struct{ Name string }
+		SubTasks []struct{ Title string } `json:"sub_tasks"`
+	}
+	check := func(name string, tasks []task) {
+		t.Helper()
+		if len(tasks) != n {
+			t.Fatalf("%s returned %d tasks, want %d", name, len(tasks), n)
+		}
+		for _, task := range tasks {
+			if task.Category.Name != "work" || len(task.Tags) != 1 || len(task.SubTasks) != 1 {
+				t.Fatalf("%s returned %+v, want every field loaded", name, task)
+			}
+		}
+	}
+
+	var list struct{ Tasks []task }
+	if err := c.Post(`{ tasks(first: 100) { category { name } tags { name } sub_tasks { title } } }`, &list, authorize); err != nil {
+		t.Fatalf("tasks failed: %v", err)
+	}
+	check("tasks", list.Tasks)
+	calls := map[string]int32{
+		"GetTasks":          backend.getTasks.Load(),
+		"BatchListSubTasks": backend.batchListSubTasks.Load(),
+		"GetCategories":     backend.getCategories.Load(),
+		"GetTags":           backend.getTags.Load(),
+	}
+	for rpc, got := range calls {
+		if got != 1 {
+			t.Fatalf("%s called %d times for %d tasks, want 1", rpc, got, n)
+		}
+	}
+
+	var attention struct {
+		NeedsAttention []task
+	}
+	if err := c.Post(`{ needsAttention { category { name } tags { name } sub_tasks { title } } }`, &attention, authorize); err != nil {
+		t.Fatalf("needsAttention failed: %v", err)
+	}
+	check("needsAttention", attention.NeedsAttention)
+	if got := backend.batchListSubTasks.Load(); got != 1 {
+		t.Fatalf("BatchListSubTasks called again for subtasks that came with the tasks")
+	}
+}
@@ -22,6 +22,60 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// DeleteCategoryPolicy decides what happens to tasks that still reference
+// the category being deleted.
+type DeleteCategoryPolicy int32
+
+const (
+	// Refuse to delete while any task references the category.
+	DeleteCategoryPolicy_DELETE_CATEGORY_POLICY_REJECT DeleteCategoryPolicy = 0
+	// Move the tasks to reassign_to before deleting.
+	DeleteCategoryPolicy_DELETE_CATEGORY_POLICY_REASSIGN DeleteCategoryPolicy = 1
+	// Clear tasks.category_id before deleting.
+	DeleteCategoryPolicy_DELETE_CATEGORY_POLICY_NULLIFY DeleteCategoryPolicy = 2
+)
+
+// Enum value maps for DeleteCategoryPolicy.
+var (
+	DeleteCategoryPolicy_name = map[int32]string{
+		0: "DELETE_CATEGORY_POLICY_REJECT",
+		1: "DELETE_CATEGORY_POLICY_REASSIGN",
+		2: "DELETE_CATEGORY_POLICY_NULLIFY",
+	}
+	DeleteCategoryPolicy_value = map[string]int32{
+		"DELETE_CATEGORY_POLICY_REJECT":   0,
+		"DELETE_CATEGORY_POLICY_REASSIGN": 1,
+		"DELETE_CATEGORY_POLICY_NULLIFY":  2,
+	}
+)
+
+func (x DeleteCategoryPolicy) Enum() *DeleteCategoryPolicy {
+	p := new(DeleteCategoryPolicy)
+	*p = x
+	return p
+}
+
+func (x DeleteCategoryPolicy) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DeleteCategoryPolicy) Descriptor() protoreflect.EnumDescriptor {
+	return file_category_proto_enumTypes[0].Descriptor()
+}
+
+func (DeleteCategoryPolicy) Type() protoreflect.EnumType {
+	return &file_category_proto_enumTypes[0]
+}
+
+func (x DeleteCategoryPolicy) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DeleteCategoryPolicy.Descriptor instead.
+func (DeleteCategoryPolicy) EnumDescriptor() ([]byte, []int) {
+	return file_category_proto_rawDescGZIP(), []int{0}
+}
+
 type Category struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -118,6 +172,206 @@ func (x *CategoryList) GetCategories() []*Category {
 	return nil
 }
 
+type CreateCategoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateCategoryRequest) Reset() {
+	*x = CreateCategoryRequest{}
+	mi := &file_category_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateCategoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCategoryRequest) ProtoMessage() {}
+
+func (x *CreateCategoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_category_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateCategoryRequest.ProtoReflect.Descriptor instead.
+func (*CreateCategoryRequest) Descriptor() ([]byte, []int) {
+	return file_category_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *CreateCategoryRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type UpdateCategoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateCategoryRequest) Reset() {
+	*x = UpdateCategoryRequest{}
+	mi := &file_category_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateCategoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateCategoryRequest) ProtoMessage() {}
+
+func (x *UpdateCategoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_category_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateCategoryRequest.ProtoReflect.Descriptor instead.
+func (*UpdateCategoryRequest) Descriptor() ([]byte, []int) {
+	return file_category_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *UpdateCategoryRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *UpdateCategoryRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type DeleteCategoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Policy        DeleteCategoryPolicy   `protobuf:"varint,2,opt,name=policy,proto3,enum=task.DeleteCategoryPolicy" json:"policy,omitempty"`
+	ReassignTo    *uint64                `protobuf:"varint,3,opt,name=reassign_to,json=reassignTo,proto3,oneof" json:"reassign_to,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteCategoryRequest) Reset() {
+	*x = DeleteCategoryRequest{}
+	mi := &file_category_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteCategoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCategoryRequest) ProtoMessage() {}
+
+func (x *DeleteCategoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_category_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteCategoryRequest.ProtoReflect.Descriptor instead.
+func (*DeleteCategoryRequest) Descriptor() ([]byte, []int) {
+	return file_category_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *DeleteCategoryRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *DeleteCategoryRequest) GetPolicy() DeleteCategoryPolicy {
+	if x != nil {
+		return x.Policy
+	}
+	return DeleteCategoryPolicy_DELETE_CATEGORY_POLICY_REJECT
+}
+
+func (x *DeleteCategoryRequest) GetReassignTo() uint64 {
+	if x != nil && x.ReassignTo != nil {
+		return *x.ReassignTo
+	}
+	return 0
+}
+
+type DeleteCategoryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteCategoryResponse) Reset() {
+	*x = DeleteCategoryResponse{}
+	mi := &file_category_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteCategoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCategoryResponse) ProtoMessage() {}
+
+func (x *DeleteCategoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_category_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteCategoryResponse.ProtoReflect.Descriptor instead.
+func (*DeleteCategoryResponse) Descriptor() ([]byte, []int) {
+	return file_category_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *DeleteCategoryResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
 var File_category_proto protoreflect.FileDescriptor
 
 const file_category_proto_rawDesc = "" +
@@ -129,9 +383,29 @@ const file_category_proto_rawDesc = "" +
 	"\fCategoryList\x12.\n" +
 	"\n" +
 	"categories\x18\x01 \x03(\v2\x0e.task.CategoryR\n" +
-	"categories2N\n" +
+	"categories\"+\n" +
+	"\x15CreateCategoryRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\";\n" +
+	"\x15UpdateCategoryRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\"\x91\x01\n" +
+	"\x15DeleteCategoryRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\x122\n" +
+	"\x06policy\x18\x02 \x01(\x0e2\x1a.task.DeleteCategoryPolicyR\x06policy\x12$\n" +
+	"\vreassign_to\x18\x03 \x01(\x04H\x00R\n" +
+	"reassignTo\x88\x01\x01B\x0e\n" +
+	"\f_reassign_to\"2\n" +
+	"\x16DeleteCategoryResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess*\x82\x01\n" +
+	"\x14DeleteCategoryPolicy\x12!\n" +
+	"\x1dDELETE_CATEGORY_POLICY_REJECT\x10\x00\x12#\n" +
+	"\x1fDELETE_CATEGORY_POLICY_REASSIGN\x10\x01\x12\"\n" +
+	"\x1eDELETE_CATEGORY_POLICY_NULLIFY\x10\x022\x99\x02\n" +
 	"\x0fCategoryService\x12;\n" +
-	"\rGetCategories\x12\x16.google.protobuf.Empty\x1a\x12.task.CategoryListB\x05Z\x03/pbb\x06proto3"
+	"\rGetCategories\x12\x16.google.protobuf.Empty\x1a\x12.task.CategoryList\x12=\n" +
+	"\x0eCreateCategory\x12\x1b.task.CreateCategoryRequest\x1a\x0e.task.Category\x12=\n" +
+	"\x0eUpdateCategory\x12\x1b.task.UpdateCategoryRequest\x1a\x0e.task.Category\x12K\n" +
+	"\x0eDeleteCategory\x12\x1b.task.DeleteCategoryRequest\x1a\x1c.task.DeleteCategoryResponseB\x05Z\x03/pbb\x06proto3"
 
 var (
 	file_category_proto_rawDescOnce sync.Once
@@ -145,21 +419,34 @@ func file_category_proto_rawDescGZIP() []byte {
 	return file_category_proto_rawDescData
 }
 
-var file_category_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_category_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_category_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
 var file_category_proto_goTypes = []any{
-	(*Category)(nil),      // 0: task.Category
-	(*CategoryList)(nil),  // 1: task.CategoryList
-	(*emptypb.Empty)(nil), // 2: google.protobuf.Empty
+	(DeleteCategoryPolicy)(0),      // 0: task.DeleteCategoryPolicy
+	(*Category)(nil),               // 1: task.Category
+	(*CategoryList)(nil),           // 2: task.CategoryList
+	(*CreateCategoryRequest)(nil),  // 3: task.CreateCategoryRequest
+	(*UpdateCategoryRequest)(nil),  // 4: task.UpdateCategoryRequest
+	(*DeleteCategoryRequest)(nil),  // 5: task.DeleteCategoryRequest
+	(*DeleteCategoryResponse)(nil), // 6: task.DeleteCategoryResponse
+	(*emptypb.Empty)(nil),          // 7: google.protobuf.Empty
 }
 var file_category_proto_depIdxs = []int32{
-	0, // 0: task.CategoryList.categories:type_name -> task.Category
-	2, // 1: task.CategoryService.GetCategories:input_type -> google.protobuf.Empty
-	1, // 2: task.CategoryService.GetCategories:output_type -> task.CategoryList
-	2, // [2:3] is the sub-list for method output_type
-	1, // [1:2] is the sub-list for method input_type
-	1, // [1:1] is the sub-list for extension type_name
-	1, // [1:1] is the sub-list for extension extendee
-	0, // [0:1] is the sub-list for field type_name
+	1, // 0: task.CategoryList.categories:type_name -> task.Category
+	0, // 1: task.DeleteCategoryRequest.policy:type_name -> task.DeleteCategoryPolicy
+	7, // 2: task.CategoryService.GetCategories:input_type -> google.protobuf.Empty
+	3, // 3: task.CategoryService.CreateCategory:input_type -> task.CreateCategoryRequest
+	4, // 4: task.CategoryService.UpdateCategory:input_type -> task.UpdateCategoryRequest
+	5, // 5: task.CategoryService.DeleteCategory:input_type -> task.DeleteCategoryRequest
+	2, // 6: task.CategoryService.GetCategories:output_type -> task.CategoryList
+	1, // 7: task.CategoryService.CreateCategory:output_type -> task.Category
+	1, // 8: task.CategoryService.UpdateCategory:output_type -> task.Category
+	6, // 9: task.CategoryService.DeleteCategory:output_type -> task.DeleteCategoryResponse
+	6, // [6:10] is the sub-list for method output_type
+	2, // [2:6] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
 }
 
 func init() { file_category_proto_init() }
@@ -167,18 +454,20 @@ func file_category_proto_init() {
 	if File_category_proto != nil {
 		return
 	}
+	file_category_proto_msgTypes[4].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_category_proto_rawDesc), len(file_category_proto_rawDesc)),
-			NumEnums:      0,
-			NumMessages:   2,
+			NumEnums:      1,
+			NumMessages:   6,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_category_proto_goTypes,
 		DependencyIndexes: file_category_proto_depIdxs,
+		EnumInfos:         file_category_proto_enumTypes,
 		MessageInfos:      file_category_proto_msgTypes,
 	}.Build()
 	File_category_proto = out.File
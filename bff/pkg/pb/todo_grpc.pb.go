@@ -11,6 +11,7 @@ import (
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
 )
 
 // This is a compile-time assertion to ensure that this generated file
@@ -19,13 +20,30 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	TaskService_GetTasks_FullMethodName      = "/task.TaskService/GetTasks"
-	TaskService_CreateTask_FullMethodName    = "/task.TaskService/CreateTask"
-	TaskService_UpdateTask_FullMethodName    = "/task.TaskService/UpdateTask"
-	TaskService_DeleteTask_FullMethodName    = "/task.TaskService/DeleteTask"
-	TaskService_CreateSubTask_FullMethodName = "/task.TaskService/CreateSubTask"
-	TaskService_ToggleSubTask_FullMethodName = "/task.TaskService/ToggleSubTask"
-	TaskService_ListSubTasks_FullMethodName  = "/task.TaskService/ListSubTasks"
+	TaskService_GetTasks_FullMethodName                  = "/task.TaskService/GetTasks"
+	TaskService_GetTask_FullMethodName                   = "/task.TaskService/GetTask"
+	TaskService_BatchGetTasks_FullMethodName             = "/task.TaskService/BatchGetTasks"
+	TaskService_CreateTask_FullMethodName                = "/task.TaskService/CreateTask"
+	TaskService_UpdateTask_FullMethodName                = "/task.TaskService/UpdateTask"
+	TaskService_DeleteTask_FullMethodName                = "/task.TaskService/DeleteTask"
+	TaskService_BulkUpdateTasks_FullMethodName           = "/task.TaskService/BulkUpdateTasks"
+	TaskService_BulkDeleteTasks_FullMethodName           = "/task.TaskService/BulkDeleteTasks"
+	TaskService_ListDeletedTasks_FullMethodName          = "/task.TaskService/ListDeletedTasks"
+	TaskService_ListTasksNeedingAttention_FullMethodName = "/task.TaskService/ListTasksNeedingAttention"
+	TaskService_RestoreTask_FullMethodName               = "/task.TaskService/RestoreTask"
+	TaskService_PurgeTask_FullMethodName                 = "/task.TaskService/PurgeTask"
+	TaskService_GetSubTask_FullMethodName                = "/task.TaskService/GetSubTask"
+	TaskService_CreateSubTask_FullMethodName             = "/task.TaskService/CreateSubTask"
+	TaskService_UpdateSubTask_FullMethodName             = "/task.TaskService/UpdateSubTask"
+	TaskService_ToggleSubTask_FullMethodName             = "/task.TaskService/ToggleSubTask"
+	TaskService_DeleteSubTask_FullMethodName             = "/task.TaskService/DeleteSubTask"
+	TaskService_ReorderSubTasks_FullMethodName           = "/task.TaskService/ReorderSubTasks"
+	TaskService_ListSubTasks_FullMethodName              = "/task.TaskService/ListSubTasks"
+	TaskService_BatchListSubTasks_FullMethodName         = "/task.TaskService/BatchListSubTasks"
+	TaskService_WatchTasks_FullMethodName                = "/task.TaskService/WatchTasks"
+	TaskService_SearchTasks_FullMethodName               = "/task.TaskService/SearchTasks"
+	TaskService_ListTaskHistory_FullMethodName           = "/task.TaskService/ListTaskHistory"
+	TaskService_GetTaskStats_FullMethodName              = "/task.TaskService/GetTaskStats"
 )
 
 // TaskServiceClient is the client API for TaskService service.
@@ -33,12 +51,36 @@ const (
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type TaskServiceClient interface {
 	GetTasks(ctx context.Context, in *GetTasksRequest, opts ...grpc.CallOption) (*TaskList, error)
+	GetTask(ctx context.Context, in *TaskId, opts ...grpc.CallOption) (*Task, error)
+	// The listed tasks in the order given.
+	BatchGetTasks(ctx context.Context, in *BatchGetTasksRequest, opts ...grpc.CallOption) (*TaskList, error)
 	CreateTask(ctx context.Context, in *CreateTaskRequest, opts ...grpc.CallOption) (*Task, error)
 	UpdateTask(ctx context.Context, in *UpdateTaskRequest, opts ...grpc.CallOption) (*Task, error)
 	DeleteTask(ctx context.Context, in *TaskId, opts ...grpc.CallOption) (*DeleteTaskResponse, error)
+	// Change or trash many tasks in one transaction, reporting the outcome for each.
+	BulkUpdateTasks(ctx context.Context, in *BulkUpdateTasksRequest, opts ...grpc.CallOption) (*BulkTasksResponse, error)
+	BulkDeleteTasks(ctx context.Context, in *BulkDeleteTasksRequest, opts ...grpc.CallOption) (*BulkTasksResponse, error)
+	ListDeletedTasks(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*TaskList, error)
+	// Open tasks of high priority or above whose due date has passed, most urgent first.
+	ListTasksNeedingAttention(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*TaskList, error)
+	RestoreTask(ctx context.Context, in *TaskId, opts ...grpc.CallOption) (*Task, error)
+	PurgeTask(ctx context.Context, in *TaskId, opts ...grpc.CallOption) (*DeleteTaskResponse, error)
+	GetSubTask(ctx context.Context, in *SubTaskId, opts ...grpc.CallOption) (*SubTask, error)
 	CreateSubTask(ctx context.Context, in *CreateSubTaskRequest, opts ...grpc.CallOption) (*SubTask, error)
+	UpdateSubTask(ctx context.Context, in *UpdateSubTaskRequest, opts ...grpc.CallOption) (*SubTask, error)
 	ToggleSubTask(ctx context.Context, in *ToggleSubTaskRequest, opts ...grpc.CallOption) (*SubTask, error)
+	DeleteSubTask(ctx context.Context, in *SubTaskId, opts ...grpc.CallOption) (*DeleteSubTaskResponse, error)
+	ReorderSubTasks(ctx context.Context, in *ReorderSubTasksRequest, opts ...grpc.CallOption) (*SubTaskList, error)
 	ListSubTasks(ctx context.Context, in *TaskId, opts ...grpc.CallOption) (*SubTaskList, error)
+	BatchListSubTasks(ctx context.Context, in *TaskIds, opts ...grpc.CallOption) (*SubTasksByTask, error)
+	// Streams changes to the calling user's tasks until the client cancels.
+	WatchTasks(ctx context.Context, in *WatchTasksRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[TaskEvent], error)
+	// Full-text search over the calling user's tasks, ranked by relevance.
+	SearchTasks(ctx context.Context, in *SearchTasksRequest, opts ...grpc.CallOption) (*SearchTasksResponse, error)
+	// Changes made to a task and its subtasks.
+	ListTaskHistory(ctx context.Context, in *TaskId, opts ...grpc.CallOption) (*TaskHistory, error)
+	// Counts and completion figures for the calling user's tasks.
+	GetTaskStats(ctx context.Context, in *GetTaskStatsRequest, opts ...grpc.CallOption) (*TaskStats, error)
 }
 
 type taskServiceClient struct {
@@ -59,6 +101,26 @@ func (c *taskServiceClient) GetTasks(ctx context.Context, in *GetTasksRequest, o
 	return out, nil
 }
 
+func (c *taskServiceClient) GetTask(ctx context.Context, in *TaskId, opts ...grpc.CallOption) (*Task, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Task)
+	err := c.cc.Invoke(ctx, TaskService_GetTask_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskServiceClient) BatchGetTasks(ctx context.Context, in *BatchGetTasksRequest, opts ...grpc.CallOption) (*TaskList, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TaskList)
+	err := c.cc.Invoke(ctx, TaskService_BatchGetTasks_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *taskServiceClient) CreateTask(ctx context.Context, in *CreateTaskRequest, opts ...grpc.CallOption) (*Task, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(Task)
@@ -89,6 +151,76 @@ func (c *taskServiceClient) DeleteTask(ctx context.Context, in *TaskId, opts ...
 	return out, nil
 }
 
+func (c *taskServiceClient) BulkUpdateTasks(ctx context.Context, in *BulkUpdateTasksRequest, opts ...grpc.CallOption) (*BulkTasksResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BulkTasksResponse)
+	err := c.cc.Invoke(ctx, TaskService_BulkUpdateTasks_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskServiceClient) BulkDeleteTasks(ctx context.Context, in *BulkDeleteTasksRequest, opts ...grpc.CallOption) (*BulkTasksResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BulkTasksResponse)
+	err := c.cc.Invoke(ctx, TaskService_BulkDeleteTasks_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskServiceClient) ListDeletedTasks(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*TaskList, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TaskList)
+	err := c.cc.Invoke(ctx, TaskService_ListDeletedTasks_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskServiceClient) ListTasksNeedingAttention(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*TaskList, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TaskList)
+	err := c.cc.Invoke(ctx, TaskService_ListTasksNeedingAttention_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskServiceClient) RestoreTask(ctx context.Context, in *TaskId, opts ...grpc.CallOption) (*Task, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Task)
+	err := c.cc.Invoke(ctx, TaskService_RestoreTask_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskServiceClient) PurgeTask(ctx context.Context, in *TaskId, opts ...grpc.CallOption) (*DeleteTaskResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteTaskResponse)
+	err := c.cc.Invoke(ctx, TaskService_PurgeTask_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskServiceClient) GetSubTask(ctx context.Context, in *SubTaskId, opts ...grpc.CallOption) (*SubTask, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SubTask)
+	err := c.cc.Invoke(ctx, TaskService_GetSubTask_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *taskServiceClient) CreateSubTask(ctx context.Context, in *CreateSubTaskRequest, opts ...grpc.CallOption) (*SubTask, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(SubTask)
@@ -99,6 +231,16 @@ func (c *taskServiceClient) CreateSubTask(ctx context.Context, in *CreateSubTask
 	return out, nil
 }
 
+func (c *taskServiceClient) UpdateSubTask(ctx context.Context, in *UpdateSubTaskRequest, opts ...grpc.CallOption) (*SubTask, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SubTask)
+	err := c.cc.Invoke(ctx, TaskService_UpdateSubTask_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *taskServiceClient) ToggleSubTask(ctx context.Context, in *ToggleSubTaskRequest, opts ...grpc.CallOption) (*SubTask, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(SubTask)
@@ -109,6 +251,26 @@ func (c *taskServiceClient) ToggleSubTask(ctx context.Context, in *ToggleSubTask
 	return out, nil
 }
 
+func (c *taskServiceClient) DeleteSubTask(ctx context.Context, in *SubTaskId, opts ...grpc.CallOption) (*DeleteSubTaskResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteSubTaskResponse)
+	err := c.cc.Invoke(ctx, TaskService_DeleteSubTask_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskServiceClient) ReorderSubTasks(ctx context.Context, in *ReorderSubTasksRequest, opts ...grpc.CallOption) (*SubTaskList, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SubTaskList)
+	err := c.cc.Invoke(ctx, TaskService_ReorderSubTasks_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *taskServiceClient) ListSubTasks(ctx context.Context, in *TaskId, opts ...grpc.CallOption) (*SubTaskList, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(SubTaskList)
@@ -119,17 +281,100 @@ func (c *taskServiceClient) ListSubTasks(ctx context.Context, in *TaskId, opts .
 	return out, nil
 }
 
+func (c *taskServiceClient) BatchListSubTasks(ctx context.Context, in *TaskIds, opts ...grpc.CallOption) (*SubTasksByTask, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SubTasksByTask)
+	err := c.cc.Invoke(ctx, TaskService_BatchListSubTasks_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskServiceClient) WatchTasks(ctx context.Context, in *WatchTasksRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[TaskEvent], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &TaskService_ServiceDesc.Streams[0], TaskService_WatchTasks_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[WatchTasksRequest, TaskEvent]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type TaskService_WatchTasksClient = grpc.ServerStreamingClient[TaskEvent]
+
+func (c *taskServiceClient) SearchTasks(ctx context.Context, in *SearchTasksRequest, opts ...grpc.CallOption) (*SearchTasksResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SearchTasksResponse)
+	err := c.cc.Invoke(ctx, TaskService_SearchTasks_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskServiceClient) ListTaskHistory(ctx context.Context, in *TaskId, opts ...grpc.CallOption) (*TaskHistory, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TaskHistory)
+	err := c.cc.Invoke(ctx, TaskService_ListTaskHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *taskServiceClient) GetTaskStats(ctx context.Context, in *GetTaskStatsRequest, opts ...grpc.CallOption) (*TaskStats, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TaskStats)
+	err := c.cc.Invoke(ctx, TaskService_GetTaskStats_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // TaskServiceServer is the server API for TaskService service.
 // All implementations must embed UnimplementedTaskServiceServer
 // for forward compatibility.
 type TaskServiceServer interface {
 	GetTasks(context.Context, *GetTasksRequest) (*TaskList, error)
+	GetTask(context.Context, *TaskId) (*Task, error)
+	// The listed tasks in the order given.
+	BatchGetTasks(context.Context, *BatchGetTasksRequest) (*TaskList, error)
 	CreateTask(context.Context, *CreateTaskRequest) (*Task, error)
 	UpdateTask(context.Context, *UpdateTaskRequest) (*Task, error)
 	DeleteTask(context.Context, *TaskId) (*DeleteTaskResponse, error)
+	// Change or trash many tasks in one transaction, reporting the outcome for each.
+	BulkUpdateTasks(context.Context, *BulkUpdateTasksRequest) (*BulkTasksResponse, error)
+	BulkDeleteTasks(context.Context, *BulkDeleteTasksRequest) (*BulkTasksResponse, error)
+	ListDeletedTasks(context.Context, *emptypb.Empty) (*TaskList, error)
+	// Open tasks of high priority or above whose due date has passed, most urgent first.
+	ListTasksNeedingAttention(context.Context, *emptypb.Empty) (*TaskList, error)
+	RestoreTask(context.Context, *TaskId) (*Task, error)
+	PurgeTask(context.Context, *TaskId) (*DeleteTaskResponse, error)
+	GetSubTask(context.Context, *SubTaskId) (*SubTask, error)
 	CreateSubTask(context.Context, *CreateSubTaskRequest) (*SubTask, error)
+	UpdateSubTask(context.Context, *UpdateSubTaskRequest) (*SubTask, error)
 	ToggleSubTask(context.Context, *ToggleSubTaskRequest) (*SubTask, error)
+	DeleteSubTask(context.Context, *SubTaskId) (*DeleteSubTaskResponse, error)
+	ReorderSubTasks(context.Context, *ReorderSubTasksRequest) (*SubTaskList, error)
 	ListSubTasks(context.Context, *TaskId) (*SubTaskList, error)
+	BatchListSubTasks(context.Context, *TaskIds) (*SubTasksByTask, error)
+	// Streams changes to the calling user's tasks until the client cancels.
+	WatchTasks(*WatchTasksRequest, grpc.ServerStreamingServer[TaskEvent]) error
+	// Full-text search over the calling user's tasks, ranked by relevance.
+	SearchTasks(context.Context, *SearchTasksRequest) (*SearchTasksResponse, error)
+	// Changes made to a task and its subtasks.
+	ListTaskHistory(context.Context, *TaskId) (*TaskHistory, error)
+	// Counts and completion figures for the calling user's tasks.
+	GetTaskStats(context.Context, *GetTaskStatsRequest) (*TaskStats, error)
 	mustEmbedUnimplementedTaskServiceServer()
 }
 
@@ -143,6 +388,12 @@ type UnimplementedTaskServiceServer struct{}
 func (UnimplementedTaskServiceServer) GetTasks(context.Context, *GetTasksRequest) (*TaskList, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetTasks not implemented")
 }
+func (UnimplementedTaskServiceServer) GetTask(context.Context, *TaskId) (*Task, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTask not implemented")
+}
+func (UnimplementedTaskServiceServer) BatchGetTasks(context.Context, *BatchGetTasksRequest) (*TaskList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchGetTasks not implemented")
+}
 func (UnimplementedTaskServiceServer) CreateTask(context.Context, *CreateTaskRequest) (*Task, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateTask not implemented")
 }
@@ -152,15 +403,60 @@ func (UnimplementedTaskServiceServer) UpdateTask(context.Context, *UpdateTaskReq
 func (UnimplementedTaskServiceServer) DeleteTask(context.Context, *TaskId) (*DeleteTaskResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteTask not implemented")
 }
+func (UnimplementedTaskServiceServer) BulkUpdateTasks(context.Context, *BulkUpdateTasksRequest) (*BulkTasksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BulkUpdateTasks not implemented")
+}
+func (UnimplementedTaskServiceServer) BulkDeleteTasks(context.Context, *BulkDeleteTasksRequest) (*BulkTasksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BulkDeleteTasks not implemented")
+}
+func (UnimplementedTaskServiceServer) ListDeletedTasks(context.Context, *emptypb.Empty) (*TaskList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDeletedTasks not implemented")
+}
+func (UnimplementedTaskServiceServer) ListTasksNeedingAttention(context.Context, *emptypb.Empty) (*TaskList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTasksNeedingAttention not implemented")
+}
+func (UnimplementedTaskServiceServer) RestoreTask(context.Context, *TaskId) (*Task, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreTask not implemented")
+}
+func (UnimplementedTaskServiceServer) PurgeTask(context.Context, *TaskId) (*DeleteTaskResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PurgeTask not implemented")
+}
+func (UnimplementedTaskServiceServer) GetSubTask(context.Context, *SubTaskId) (*SubTask, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSubTask not implemented")
+}
 func (UnimplementedTaskServiceServer) CreateSubTask(context.Context, *CreateSubTaskRequest) (*SubTask, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateSubTask not implemented")
 }
+func (UnimplementedTaskServiceServer) UpdateSubTask(context.Context, *UpdateSubTaskRequest) (*SubTask, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateSubTask not implemented")
+}
 func (UnimplementedTaskServiceServer) ToggleSubTask(context.Context, *ToggleSubTaskRequest) (*SubTask, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ToggleSubTask not implemented")
 }
+func (UnimplementedTaskServiceServer) DeleteSubTask(context.Context, *SubTaskId) (*DeleteSubTaskResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteSubTask not implemented")
+}
+func (UnimplementedTaskServiceServer) ReorderSubTasks(context.Context, *ReorderSubTasksRequest) (*SubTaskList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReorderSubTasks not implemented")
+}
 func (UnimplementedTaskServiceServer) ListSubTasks(context.Context, *TaskId) (*SubTaskList, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListSubTasks not implemented")
 }
+func (UnimplementedTaskServiceServer) BatchListSubTasks(context.Context, *TaskIds) (*SubTasksByTask, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchListSubTasks not implemented")
+}
+func (UnimplementedTaskServiceServer) WatchTasks(*WatchTasksRequest, grpc.ServerStreamingServer[TaskEvent]) error {
+	return status.Errorf(codes.Unimplemented, "method WatchTasks not implemented")
+}
+func (UnimplementedTaskServiceServer) SearchTasks(context.Context, *SearchTasksRequest) (*SearchTasksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchTasks not implemented")
+}
+func (UnimplementedTaskServiceServer) ListTaskHistory(context.Context, *TaskId) (*TaskHistory, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTaskHistory not implemented")
+}
+func (UnimplementedTaskServiceServer) GetTaskStats(context.Context, *GetTaskStatsRequest) (*TaskStats, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTaskStats not implemented")
+}
 func (UnimplementedTaskServiceServer) mustEmbedUnimplementedTaskServiceServer() {}
 func (UnimplementedTaskServiceServer) testEmbeddedByValue()                     {}
 
@@ -200,6 +496,42 @@ func _TaskService_GetTasks_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _TaskService_GetTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TaskId)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskServiceServer).GetTask(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TaskService_GetTask_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskServiceServer).GetTask(ctx, req.(*TaskId))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaskService_BatchGetTasks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchGetTasksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskServiceServer).BatchGetTasks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TaskService_BatchGetTasks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskServiceServer).BatchGetTasks(ctx, req.(*BatchGetTasksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _TaskService_CreateTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CreateTaskRequest)
 	if err := dec(in); err != nil {
@@ -254,6 +586,132 @@ func _TaskService_DeleteTask_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _TaskService_BulkUpdateTasks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BulkUpdateTasksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskServiceServer).BulkUpdateTasks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TaskService_BulkUpdateTasks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskServiceServer).BulkUpdateTasks(ctx, req.(*BulkUpdateTasksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaskService_BulkDeleteTasks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BulkDeleteTasksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskServiceServer).BulkDeleteTasks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TaskService_BulkDeleteTasks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskServiceServer).BulkDeleteTasks(ctx, req.(*BulkDeleteTasksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaskService_ListDeletedTasks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskServiceServer).ListDeletedTasks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TaskService_ListDeletedTasks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskServiceServer).ListDeletedTasks(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaskService_ListTasksNeedingAttention_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskServiceServer).ListTasksNeedingAttention(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TaskService_ListTasksNeedingAttention_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskServiceServer).ListTasksNeedingAttention(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaskService_RestoreTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TaskId)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskServiceServer).RestoreTask(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TaskService_RestoreTask_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskServiceServer).RestoreTask(ctx, req.(*TaskId))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaskService_PurgeTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TaskId)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskServiceServer).PurgeTask(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TaskService_PurgeTask_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskServiceServer).PurgeTask(ctx, req.(*TaskId))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaskService_GetSubTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubTaskId)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskServiceServer).GetSubTask(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TaskService_GetSubTask_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskServiceServer).GetSubTask(ctx, req.(*SubTaskId))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _TaskService_CreateSubTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CreateSubTaskRequest)
 	if err := dec(in); err != nil {
@@ -272,6 +730,24 @@ func _TaskService_CreateSubTask_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _TaskService_UpdateSubTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateSubTaskRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskServiceServer).UpdateSubTask(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TaskService_UpdateSubTask_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskServiceServer).UpdateSubTask(ctx, req.(*UpdateSubTaskRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _TaskService_ToggleSubTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ToggleSubTaskRequest)
 	if err := dec(in); err != nil {
@@ -290,6 +766,42 @@ func _TaskService_ToggleSubTask_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _TaskService_DeleteSubTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubTaskId)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskServiceServer).DeleteSubTask(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TaskService_DeleteSubTask_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskServiceServer).DeleteSubTask(ctx, req.(*SubTaskId))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaskService_ReorderSubTasks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReorderSubTasksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskServiceServer).ReorderSubTasks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TaskService_ReorderSubTasks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskServiceServer).ReorderSubTasks(ctx, req.(*ReorderSubTasksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _TaskService_ListSubTasks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(TaskId)
 	if err := dec(in); err != nil {
@@ -308,6 +820,89 @@ func _TaskService_ListSubTasks_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _TaskService_BatchListSubTasks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TaskIds)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskServiceServer).BatchListSubTasks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TaskService_BatchListSubTasks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskServiceServer).BatchListSubTasks(ctx, req.(*TaskIds))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaskService_WatchTasks_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchTasksRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(TaskServiceServer).WatchTasks(m, &grpc.GenericServerStream[WatchTasksRequest, TaskEvent]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type TaskService_WatchTasksServer = grpc.ServerStreamingServer[TaskEvent]
+
+func _TaskService_SearchTasks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchTasksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskServiceServer).SearchTasks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TaskService_SearchTasks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskServiceServer).SearchTasks(ctx, req.(*SearchTasksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaskService_ListTaskHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TaskId)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskServiceServer).ListTaskHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TaskService_ListTaskHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskServiceServer).ListTaskHistory(ctx, req.(*TaskId))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaskService_GetTaskStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTaskStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskServiceServer).GetTaskStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TaskService_GetTaskStats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskServiceServer).GetTaskStats(ctx, req.(*GetTaskStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // TaskService_ServiceDesc is the grpc.ServiceDesc for TaskService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -319,6 +914,14 @@ var TaskService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetTasks",
 			Handler:    _TaskService_GetTasks_Handler,
 		},
+		{
+			MethodName: "GetTask",
+			Handler:    _TaskService_GetTask_Handler,
+		},
+		{
+			MethodName: "BatchGetTasks",
+			Handler:    _TaskService_BatchGetTasks_Handler,
+		},
 		{
 			MethodName: "CreateTask",
 			Handler:    _TaskService_CreateTask_Handler,
@@ -331,19 +934,81 @@ var TaskService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteTask",
 			Handler:    _TaskService_DeleteTask_Handler,
 		},
+		{
+			MethodName: "BulkUpdateTasks",
+			Handler:    _TaskService_BulkUpdateTasks_Handler,
+		},
+		{
+			MethodName: "BulkDeleteTasks",
+			Handler:    _TaskService_BulkDeleteTasks_Handler,
+		},
+		{
+			MethodName: "ListDeletedTasks",
+			Handler:    _TaskService_ListDeletedTasks_Handler,
+		},
+		{
+			MethodName: "ListTasksNeedingAttention",
+			Handler:    _TaskService_ListTasksNeedingAttention_Handler,
+		},
+		{
+			MethodName: "RestoreTask",
+			Handler:    _TaskService_RestoreTask_Handler,
+		},
+		{
+			MethodName: "PurgeTask",
+			Handler:    _TaskService_PurgeTask_Handler,
+		},
+		{
+			MethodName: "GetSubTask",
+			Handler:    _TaskService_GetSubTask_Handler,
+		},
 		{
 			MethodName: "CreateSubTask",
 			Handler:    _TaskService_CreateSubTask_Handler,
 		},
+		{
+			MethodName: "UpdateSubTask",
+			Handler:    _TaskService_UpdateSubTask_Handler,
+		},
 		{
 			MethodName: "ToggleSubTask",
 			Handler:    _TaskService_ToggleSubTask_Handler,
 		},
+		{
+			MethodName: "DeleteSubTask",
+			Handler:    _TaskService_DeleteSubTask_Handler,
+		},
+		{
+			MethodName: "ReorderSubTasks",
+			Handler:    _TaskService_ReorderSubTasks_Handler,
+		},
 		{
 			MethodName: "ListSubTasks",
 			Handler:    _TaskService_ListSubTasks_Handler,
 		},
+		{
+			MethodName: "BatchListSubTasks",
+			Handler:    _TaskService_BatchListSubTasks_Handler,
+		},
+		{
+			MethodName: "SearchTasks",
+			Handler:    _TaskService_SearchTasks_Handler,
+		},
+		{
+			MethodName: "ListTaskHistory",
+			Handler:    _TaskService_ListTaskHistory_Handler,
+		},
+		{
+			MethodName: "GetTaskStats",
+			Handler:    _TaskService_GetTaskStats_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchTasks",
+			Handler:       _TaskService_WatchTasks_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "grpc/proto/todo.proto",
 }
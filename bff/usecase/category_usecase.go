@@ -10,6 +10,9 @@ import (
 // CategoryUsecase exposes category business logic.
 type CategoryUsecase interface {
 	ListCategories(ctx context.Context) ([]*model.Category, error)
+	CreateCategory(ctx context.Context, name string) (*model.Category, error)
+	RenameCategory(ctx context.Context, id uint64, name string) (*model.Category, error)
+	DeleteCategory(ctx context.Context, input repository.DeleteCategoryInput) (bool, error)
 }
 
 type categoryUsecase struct {
@@ -24,3 +27,15 @@ func NewCategoryUsecase(repo repository.CategoryRepository) CategoryUsecase {
 func (uc *categoryUsecase) ListCategories(ctx context.Context) ([]*model.Category, error) {
 	return uc.repo.ListCategories(ctx)
 }
+
+func (uc *categoryUsecase) CreateCategory(ctx context.Context, name string) (*model.Category, error) {
+	return uc.repo.CreateCategory(ctx, name)
+}
+
+func (uc *categoryUsecase) RenameCategory(ctx context.Context, id uint64, name string) (*model.Category, error) {
+	return uc.repo.RenameCategory(ctx, id, name)
+}
+
+func (uc *categoryUsecase) DeleteCategory(ctx context.Context, input repository.DeleteCategoryInput) (bool, error) {
+	return uc.repo.DeleteCategory(ctx, input)
+}
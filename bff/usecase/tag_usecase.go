@@ -0,0 +1,41 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/naoyakurokawa/go_grpc_graphql/domain/model"
+	"github.com/naoyakurokawa/go_grpc_graphql/domain/repository"
+)
+
+// TagUsecase exposes tag business logic.
+type TagUsecase interface {
+	ListTags(ctx context.Context) ([]*model.Tag, error)
+	CreateTag(ctx context.Context, name string) (*model.Tag, error)
+	RenameTag(ctx context.Context, id uint64, name string) (*model.Tag, error)
+	DeleteTag(ctx context.Context, id uint64) (bool, error)
+}
+
+type tagUsecase struct {
+	repo repository.TagRepository
+}
+
+// NewTagUsecase creates a TagUsecase backed by the provided repository.
+func NewTagUsecase(repo repository.TagRepository) TagUsecase {
+	return &tagUsecase{repo: repo}
+}
+
+func (uc *tagUsecase) ListTags(ctx context.Context) ([]*model.Tag, error) {
+	return uc.repo.ListTags(ctx)
+}
+
+func (uc *tagUsecase) CreateTag(ctx context.Context, name string) (*model.Tag, error) {
+	return uc.repo.CreateTag(ctx, name)
+}
+
+func (uc *tagUsecase) RenameTag(ctx context.Context, id uint64, name string) (*model.Tag, error) {
+	return uc.repo.RenameTag(ctx, id, name)
+}
+
+func (uc *tagUsecase) DeleteTag(ctx context.Context, id uint64) (bool, error) {
+	return uc.repo.DeleteTag(ctx, id)
+}
@@ -12,9 +12,27 @@ type TodoUsecase interface {
 	CreateTask(ctx context.Context, input model.NewTask) (*model.Task, error)
 	UpdateTask(ctx context.Context, input model.UpdateTask) (*model.Task, error)
 	DeleteTask(ctx context.Context, id uint64) (bool, error)
-	ListTasks(ctx context.Context, filter repository.TaskFilter) ([]*model.Task, error)
+	GetTask(ctx context.Context, id uint64) (*model.Task, error)
+	BatchGetTasks(ctx context.Context, ids []uint64) ([]*model.Task, error)
+	ListDeletedTasks(ctx context.Context) ([]*model.Task, error)
+	ListTasksNeedingAttention(ctx context.Context) ([]*model.Task, error)
+	RestoreTask(ctx context.Context, id uint64) (*model.Task, error)
+	// ListTasks returns the first limit tasks matching filter. Zero uses the backend's default page size.
+	ListTasks(ctx context.Context, filter repository.TaskFilter, limit int32) ([]*model.Task, error)
+	ListTasksConnection(ctx context.Context, filter repository.TaskFilter, page repository.PageArgs) (*model.TaskConnection, error)
+	SearchTasks(ctx context.Context, query string, page repository.PageArgs) (*model.TaskSearchConnection, error)
 	CreateSubTask(ctx context.Context, input model.NewSubTask) (*model.SubTask, error)
-	ToggleSubTask(ctx context.Context, id uint64, completed bool) (*model.SubTask, error)
+	GetSubTask(ctx context.Context, id uint64) (*model.SubTask, error)
+	UpdateSubTask(ctx context.Context, input model.UpdateSubTask) (*model.SubTask, error)
+	ToggleSubTask(ctx context.Context, id uint64, completed bool, expectedVersion *int32) (*model.SubTask, error)
+	DeleteSubTask(ctx context.Context, id uint64) (bool, error)
+	ReorderSubTasks(ctx context.Context, taskID uint64, subTaskIDs []uint64) ([]*model.SubTask, error)
+	ListSubTasksByTaskIDs(ctx context.Context, taskIDs []uint64) (map[uint64][]*model.SubTask, error)
+	WatchTasks(ctx context.Context, types ...repository.TaskEventType) (<-chan repository.TaskEvent, error)
+	ListTaskHistory(ctx context.Context, taskID uint64) ([]*model.TaskHistoryEntry, error)
+	BulkUpdateTasks(ctx context.Context, target repository.BulkTaskTarget, change model.BulkTaskChange) (*repository.BulkTaskResult, error)
+	BulkDeleteTasks(ctx context.Context, target repository.BulkTaskTarget) (*repository.BulkTaskResult, error)
+	GetTaskStats(ctx context.Context, statsRange model.StatsRange, categoryID *uint64) (*model.TaskStats, error)
 }
 
 type todoUsecase struct {
@@ -38,14 +56,82 @@ func (uc *todoUsecase) DeleteTask(ctx context.Context, id uint64) (bool, error)
 	return uc.repo.DeleteTask(ctx, id)
 }
 
-func (uc *todoUsecase) ListTasks(ctx context.Context, filter repository.TaskFilter) ([]*model.Task, error) {
-	return uc.repo.ListTasks(ctx, filter)
+func (uc *todoUsecase) GetTask(ctx context.Context, id uint64) (*model.Task, error) {
+	return uc.repo.GetTask(ctx, id)
+}
+
+func (uc *todoUsecase) BatchGetTasks(ctx context.Context, ids []uint64) ([]*model.Task, error) {
+	return uc.repo.BatchGetTasks(ctx, ids)
+}
+
+func (uc *todoUsecase) GetSubTask(ctx context.Context, id uint64) (*model.SubTask, error) {
+	return uc.repo.GetSubTask(ctx, id)
+}
+
+func (uc *todoUsecase) ListDeletedTasks(ctx context.Context) ([]*model.Task, error) {
+	return uc.repo.ListDeletedTasks(ctx)
+}
+
+func (uc *todoUsecase) ListTasksNeedingAttention(ctx context.Context) ([]*model.Task, error) {
+	return uc.repo.ListTasksNeedingAttention(ctx)
+}
+
+func (uc *todoUsecase) RestoreTask(ctx context.Context, id uint64) (*model.Task, error) {
+	return uc.repo.RestoreTask(ctx, id)
+}
+
+func (uc *todoUsecase) ListTasks(ctx context.Context, filter repository.TaskFilter, limit int32) ([]*model.Task, error) {
+	return uc.repo.ListTasks(ctx, filter, limit)
+}
+
+func (uc *todoUsecase) ListTasksConnection(ctx context.Context, filter repository.TaskFilter, page repository.PageArgs) (*model.TaskConnection, error) {
+	return uc.repo.ListTasksConnection(ctx, filter, page)
+}
+
+func (uc *todoUsecase) SearchTasks(ctx context.Context, query string, page repository.PageArgs) (*model.TaskSearchConnection, error) {
+	return uc.repo.SearchTasks(ctx, query, page)
 }
 
 func (uc *todoUsecase) CreateSubTask(ctx context.Context, input model.NewSubTask) (*model.SubTask, error) {
 	return uc.repo.CreateSubTask(ctx, input)
 }
 
-func (uc *todoUsecase) ToggleSubTask(ctx context.Context, id uint64, completed bool) (*model.SubTask, error) {
-	return uc.repo.ToggleSubTask(ctx, id, completed)
+func (uc *todoUsecase) UpdateSubTask(ctx context.Context, input model.UpdateSubTask) (*model.SubTask, error) {
+	return uc.repo.UpdateSubTask(ctx, input)
+}
+
+func (uc *todoUsecase) ToggleSubTask(ctx context.Context, id uint64, completed bool, expectedVersion *int32) (*model.SubTask, error) {
+	return uc.repo.ToggleSubTask(ctx, id, completed, expectedVersion)
+}
+
+func (uc *todoUsecase) DeleteSubTask(ctx context.Context, id uint64) (bool, error) {
+	return uc.repo.DeleteSubTask(ctx, id)
+}
+
+func (uc *todoUsecase) ReorderSubTasks(ctx context.Context, taskID uint64, subTaskIDs []uint64) ([]*model.SubTask, error) {
+	return uc.repo.ReorderSubTasks(ctx, taskID, subTaskIDs)
+}
+
+func (uc *todoUsecase) ListSubTasksByTaskIDs(ctx context.Context, taskIDs []uint64) (map[uint64][]*model.SubTask, error) {
+	return uc.repo.ListSubTasksByTaskIDs(ctx, taskIDs)
+}
+
+func (uc *todoUsecase) WatchTasks(ctx context.Context, types ...repository.TaskEventType) (<-chan repository.TaskEvent, error) {
+	return uc.repo.WatchTasks(ctx, types...)
+}
+
+func (uc *todoUsecase) ListTaskHistory(ctx context.Context, taskID uint64) ([]*model.TaskHistoryEntry, error) {
+	return uc.repo.ListTaskHistory(ctx, taskID)
+}
+
+func (uc *todoUsecase) BulkUpdateTasks(ctx context.Context, target repository.BulkTaskTarget, change model.BulkTaskChange) (*repository.BulkTaskResult, error) {
+	return uc.repo.BulkUpdateTasks(ctx, target, change)
+}
+
+func (uc *todoUsecase) BulkDeleteTasks(ctx context.Context, target repository.BulkTaskTarget) (*repository.BulkTaskResult, error) {
+	return uc.repo.BulkDeleteTasks(ctx, target)
+}
+
+func (uc *todoUsecase) GetTaskStats(ctx context.Context, statsRange model.StatsRange, categoryID *uint64) (*model.TaskStats, error) {
+	return uc.repo.GetTaskStats(ctx, statsRange, categoryID)
 }
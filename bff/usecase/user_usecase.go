@@ -0,0 +1,57 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/naoyakurokawa/go_grpc_graphql/auth"
+	"github.com/naoyakurokawa/go_grpc_graphql/domain/model"
+	"github.com/naoyakurokawa/go_grpc_graphql/domain/repository"
+)
+
+// UserUsecase exposes account operations that hand out access tokens.
+type UserUsecase interface {
+	SignUp(ctx context.Context, email, password string) (*model.AuthPayload, error)
+	Login(ctx context.Context, email, password string) (*model.AuthPayload, error)
+}
+
+type userUsecase struct {
+	repo   repository.UserRepository
+	tokens *auth.TokenManager
+}
+
+// NewUserUsecase creates a UserUsecase issuing tokens with the given manager.
+func NewUserUsecase(repo repository.UserRepository, tokens *auth.TokenManager) UserUsecase {
+	return &userUsecase{repo: repo, tokens: tokens}
+}
+
+func (uc *userUsecase) SignUp(ctx context.Context, email, password string) (*model.AuthPayload, error) {
+	user, err := uc.repo.SignUp(ctx, email, password)
+	if err != nil {
+		return nil, err
+	}
+
+	return uc.issue(user)
+}
+
+func (uc *userUsecase) Login(ctx context.Context, email, password string) (*model.AuthPayload, error) {
+	user, err := uc.repo.Authenticate(ctx, email, password)
+	if err != nil {
+		return nil, err
+	}
+
+	return uc.issue(user)
+}
+
+func (uc *userUsecase) issue(user *model.User) (*model.AuthPayload, error) {
+	token, expiresAt, err := uc.tokens.Issue(user.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &model.AuthPayload{
+		Token:     token,
+		ExpiresAt: expiresAt.In(time.Local).Format("2006-01-02 15:04:05"),
+		User:      user,
+	}, nil
+}
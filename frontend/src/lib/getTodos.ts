@@ -48,7 +48,7 @@ export const GET_TASKS = gql`
       due_date_end: $dueDateEnd
       incomplete_only: $incompleteOnly
     ) {
-      id
+      id: database_id
       title
       note
       category_id
@@ -58,7 +58,7 @@ export const GET_TASKS = gql`
       created_at
       updated_at
       sub_tasks {
-        id
+        id: database_id
         task_id
         title
         note
@@ -99,7 +99,7 @@ export type CreateTaskInput = {
 export const CREATE_TASK = gql`
   mutation CreateTask($input: NewTask!) {
     createTask(input: $input) {
-      id
+      id: database_id
       title
       note
       category_id
@@ -109,7 +109,7 @@ export const CREATE_TASK = gql`
       created_at
       updated_at
       sub_tasks {
-        id
+        id: database_id
         task_id
         title
         note
@@ -152,7 +152,7 @@ export type UpdateTaskInput = {
 export const UPDATE_TASK = gql`
   mutation UpdateTask($input: UpdateTask!) {
     updateTask(input: $input) {
-      id
+      id: database_id
       title
       note
       category_id
@@ -162,7 +162,7 @@ export const UPDATE_TASK = gql`
       created_at
       updated_at
       sub_tasks {
-        id
+        id: database_id
         task_id
         title
         note
@@ -206,7 +206,7 @@ export type GetCategoriesQuery = {
 export const GET_CATEGORIES = gql`
   query GetCategories {
     categories {
-      id
+      id: database_id
       name
     }
   }
@@ -222,7 +222,7 @@ export type CreateSubTaskInput = {
 export const CREATE_SUB_TASK = gql`
   mutation CreateSubTask($input: NewSubTask!) {
     createSubTask(input: $input) {
-      id
+      id: database_id
       task_id
       title
       note
@@ -238,7 +238,7 @@ export const CREATE_SUB_TASK = gql`
 export const TOGGLE_SUB_TASK = gql`
   mutation ToggleSubTask($id: Uint64!, $completed: Boolean!) {
     toggleSubTask(id: $id, completed: $completed) {
-      id
+      id: database_id
       completed
       completed_at
       updated_at